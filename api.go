@@ -25,6 +25,30 @@ type APIPaymentServiceTokenRes struct {
 	Status string `json:"status"`
 }
 
+// 二段階の決済: /authorize で与信を取り、/capture で確定し、/refund で取り消す (payments.go)
+type APIPaymentAuthorizeReq struct {
+	ShopID string `json:"shop_id"`
+	Token  string `json:"token"`
+	APIKey string `json:"api_key"`
+	Price  int    `json:"price"`
+}
+
+type APIPaymentAuthorizeRes struct {
+	Status    string `json:"status"`
+	PaymentID string `json:"payment_id"`
+}
+
+// /capture と /refund で共通
+type APIPaymentOperationReq struct {
+	ShopID    string `json:"shop_id"`
+	APIKey    string `json:"api_key"`
+	PaymentID string `json:"payment_id"`
+}
+
+type APIPaymentOperationRes struct {
+	Status string `json:"status"`
+}
+
 type APIShipmentCreateReq struct {
 	ToAddress   string `json:"to_address"`
 	ToName      string `json:"to_name"`
@@ -84,6 +108,61 @@ func APIPaymentToken(paymentURL string, param *APIPaymentServiceTokenReq) (*APIP
 	return pstr, nil
 }
 
+func APIPaymentAuthorize(paymentURL string, param *APIPaymentAuthorizeReq) (*APIPaymentAuthorizeRes, error) {
+	par := &APIPaymentAuthorizeRes{}
+	err := postPaymentService(paymentURL+"/authorize", param, par)
+	if err != nil {
+		return nil, err
+	}
+	return par, nil
+}
+
+func APIPaymentCapture(paymentURL string, param *APIPaymentOperationReq) (*APIPaymentOperationRes, error) {
+	por := &APIPaymentOperationRes{}
+	err := postPaymentService(paymentURL+"/capture", param, por)
+	if err != nil {
+		return nil, err
+	}
+	return por, nil
+}
+
+func APIPaymentRefund(paymentURL string, param *APIPaymentOperationReq) (*APIPaymentOperationRes, error) {
+	por := &APIPaymentOperationRes{}
+	err := postPaymentService(paymentURL+"/refund", param, por)
+	if err != nil {
+		return nil, err
+	}
+	return por, nil
+}
+
+func postPaymentService(url string, param interface{}, out interface{}) error {
+	b, _ := json.Marshal(param)
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(b))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		b, err := ioutil.ReadAll(res.Body)
+		if err != nil {
+			return fmt.Errorf("failed to read res.Body and the status code of the response from payment service was not 200: %v", err)
+		}
+		return fmt.Errorf("status code: %d; body: %s", res.StatusCode, b)
+	}
+
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
 func APIShipmentCreate(shipmentURL string, param *APIShipmentCreateReq) (*APIShipmentCreateRes, error) {
 	b, _ := json.Marshal(param)
 
@@ -0,0 +1,303 @@
+package main
+
+// 個人用の API トークン
+//
+// ログインしたユーザーが /users/tokens でスコープ(read / sell / buy / ship)を選んで作る。トークンは作った時に一度だけ返し、
+// apiTokenServer には sha256 の hex をキーにして保存する。
+// Authorization: Bearer <token> のリクエストは apiTokenMiddleware で確かめて context に入れ、
+// getUser などはセッションの代わりにそのユーザーを使う。トークンのリクエストは cookie を使わないので CSRF の確認はしない。
+// どのスコープが要るかは GET なら read、POST は apiTokenPostScopes で決める(載っていない POST はトークンでは使えない)。
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/isucon/isucon9-qualify/webapp/go/internal/kvtypes"
+)
+
+const (
+	apiTokenPrefix        = "isu_"
+	apiTokenTouchInterval = time.Minute
+	apiTokenMaxPerUser    = 20
+	indexAPITokensByUser  = "apitokens.user"
+
+	apiTokenScopeRead = "read"
+	apiTokenScopeSell = "sell"
+	apiTokenScopeBuy  = "buy"
+	apiTokenScopeShip = "ship"
+)
+
+var apiTokenScopes = []string{apiTokenScopeRead, apiTokenScopeSell, apiTokenScopeBuy, apiTokenScopeShip}
+
+// POST のパス -> 要るスコープ(どれか 1 つあればよい)
+var apiTokenPostScopes = map[string][]string{
+	"/sell":                  {apiTokenScopeSell},
+	"/items/edit":            {apiTokenScopeSell},
+	"/items/stop":            {apiTokenScopeSell},
+	"/items/resume":          {apiTokenScopeSell},
+	"/bump":                  {apiTokenScopeSell},
+	"/buy":                   {apiTokenScopeBuy},
+	"/ship":                  {apiTokenScopeShip},
+	"/ship_done":             {apiTokenScopeShip},
+	"/complete":              {apiTokenScopeShip},
+	"/cancel":                {apiTokenScopeBuy, apiTokenScopeShip}, // 購入者も出品者もキャンセルできる
+	"/review":                {apiTokenScopeBuy, apiTokenScopeSell},
+	"/items/like":            {apiTokenScopeBuy},
+	"/items/unlike":          {apiTokenScopeBuy},
+	"/items/comments":        {apiTokenScopeBuy, apiTokenScopeSell}, // 質問も出品者の返信も書ける
+	"/items/comments/delete": {apiTokenScopeBuy, apiTokenScopeSell},
+	"/items/messages":        {apiTokenScopeBuy, apiTokenScopeShip}, // 購入者も出品者も送れる
+	"/notifications/read":    {apiTokenScopeRead},
+}
+
+type apiToken kvtypes.APIToken
+
+func (this *apiToken) hasAnyScope(scopes []string) bool {
+	for _, s := range this.Scopes {
+		for _, scope := range scopes {
+			if s == scope {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type apiTokenContextKey struct{}
+
+func hashAPIToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// Bearer で来たトークン。無ければ nil
+func apiTokenFromRequest(r *http.Request) *apiToken {
+	token, _ := r.Context().Value(apiTokenContextKey{}).(*apiToken)
+	return token
+}
+
+func apiTokenScopesOf(r *http.Request) ([]string, bool) {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		return []string{apiTokenScopeRead}, true
+	case http.MethodPost:
+		scopes, ok := apiTokenPostScopes[r.URL.Path]
+		return scopes, ok
+	}
+	return nil, false
+}
+
+func apiTokenMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization := r.Header.Get("Authorization")
+		if !strings.HasPrefix(authorization, "Bearer ") {
+			next.ServeHTTP(w, r)
+			return
+		}
+		key := hashAPIToken(strings.TrimSpace(strings.TrimPrefix(authorization, "Bearer ")))
+		token := apiToken{}
+		if !apiTokenServer.Get(key, &token) {
+			outputErrorMsg(w, http.StatusUnauthorized, "invalid token")
+			return
+		}
+		scopes, ok := apiTokenScopesOf(r)
+		if !ok {
+			outputErrorMsg(w, http.StatusForbidden, "この操作はトークンではできません")
+			return
+		}
+		if !token.hasAnyScope(scopes) {
+			outputErrorMsg(w, http.StatusForbidden, "token scope error: "+strings.Join(scopes, "|"))
+			return
+		}
+		if now := time.Now(); now.Sub(token.LastUsedAt) > apiTokenTouchInterval {
+			touchAPIToken(key, now)
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), apiTokenContextKey{}, &token)))
+	})
+}
+
+func touchAPIToken(key string, now time.Time) {
+	apiTokenServer.Transaction(key, func(tx KeyValueStoreConn) {
+		token := apiToken{}
+		if !tx.Get(key, &token) {
+			return
+		}
+		token.LastUsedAt = now
+		tx.Set(key, token)
+	})
+}
+
+func registerAPITokenIndexes() {
+	apiTokenServer.server.RegisterIndex(indexAPITokensByUser, func(value interface{}) ([]string, string) {
+		token := value.(*apiToken)
+		return []string{strconv.Itoa(int(token.UserID))}, fmt.Sprintf("%019d", token.CreatedAt.UnixNano())
+	})
+}
+
+// ユーザーのトークンを新しい順に。キーは hash
+func userAPITokens(userID int64) (keys []string, tokens []apiToken, err error) {
+	found, err := apiTokenServer.IQuery(indexAPITokensByUser, strconv.Itoa(int(userID)), "", 0, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	mGot := apiTokenServer.MGet(found)
+	for _, key := range found {
+		token := apiToken{}
+		if mGot.Get(key, &token) {
+			keys = append(keys, key)
+			tokens = append(tokens, token)
+		}
+	}
+	return keys, tokens, nil
+}
+
+type reqCreateAPIToken struct {
+	CSRFToken string   `json:"csrf_token"`
+	Name      string   `json:"name"`
+	Scopes    []string `json:"scopes"`
+}
+
+type reqRevokeAPIToken struct {
+	CSRFToken string `json:"csrf_token"`
+	ID        string `json:"id"`
+}
+
+type resAPIToken struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Scopes     []string `json:"scopes"`
+	CreatedAt  int64    `json:"created_at"`
+	LastUsedAt int64    `json:"last_used_at,omitempty"`
+	Token      string   `json:"token,omitempty"` // 作った時だけ
+}
+
+func newResAPIToken(token apiToken) resAPIToken {
+	res := resAPIToken{
+		ID:        token.ID,
+		Name:      token.Name,
+		Scopes:    token.Scopes,
+		CreatedAt: token.CreatedAt.Unix(),
+	}
+	if !token.LastUsedAt.IsZero() {
+		res.LastUsedAt = token.LastUsedAt.Unix()
+	}
+	return res
+}
+
+func getAPITokens(w http.ResponseWriter, r *http.Request) {
+	user, errCode, errMsg := getUser(r)
+	if errMsg != "" {
+		outputErrorMsg(w, errCode, errMsg)
+		return
+	}
+	_, tokens, err := userAPITokens(user.ID)
+	if err != nil {
+		log.Print(err)
+		outputErrorMsg(w, http.StatusInternalServerError, "kvs error")
+		return
+	}
+	res := make([]resAPIToken, 0, len(tokens))
+	for _, token := range tokens {
+		res = append(res, newResAPIToken(token))
+	}
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	json.NewEncoder(w).Encode(res)
+}
+
+func postAPIToken(w http.ResponseWriter, r *http.Request) {
+	rc := reqCreateAPIToken{}
+	err := json.NewDecoder(r.Body).Decode(&rc)
+	if err != nil {
+		outputErrorMsg(w, http.StatusBadRequest, "json decode error")
+		return
+	}
+	if rc.CSRFToken != getCSRFToken(r) {
+		outputErrorMsg(w, http.StatusUnprocessableEntity, "csrf token error")
+		return
+	}
+	user, errCode, errMsg := getUser(r)
+	if errMsg != "" {
+		outputErrorMsg(w, errCode, errMsg)
+		return
+	}
+	if rc.Name == "" || len(rc.Scopes) == 0 {
+		outputErrorMsg(w, http.StatusBadRequest, "all parameters are required")
+		return
+	}
+	scopes := []string{}
+	for _, scope := range apiTokenScopes { // 重複を除いて順番を揃える
+		for _, requested := range rc.Scopes {
+			if requested == scope {
+				scopes = append(scopes, scope)
+				break
+			}
+		}
+	}
+	if len(scopes) != len(rc.Scopes) {
+		outputErrorMsg(w, http.StatusBadRequest, "scopes must be some of "+strings.Join(apiTokenScopes, ", "))
+		return
+	}
+	keys, _, err := userAPITokens(user.ID)
+	if err != nil {
+		log.Print(err)
+		outputErrorMsg(w, http.StatusInternalServerError, "kvs error")
+		return
+	}
+	if len(keys) >= apiTokenMaxPerUser {
+		outputErrorMsg(w, http.StatusBadRequest, "トークンが多すぎます")
+		return
+	}
+	secret := apiTokenPrefix + secureRandomStr(32)
+	token := apiToken{
+		ID:        secureRandomStr(8),
+		UserID:    user.ID,
+		Name:      rc.Name,
+		Scopes:    scopes,
+		CreatedAt: time.Now(),
+	}
+	apiTokenServer.Set(hashAPIToken(secret), token)
+	res := newResAPIToken(token)
+	res.Token = secret
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	json.NewEncoder(w).Encode(res)
+}
+
+func postRevokeAPIToken(w http.ResponseWriter, r *http.Request) {
+	rr := reqRevokeAPIToken{}
+	err := json.NewDecoder(r.Body).Decode(&rr)
+	if err != nil {
+		outputErrorMsg(w, http.StatusBadRequest, "json decode error")
+		return
+	}
+	if rr.CSRFToken != getCSRFToken(r) {
+		outputErrorMsg(w, http.StatusUnprocessableEntity, "csrf token error")
+		return
+	}
+	user, errCode, errMsg := getUser(r)
+	if errMsg != "" {
+		outputErrorMsg(w, errCode, errMsg)
+		return
+	}
+	keys, tokens, err := userAPITokens(user.ID)
+	if err != nil {
+		log.Print(err)
+		outputErrorMsg(w, http.StatusInternalServerError, "kvs error")
+		return
+	}
+	for i, token := range tokens {
+		if token.ID == rr.ID {
+			apiTokenServer.Del(keys[i])
+			w.Header().Set("Content-Type", "application/json;charset=utf-8")
+			w.Write([]byte("{}"))
+			return
+		}
+	}
+	outputErrorMsg(w, http.StatusNotFound, "token not found")
+}
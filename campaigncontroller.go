@@ -0,0 +1,336 @@
+package main
+
+// キャンペーンの段階を負荷に合わせて自動で上げ下げする
+//
+// /initialize が返す段階 (campaign) でベンチマーカーからの負荷が変わる。
+// 有効にすると eventServer の master の台で campaignControllerInterval 毎に、直近の /buy や /new_items の
+// レイテンシ (p95) とエラー率 (latency.go) を目標と比べ、どれかが超えていれば段階を 1 つ下げ、
+// 全て余裕がある状態が campaignControllerRaiseAfter 回続けば 1 つ上げる(MinLevel - MaxLevel の範囲で)。
+// 状態は eventServer の campaignControllerKey に置くので、どの台の /initialize でも同じ段階を返す。
+// ベンチマーカーは /initialize の時にしか段階を見ないので、走行中に決めた段階は次の /initialize で返す。
+// 初めての時や無効の時はキャンペーン (campaigns.go) の段階を範囲に収めて使う。
+// 変えるのはベンチマーカーに返す段階だけで、/complete で付けるポイントの還元率 (campaign.Rate) は変えない。
+// ポイントはユーザーへの約束なので、こちらの負荷の都合で走行中に減らすことはしない。
+// 判断はログに出し、:9876 の /debug/campaign-controller で見られる(POST で有効 / 無効や範囲を変えられる)。
+// ISUCARI_CAMPAIGN_CONTROLLER=1 で起動時から有効にし、範囲は ISUCARI_CAMPAIGN_MIN / ISUCARI_CAMPAIGN_MAX で決める。
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const (
+	campaignControllerKey        = "campaign.controller"
+	campaignControllerInterval   = 5 * time.Second
+	campaignControllerWindow     = 10 * time.Second // これだけ前までのレイテンシで判断する
+	campaignControllerCooldown   = 10 * time.Second // 変えてからこれだけは変えない
+	campaignControllerRaiseAfter = 3
+	campaignControllerMinSamples = 20 // これより少ないエンドポイントは判断に使わない
+	campaignControllerDecisions  = 50 // 残しておく判断の数
+)
+
+// 見張るエンドポイント (latencyEndpointOf の形式) と目標
+type campaignControllerTarget struct {
+	Endpoint     string  `json:"endpoint"`
+	P95Ms        int64   `json:"p95_ms"`
+	MaxErrorRate float64 `json:"max_error_rate"`
+}
+
+var campaignControllerTargets = []campaignControllerTarget{
+	{Endpoint: "POST /buy", P95Ms: 1000, MaxErrorRate: 0.01},
+	{Endpoint: "GET /new_items.json", P95Ms: 250, MaxErrorRate: 0.01},
+	{Endpoint: "GET /new_items/:root_category_id.json", P95Ms: 250, MaxErrorRate: 0.01},
+}
+
+type campaignDecision struct {
+	At     time.Time
+	From   int
+	To     int
+	Reason string
+}
+
+type campaignControllerState struct {
+	Enabled      bool
+	MinLevel     int
+	MaxLevel     int
+	Level        int
+	HealthyTicks int // 余裕がある状態が続いた回数
+	LastChangeAt time.Time
+	LastTickAt   time.Time
+	Decisions    []campaignDecision // 新しい順
+}
+
+func (this *campaignControllerState) clamp(level int) int {
+	if level < this.MinLevel {
+		return this.MinLevel
+	}
+	if level > this.MaxLevel {
+		return this.MaxLevel
+	}
+	return level
+}
+
+func (this *campaignControllerState) decide(to int, reason string, now time.Time) {
+	decision := campaignDecision{At: now, From: this.Level, To: to, Reason: reason}
+	log.Printf("campaign controller: %d -> %d (%s)", decision.From, decision.To, reason)
+	this.Level = to
+	this.HealthyTicks = 0
+	this.LastChangeAt = now
+	this.Decisions = append([]campaignDecision{decision}, this.Decisions...)
+	if len(this.Decisions) > campaignControllerDecisions {
+		this.Decisions = this.Decisions[:campaignControllerDecisions]
+	}
+}
+
+func envInt(name string, defaultValue int) int {
+	value := os.Getenv(name)
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		log.Println("invalid", name, value)
+		return defaultValue
+	}
+	return parsed
+}
+
+func defaultCampaignControllerState() campaignControllerState {
+	state := campaignControllerState{
+		Enabled:  os.Getenv("ISUCARI_CAMPAIGN_CONTROLLER") == "1",
+		MinLevel: envInt("ISUCARI_CAMPAIGN_MIN", 0),
+		MaxLevel: envInt("ISUCARI_CAMPAIGN_MAX", campaignMaxLevel),
+	}
+	state.MinLevel = clampCampaignLevel(state.MinLevel)
+	state.MaxLevel = clampCampaignLevel(state.MaxLevel)
+	if state.MaxLevel < state.MinLevel {
+		state.MaxLevel = state.MinLevel
+	}
+	return state
+}
+
+func loadCampaignControllerState(tx KeyValueStoreConn) campaignControllerState {
+	state := campaignControllerState{}
+	if !tx.Get(campaignControllerKey, &state) {
+		state = defaultCampaignControllerState()
+	}
+	return state
+}
+
+func updateCampaignControllerState(f func(state *campaignControllerState)) (state campaignControllerState) {
+	eventServer.Transaction(campaignControllerKey, func(tx KeyValueStoreConn) {
+		state = loadCampaignControllerState(tx)
+		f(&state)
+		tx.Set(campaignControllerKey, state)
+	})
+	return state
+}
+
+// /initialize で呼ぶ。返す段階を決める。無効の時もキャンペーンの段階を範囲に収めて返す
+func resetCampaignController(level int) int {
+	state := updateCampaignControllerState(func(state *campaignControllerState) {
+		if !state.Enabled || state.LastChangeAt.IsZero() {
+			state.Level = level
+		}
+		state.Level = state.clamp(state.Level)
+		state.HealthyTicks = 0
+		state.LastChangeAt = time.Now()
+	})
+	if state.Enabled {
+		log.Printf("campaign controller: start at %d (campaign %d, range %d-%d)", state.Level, level, state.MinLevel, state.MaxLevel)
+	}
+	return state.Level
+}
+
+// 目標ごとの直近の様子
+type campaignTargetStatus struct {
+	campaignControllerTarget
+	Count         int64   `json:"count"`
+	ObservedP95Ms int64   `json:"observed_p95_ms"`
+	AverageMs     float64 `json:"average_ms"`
+	ErrorRate     float64 `json:"error_rate"`
+	Over          bool    `json:"over"`
+}
+
+func evaluateCampaignTargets(recent map[string]*endpointLatency) []campaignTargetStatus {
+	statuses := make([]campaignTargetStatus, 0, len(campaignControllerTargets))
+	for _, target := range campaignControllerTargets {
+		status := campaignTargetStatus{campaignControllerTarget: target}
+		if stats, ok := recent[target.Endpoint]; ok {
+			status.Count = stats.Count
+			status.ObservedP95Ms = stats.quantileMs(0.95)
+			status.AverageMs = stats.averageMs()
+			status.ErrorRate = stats.errorRate()
+			status.Over = status.Count >= campaignControllerMinSamples &&
+				(status.ObservedP95Ms > target.P95Ms || status.ErrorRate > target.MaxErrorRate)
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+func tickCampaignController(now time.Time) {
+	statuses := evaluateCampaignTargets(recentLatency(campaignControllerWindow))
+	updateCampaignControllerState(func(state *campaignControllerState) {
+		state.LastTickAt = now
+		if !state.Enabled {
+			return
+		}
+		coolingDown := now.Sub(state.LastChangeAt) < campaignControllerCooldown
+		observed := false
+		relaxed := true // 全ての目標に半分以上の余裕がある
+		for _, status := range statuses {
+			if status.Count < campaignControllerMinSamples {
+				continue
+			}
+			observed = true
+			if status.Over {
+				state.HealthyTicks = 0
+				if state.Level > state.MinLevel && !coolingDown {
+					state.decide(state.Level-1, fmt.Sprintf("%s p95=%dms errors=%.3f (target %dms, %.3f)",
+						status.Endpoint, status.ObservedP95Ms, status.ErrorRate, status.P95Ms, status.MaxErrorRate), now)
+				}
+				return
+			}
+			if status.ObservedP95Ms*2 > status.P95Ms || status.ErrorRate*2 > status.MaxErrorRate {
+				relaxed = false
+			}
+		}
+		if !observed || !relaxed {
+			state.HealthyTicks = 0
+			return
+		}
+		state.HealthyTicks++
+		if state.HealthyTicks >= campaignControllerRaiseAfter && state.Level < state.MaxLevel && !coolingDown {
+			state.decide(state.Level+1, fmt.Sprintf("all targets below half for %d ticks", state.HealthyTicks), now)
+		}
+	})
+}
+
+func startCampaignController() {
+	if !isMasterServerIP {
+		return
+	}
+	go func() {
+		for {
+			time.Sleep(campaignControllerInterval)
+			tickCampaignController(time.Now())
+		}
+	}()
+}
+
+type resCampaignController struct {
+	Enabled      bool                   `json:"enabled"`
+	MinLevel     int                    `json:"min_level"`
+	MaxLevel     int                    `json:"max_level"`
+	Level        int                    `json:"level"`
+	HealthyTicks int                    `json:"healthy_ticks"`
+	LastChangeAt int64                  `json:"last_change_at"`
+	LastTickAt   int64                  `json:"last_tick_at"`
+	Targets      []campaignTargetStatus `json:"targets"`
+	Endpoints    map[string]interface{} `json:"endpoints"` // 直近の全エンドポイント (master の台でだけ)
+	Decisions    []resCampaignDecision  `json:"decisions"`
+}
+
+type resCampaignDecision struct {
+	At     int64  `json:"at"`
+	From   int    `json:"from"`
+	To     int    `json:"to"`
+	Reason string `json:"reason"`
+}
+
+type reqCampaignController struct {
+	Enabled  *bool `json:"enabled"`
+	MinLevel *int  `json:"min_level"`
+	MaxLevel *int  `json:"max_level"`
+	Level    *int  `json:"level"`
+}
+
+// GET で状態、POST で設定を変える
+func debugCampaignController(w http.ResponseWriter, r *http.Request) {
+	var state campaignControllerState
+	switch r.Method {
+	case http.MethodGet:
+		state = loadCampaignControllerState(eventServer)
+	case http.MethodPost:
+		req := reqCampaignController{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			outputErrorMsg(w, http.StatusBadRequest, "json decode error")
+			return
+		}
+		invalid := ""
+		state = updateCampaignControllerState(func(state *campaignControllerState) {
+			minLevel, maxLevel := state.MinLevel, state.MaxLevel
+			if req.MinLevel != nil {
+				minLevel = *req.MinLevel
+			}
+			if req.MaxLevel != nil {
+				maxLevel = *req.MaxLevel
+			}
+			if minLevel < 0 || maxLevel > campaignMaxLevel || minLevel > maxLevel {
+				invalid = fmt.Sprintf("levels must be 0 <= min_level <= max_level <= %d", campaignMaxLevel)
+				return
+			}
+			state.MinLevel, state.MaxLevel = minLevel, maxLevel
+			if req.Enabled != nil {
+				state.Enabled = *req.Enabled
+			}
+			level := state.Level
+			if req.Level != nil {
+				level = *req.Level
+			}
+			if level = state.clamp(level); level != state.Level {
+				state.decide(level, "set by /debug/campaign-controller", time.Now())
+			}
+		})
+		if invalid != "" {
+			outputErrorMsg(w, http.StatusBadRequest, invalid)
+			return
+		}
+	default:
+		outputErrorMsg(w, http.StatusMethodNotAllowed, "GET or POST only")
+		return
+	}
+	recent := recentLatency(campaignControllerWindow)
+	res := resCampaignController{
+		Enabled:      state.Enabled,
+		MinLevel:     state.MinLevel,
+		MaxLevel:     state.MaxLevel,
+		Level:        state.Level,
+		HealthyTicks: state.HealthyTicks,
+		LastChangeAt: unixOrZero(state.LastChangeAt),
+		LastTickAt:   unixOrZero(state.LastTickAt),
+		Targets:      evaluateCampaignTargets(recent),
+		Endpoints:    map[string]interface{}{},
+		Decisions:    []resCampaignDecision{},
+	}
+	endpoints := make([]string, 0, len(recent))
+	for endpoint := range recent {
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Strings(endpoints)
+	for _, endpoint := range endpoints {
+		stats := recent[endpoint]
+		res.Endpoints[endpoint] = map[string]interface{}{
+			"count":      stats.Count,
+			"p95_ms":     stats.quantileMs(0.95),
+			"average_ms": stats.averageMs(),
+			"error_rate": stats.errorRate(),
+		}
+	}
+	for _, decision := range state.Decisions {
+		res.Decisions = append(res.Decisions, resCampaignDecision{
+			At:     decision.At.Unix(),
+			From:   decision.From,
+			To:     decision.To,
+			Reason: decision.Reason,
+		})
+	}
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	json.NewEncoder(w).Encode(res)
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func useTestCampaignControllerState(t *testing.T, state campaignControllerState) (restore func()) {
+	saved := eventServer
+	eventServer = newTestSyncMapServerConn(t)
+	eventServer.Set(campaignControllerKey, state)
+	return func() { eventServer = saved }
+}
+
+// 有効でも無効でも返す段階は範囲に収まる
+func TestResetCampaignControllerClamps(t *testing.T) {
+	tests := []struct {
+		name  string
+		state campaignControllerState
+		level int
+		want  int
+	}{
+		{"disabled", campaignControllerState{MinLevel: 0, MaxLevel: 4}, 3, 3},
+		{"disabled above the range", campaignControllerState{MinLevel: 0, MaxLevel: 4}, 100, 4},
+		{"disabled below the range", campaignControllerState{MinLevel: 1, MaxLevel: 3}, 0, 1},
+		{"first start", campaignControllerState{Enabled: true, MinLevel: 0, MaxLevel: 2}, 4, 2},
+		{"keeps the decided level", campaignControllerState{Enabled: true, MinLevel: 0, MaxLevel: 4, Level: 1, LastChangeAt: time.Now()}, 4, 1},
+	}
+	for _, test := range tests {
+		restore := useTestCampaignControllerState(t, test.state)
+		if got := resetCampaignController(test.level); got != test.want {
+			t.Errorf("%s: resetCampaignController(%d) = %d, want %d", test.name, test.level, got, test.want)
+		}
+		restore()
+	}
+}
+
+func TestDefaultCampaignControllerStateRange(t *testing.T) {
+	defer os.Unsetenv("ISUCARI_CAMPAIGN_MIN")
+	defer os.Unsetenv("ISUCARI_CAMPAIGN_MAX")
+	os.Setenv("ISUCARI_CAMPAIGN_MIN", "9")
+	os.Setenv("ISUCARI_CAMPAIGN_MAX", "12")
+	state := defaultCampaignControllerState()
+	if state.MinLevel != campaignMaxLevel || state.MaxLevel != campaignMaxLevel {
+		t.Errorf("range = %d-%d, want %d-%d", state.MinLevel, state.MaxLevel, campaignMaxLevel, campaignMaxLevel)
+	}
+}
@@ -0,0 +1,301 @@
+package main
+
+// キャンペーンとポイント
+//
+// キャンペーンは段階 (Level, 0-4)・ポイントの還元率 (Rate, %)・期間・対象のカテゴリを持ち、
+// campaignServer に ID をキーにして保存する。
+// /initialize ではストアを消さないので、:9876 の /debug/campaigns で書き換えれば再起動せずに切り替わる。
+// 空の時は ISUCARI_CAMPAIGN_LEVEL (無ければ 4) と ISUCARI_CAMPAIGN_RATE (無ければ 4) の常設のキャンペーンを作る。
+// /complete で取引が終わると、その時に有効なキャンペーンのうち商品のカテゴリが対象で一番還元率の高いもので
+// 購入者にポイント (価格 × Rate / 100) を付ける。ポイントは pointServer にユーザー毎に持つ。
+// /initialize が返す campaign はベンチマーカーの負荷の段階で、還元率とは別。
+// カテゴリを絞っていない有効なキャンペーンの一番高い段階を返す。
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/isucon/isucon9-qualify/webapp/go/internal/kvtypes"
+)
+
+const (
+	campaignLevelEnv        = "ISUCARI_CAMPAIGN_LEVEL"
+	campaignRateEnv         = "ISUCARI_CAMPAIGN_RATE"
+	campaignDefaultLevel    = 4
+	campaignDefaultRate     = 4
+	campaignDefaultID       = "default"
+	campaignMaxLevel        = 4 // ベンチマーカーが受け付ける最大
+	campaignMaxRate         = 100
+	pointHistoryLimit       = 50
+	pointHistoryPublicLimit = 20
+)
+
+type campaign kvtypes.Campaign
+
+func (this *campaign) activeAt(now time.Time) bool {
+	if !this.StartAt.IsZero() && now.Before(this.StartAt) {
+		return false
+	}
+	if !this.EndAt.IsZero() && !now.Before(this.EndAt) {
+		return false
+	}
+	return true
+}
+
+func (this *campaign) eligible(categoryID, rootCategoryID int) bool {
+	if len(this.CategoryIDs) == 0 {
+		return true
+	}
+	for _, id := range this.CategoryIDs {
+		if id == categoryID || id == rootCategoryID {
+			return true
+		}
+	}
+	return false
+}
+
+type pointGrant = kvtypes.PointGrant
+
+type userPoints = kvtypes.UserPoints
+
+func allCampaigns() []campaign {
+	keys := campaignServer.AllKeys()
+	mGot := campaignServer.MGet(keys)
+	campaigns := make([]campaign, 0, len(keys))
+	for _, key := range keys {
+		c := campaign{}
+		if mGot.Get(key, &c) {
+			campaigns = append(campaigns, c)
+		}
+	}
+	sort.Slice(campaigns, func(i, j int) bool { return campaigns[i].ID < campaigns[j].ID })
+	return campaigns
+}
+
+// 今の段階 (/initialize で返す)
+func currentCampaignLevel(now time.Time) int {
+	level := 0
+	for _, c := range allCampaigns() {
+		if c.activeAt(now) && len(c.CategoryIDs) == 0 && c.Level > level {
+			level = c.Level
+		}
+	}
+	return clampCampaignLevel(level)
+}
+
+func clampCampaignLevel(level int) int {
+	if level < 0 {
+		return 0
+	}
+	if level > campaignMaxLevel {
+		return campaignMaxLevel
+	}
+	return level
+}
+
+// 商品に使えるキャンペーン。無ければ ok = false
+func campaignForItem(categoryID, rootCategoryID int, now time.Time) (best campaign, ok bool) {
+	for _, c := range allCampaigns() {
+		if !c.activeAt(now) || !c.eligible(categoryID, rootCategoryID) || c.Rate <= 0 {
+			continue
+		}
+		if !ok || c.Rate > best.Rate {
+			best, ok = c, true
+		}
+	}
+	return best, ok
+}
+
+func ensureDefaultCampaign() {
+	if !isMasterServerIP || campaignServer.DBSize() > 0 {
+		return
+	}
+	campaignServer.Set(campaignDefaultID, campaign{
+		ID:        campaignDefaultID,
+		Level:     campaignEnvInt(campaignLevelEnv, campaignDefaultLevel, campaignMaxLevel),
+		Rate:      campaignEnvInt(campaignRateEnv, campaignDefaultRate, campaignMaxRate),
+		UpdatedAt: time.Now(),
+	})
+}
+
+// 0 - max に収まらなければ defaultValue
+func campaignEnvInt(name string, defaultValue, max int) int {
+	value := os.Getenv(name)
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil || parsed < 0 || parsed > max {
+		log.Println("campaign: invalid", name, value)
+		return defaultValue
+	}
+	return parsed
+}
+
+// 取引が終わった時に購入者にポイントを付ける。付けたポイントを返す。
+// 還元率はキャンペーンの Rate のままで、campaigncontroller.go が段階を下げても変えない
+func grantCampaignPoints(transactionEvidence TransactionEvidence, now time.Time) int {
+	c, ok := campaignForItem(transactionEvidence.ItemCategoryID, transactionEvidence.ItemRootCategoryID, now)
+	if !ok {
+		return 0
+	}
+	points := transactionEvidence.ItemPrice * c.Rate / 100
+	if points <= 0 {
+		return 0
+	}
+	key := strconv.Itoa(int(transactionEvidence.BuyerID))
+	pointServer.Transaction(key, func(tx KeyValueStoreConn) {
+		up := userPoints{}
+		tx.Get(key, &up)
+		up.Balance += points
+		up.History = append([]pointGrant{{
+			TransactionEvidenceID: transactionEvidence.ID,
+			ItemID:                transactionEvidence.ItemID,
+			CampaignID:            c.ID,
+			Rate:                  c.Rate,
+			Points:                points,
+			CreatedAt:             now,
+		}}, up.History...)
+		if len(up.History) > pointHistoryLimit {
+			up.History = up.History[:pointHistoryLimit]
+		}
+		up.UpdatedAt = now
+		tx.Set(key, up)
+	})
+	return points
+}
+
+type resPointGrant struct {
+	TransactionEvidenceID int64  `json:"transaction_evidence_id"`
+	ItemID                int64  `json:"item_id"`
+	CampaignID            string `json:"campaign_id"`
+	Rate                  int    `json:"rate"`
+	Points                int    `json:"points"`
+	CreatedAt             int64  `json:"created_at"`
+}
+
+type resPoints struct {
+	Balance int             `json:"balance"`
+	History []resPointGrant `json:"history"`
+}
+
+func getPoints(w http.ResponseWriter, r *http.Request) {
+	user, errCode, errMsg := getUser(r)
+	if errMsg != "" {
+		outputErrorMsg(w, errCode, errMsg)
+		return
+	}
+	up := userPoints{}
+	pointServer.Get(strconv.Itoa(int(user.ID)), &up)
+	res := resPoints{Balance: up.Balance, History: []resPointGrant{}}
+	for i, grant := range up.History {
+		if i >= pointHistoryPublicLimit {
+			break
+		}
+		res.History = append(res.History, resPointGrant{
+			TransactionEvidenceID: grant.TransactionEvidenceID,
+			ItemID:                grant.ItemID,
+			CampaignID:            grant.CampaignID,
+			Rate:                  grant.Rate,
+			Points:                grant.Points,
+			CreatedAt:             grant.CreatedAt.Unix(),
+		})
+	}
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	json.NewEncoder(w).Encode(res)
+}
+
+// :9876 のデバッグ用。時刻は unix 秒で 0 なら無期限
+type debugCampaign struct {
+	ID          string `json:"id"`
+	Level       int    `json:"level"`
+	Rate        int    `json:"rate"`
+	StartAt     int64  `json:"start_at"`
+	EndAt       int64  `json:"end_at"`
+	CategoryIDs []int  `json:"category_ids"`
+	Active      bool   `json:"active"`
+}
+
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+func timeOrZero(unix int64) time.Time {
+	if unix == 0 {
+		return time.Time{}
+	}
+	return time.Unix(unix, 0)
+}
+
+// GET で一覧、POST で ID の一致するものを作るか置き換える
+func debugCampaigns(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPost:
+		dc := debugCampaign{}
+		if err := json.NewDecoder(r.Body).Decode(&dc); err != nil {
+			outputErrorMsg(w, http.StatusBadRequest, "json decode error")
+			return
+		}
+		if dc.ID == "" || dc.Level < 0 || dc.Level > campaignMaxLevel || dc.Rate < 0 || dc.Rate > campaignMaxRate {
+			outputErrorMsg(w, http.StatusBadRequest, "id is required, level must be 0-4 and rate must be 0-100")
+			return
+		}
+		if dc.StartAt != 0 && dc.EndAt != 0 && dc.EndAt <= dc.StartAt {
+			outputErrorMsg(w, http.StatusBadRequest, "end_at must be after start_at")
+			return
+		}
+		campaignServer.Set(dc.ID, campaign{
+			ID:          dc.ID,
+			Level:       dc.Level,
+			Rate:        dc.Rate,
+			StartAt:     timeOrZero(dc.StartAt),
+			EndAt:       timeOrZero(dc.EndAt),
+			CategoryIDs: dc.CategoryIDs,
+			UpdatedAt:   time.Now(),
+		})
+	default:
+		outputErrorMsg(w, http.StatusMethodNotAllowed, "GET or POST only")
+		return
+	}
+	now := time.Now()
+	res := struct {
+		CurrentLevel int             `json:"current_level"`
+		Campaigns    []debugCampaign `json:"campaigns"`
+	}{CurrentLevel: currentCampaignLevel(now), Campaigns: []debugCampaign{}}
+	for _, c := range allCampaigns() {
+		res.Campaigns = append(res.Campaigns, debugCampaign{
+			ID:          c.ID,
+			Level:       c.Level,
+			Rate:        c.Rate,
+			StartAt:     unixOrZero(c.StartAt),
+			EndAt:       unixOrZero(c.EndAt),
+			CategoryIDs: c.CategoryIDs,
+			Active:      c.activeAt(now),
+		})
+	}
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	json.NewEncoder(w).Encode(res)
+}
+
+func postDebugCampaignsDelete(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		outputErrorMsg(w, http.StatusMethodNotAllowed, "POST only")
+		return
+	}
+	id := r.URL.Query().Get("id")
+	if id == "" || !campaignServer.Exists(id) {
+		outputErrorMsg(w, http.StatusNotFound, "campaign not found")
+		return
+	}
+	campaignServer.Del(id)
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	w.Write([]byte("{}"))
+}
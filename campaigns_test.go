@@ -0,0 +1,36 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func useTestCampaignServer(t *testing.T) (restore func()) {
+	saved := campaignServer
+	campaignServer = newTestSyncMapServerConn(t)
+	campaignServer.server.NewValueFunction = func() interface{} { return &campaign{} }
+	return func() { campaignServer = saved }
+}
+
+// /initialize の段階は還元率とは別で、0 - campaignMaxLevel に収める
+func TestCurrentCampaignLevel(t *testing.T) {
+	defer useTestCampaignServer(t)()
+	now := time.Unix(1565575823, 0)
+	if got := currentCampaignLevel(now); got != 0 {
+		t.Errorf("no campaigns: level = %d", got)
+	}
+	campaignServer.Set("default", campaign{ID: "default", Level: 2, Rate: 100})
+	if got := currentCampaignLevel(now); got != 2 {
+		t.Errorf("level = %d, want 2", got)
+	}
+	// カテゴリを絞ったものと期間外のものは見ない
+	campaignServer.Set("category", campaign{ID: "category", Level: 3, CategoryIDs: []int{10}})
+	campaignServer.Set("ended", campaign{ID: "ended", Level: 3, EndAt: now})
+	if got := currentCampaignLevel(now); got != 2 {
+		t.Errorf("level = %d, want 2", got)
+	}
+	campaignServer.Set("too-high", campaign{ID: "too-high", Level: 50})
+	if got := currentCampaignLevel(now); got != campaignMaxLevel {
+		t.Errorf("level = %d, want %d", got, campaignMaxLevel)
+	}
+}
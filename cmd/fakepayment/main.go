@@ -0,0 +1,210 @@
+// fakepayment は手元で決済の流れを試すための payment service の代わり。
+//
+//	fakepayment -addr :5555
+//
+// 状態はメモリにしか持たない。カードのトークンは "invalid" を含めば invalid、"fail" で始まれば fail(残高不足)、
+// それ以外は全て ok として扱う。/token は従来通りの即時決済で、/authorize /capture /refund が二段階の決済。
+// GET /payments で今までの決済の一覧を JSON で返す。
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	statusAuthorized = "authorized"
+	statusCaptured   = "captured"
+	statusRefunded   = "refunded"
+)
+
+type payment struct {
+	ID        string    `json:"payment_id"`
+	ShopID    string    `json:"shop_id"`
+	Token     string    `json:"token"`
+	Price     int       `json:"price"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type reqCharge struct {
+	ShopID string `json:"shop_id"`
+	Token  string `json:"token"`
+	APIKey string `json:"api_key"`
+	Price  int    `json:"price"`
+}
+
+type reqOperation struct {
+	ShopID    string `json:"shop_id"`
+	APIKey    string `json:"api_key"`
+	PaymentID string `json:"payment_id"`
+}
+
+type server struct {
+	shopID   string
+	apiKey   string
+	mutex    sync.Mutex
+	payments map[string]*payment
+}
+
+func main() {
+	addr := flag.String("addr", ":5555", "listen address")
+	shopID := flag.String("shop-id", "11", "受け付ける shop_id")
+	apiKey := flag.String("api-key", "a15400e46c83635eb181-946abb51ff26a868317c", "受け付ける api_key")
+	flag.Parse()
+
+	s := &server{shopID: *shopID, apiKey: *apiKey, payments: map[string]*payment{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", s.postToken)
+	mux.HandleFunc("/authorize", s.postAuthorize)
+	mux.HandleFunc("/capture", s.postCapture)
+	mux.HandleFunc("/refund", s.postRefund)
+	mux.HandleFunc("/payments", s.getPayments)
+	log.Println("fakepayment listening on", *addr)
+	log.Fatal(http.ListenAndServe(*addr, mux))
+}
+
+func outputJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func outputError(w http.ResponseWriter, status int, msg string) {
+	outputJSON(w, status, map[string]string{"error": msg})
+}
+
+func cardStatus(token string) string {
+	switch {
+	case token == "" || strings.Contains(token, "invalid"):
+		return "invalid"
+	case strings.HasPrefix(token, "fail"):
+		return "fail"
+	}
+	return "ok"
+}
+
+func newPaymentID() string {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+func (this *server) decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if r.Method != http.MethodPost {
+		outputError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return false
+	}
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		outputError(w, http.StatusBadRequest, "json decode error")
+		return false
+	}
+	return true
+}
+
+func (this *server) authorized(w http.ResponseWriter, shopID, apiKey string) bool {
+	if shopID != this.shopID || apiKey != this.apiKey {
+		outputError(w, http.StatusForbidden, "invalid shop_id or api_key")
+		return false
+	}
+	return true
+}
+
+// カードが使えれば status で新しい決済を作る
+func (this *server) charge(w http.ResponseWriter, r *http.Request, status string) {
+	req := reqCharge{}
+	if !this.decode(w, r, &req) || !this.authorized(w, req.ShopID, req.APIKey) {
+		return
+	}
+	if req.Price <= 0 {
+		outputError(w, http.StatusBadRequest, "invalid price")
+		return
+	}
+	if result := cardStatus(req.Token); result != "ok" {
+		outputJSON(w, http.StatusOK, map[string]string{"status": result})
+		return
+	}
+	now := time.Now()
+	p := &payment{
+		ID:        newPaymentID(),
+		ShopID:    req.ShopID,
+		Token:     req.Token,
+		Price:     req.Price,
+		Status:    status,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	this.mutex.Lock()
+	this.payments[p.ID] = p
+	this.mutex.Unlock()
+	outputJSON(w, http.StatusOK, map[string]string{"status": "ok", "payment_id": p.ID})
+}
+
+func (this *server) postToken(w http.ResponseWriter, r *http.Request) {
+	this.charge(w, r, statusCaptured)
+}
+
+func (this *server) postAuthorize(w http.ResponseWriter, r *http.Request) {
+	this.charge(w, r, statusAuthorized)
+}
+
+// 既に to になっているものは何もせずに ok を返す(再試行できるように)
+func (this *server) operate(w http.ResponseWriter, r *http.Request, to string, from ...string) {
+	req := reqOperation{}
+	if !this.decode(w, r, &req) || !this.authorized(w, req.ShopID, req.APIKey) {
+		return
+	}
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	p, ok := this.payments[req.PaymentID]
+	if !ok || p.ShopID != req.ShopID {
+		outputError(w, http.StatusNotFound, "payment not found")
+		return
+	}
+	if p.Status != to {
+		allowed := false
+		for _, status := range from {
+			if p.Status == status {
+				allowed = true
+			}
+		}
+		if !allowed {
+			outputError(w, http.StatusConflict, "payment is "+p.Status)
+			return
+		}
+		p.Status = to
+		p.UpdatedAt = time.Now()
+	}
+	outputJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+func (this *server) postCapture(w http.ResponseWriter, r *http.Request) {
+	this.operate(w, r, statusCaptured, statusAuthorized)
+}
+
+// 与信の取り消しと確定後の返金のどちらも受け付ける
+func (this *server) postRefund(w http.ResponseWriter, r *http.Request) {
+	this.operate(w, r, statusRefunded, statusAuthorized, statusCaptured)
+}
+
+func (this *server) getPayments(w http.ResponseWriter, r *http.Request) {
+	this.mutex.Lock()
+	payments := make([]payment, 0, len(this.payments))
+	for _, p := range this.payments {
+		payments = append(payments, *p)
+	}
+	this.mutex.Unlock()
+	sort.Slice(payments, func(i, j int) bool { return payments[i].CreatedAt.Before(payments[j].CreatedAt) })
+	outputJSON(w, http.StatusOK, payments)
+}
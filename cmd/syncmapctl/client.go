@@ -0,0 +1,308 @@
+package main
+
+// SyncMapServer のプロトコルを喋る最小のクライアント。
+// webapp 本体は package main なので import できない。プロトコルを変えたらこちらも揃えること。
+//  packet := 長さ 4B (little endian) + join([command, args...])
+import (
+	"errors"
+	"io"
+	"net"
+	"time"
+
+	"github.com/shamaton/msgpack"
+)
+
+const ( // webapp の syncMapCommand* と同じ
+	commandGet        = "G"
+	commandSet        = "S"
+	commandDel        = "D"
+	commandDBSize     = "L"
+	commandAllKeys    = "ALLKEYS"
+	commandLRange     = "LRANGE"
+	commandLLen       = "LLEN"
+	commandIsLocked   = "LI"
+	commandFlushAll   = "FLUSHALL"
+	commandInitialize = "INITIALIZE"
+	commandSave       = "SAVE"
+	commandLoad       = "LOAD"
+	commandExport     = "EXPORT"
+	commandImport     = "IMPORT"
+	commandReencode   = "REENCODE"
+	commandPublish    = "PUBLISH"
+	commandSubscribe  = "SUBSCRIBE"
+	commandIQuery     = "IQUERY"
+)
+
+type client struct {
+	addr string
+	conn net.Conn
+}
+
+func newClient(addr string) (*client, error) {
+	conn, err := net.DialTimeout("tcp", addr, 3*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	return &client{addr: addr, conn: conn}, nil
+}
+
+func (c *client) Close() error {
+	return c.conn.Close()
+}
+
+func (c *client) send(command string, args ...[]byte) ([]byte, error) {
+	packet := make([][]byte, 0, len(args)+1)
+	packet = append(packet, []byte(command))
+	packet = append(packet, args...)
+	body := join(packet)
+	if _, err := c.conn.Write(append(format32bit(len(body)), body...)); err != nil {
+		return nil, err
+	}
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(c.conn, header); err != nil {
+		return nil, err
+	}
+	result := make([]byte, parse32bit(header))
+	if _, err := io.ReadFull(c.conn, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// 値が存在しなければ nil
+func (c *client) Get(key string) ([]byte, error) {
+	res, err := c.send(commandGet, []byte(key))
+	if err != nil || len(res) == 0 {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *client) Set(key string, encoded []byte) error {
+	_, err := c.send(commandSet, []byte(key), encoded)
+	return err
+}
+
+func (c *client) Del(key string) error {
+	_, err := c.send(commandDel, []byte(key))
+	return err
+}
+
+func (c *client) LRange(key string, start, stop int) ([][]byte, error) {
+	res, err := c.send(commandLRange, []byte(key), encode(start), encode(stop))
+	if err != nil || len(res) == 0 {
+		return [][]byte{}, err
+	}
+	return split(res), nil
+}
+
+func (c *client) LLen(key string) (int, error) {
+	res, err := c.send(commandLLen, []byte(key))
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	err = msgpack.Decode(res, &n)
+	return n, err
+}
+
+func (c *client) AllKeys() ([]string, error) {
+	res, err := c.send(commandAllKeys)
+	if err != nil {
+		return nil, err
+	}
+	return splitBytesToStrs(res), nil
+}
+
+func (c *client) DBSize() (int, error) {
+	res, err := c.send(commandDBSize)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	err = msgpack.Decode(res, &n)
+	return n, err
+}
+
+func (c *client) IsLockedKey(key string) (bool, error) {
+	res, err := c.send(commandIsLocked, []byte(key))
+	if err != nil {
+		return false, err
+	}
+	locked := false
+	err = msgpack.Decode(res, &locked)
+	return locked, err
+}
+
+func (c *client) FlushAll() error {
+	_, err := c.send(commandFlushAll)
+	return err
+}
+
+func (c *client) Initialize() error {
+	_, err := c.send(commandInitialize)
+	return err
+}
+
+// path が空ならサーバー側のデフォルトのバックアップ先
+func (c *client) Save(path string) error {
+	return c.sendWithErrorMessage(commandSave, []byte(path))
+}
+
+func (c *client) Load(path string) error {
+	return c.sendWithErrorMessage(commandLoad, []byte(path))
+}
+
+// NDJSON で全てのキーを取得する (値はサーバー側の型でデコードされる)
+// 途中で失敗した時はそこまでの NDJSON とエラーを返す
+func (c *client) Export() ([]byte, error) {
+	res, err := c.send(commandExport)
+	if err != nil {
+		return nil, err
+	}
+	splitted := split(res)
+	if len(splitted[1]) != 0 {
+		return splitted[0], errors.New(string(splitted[1]))
+	}
+	return splitted[0], nil
+}
+
+// NDJSON を読み込ませる。読み込めた行数を返す
+func (c *client) Import(ndjson []byte) (int, error) {
+	res, err := c.send(commandImport, ndjson)
+	if err != nil {
+		return 0, err
+	}
+	splitted := split(res)
+	n := 0
+	if err := msgpack.Decode(splitted[0], &n); err != nil {
+		return 0, err
+	}
+	if len(splitted[1]) != 0 {
+		return n, errors.New(string(splitted[1]))
+	}
+	return n, nil
+}
+
+// codec を変えて全ての値を書き直させる。書き直せた値の数を返す
+func (c *client) Reencode(codecName string) (int, error) {
+	res, err := c.send(commandReencode, []byte(codecName))
+	if err != nil {
+		return 0, err
+	}
+	splitted := split(res)
+	n := 0
+	if err := msgpack.Decode(splitted[0], &n); err != nil {
+		return 0, err
+	}
+	if len(splitted[1]) != 0 {
+		return n, errors.New(string(splitted[1]))
+	}
+	return n, nil
+}
+
+// 受け取った購読の数を返す
+func (c *client) Publish(channel string, payload []byte) (int, error) {
+	res, err := c.send(commandPublish, []byte(channel), payload)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	err = msgpack.Decode(res, &n)
+	return n, err
+}
+
+// 専用のコネクションを張って、切れるまでメッセージを f に渡し続ける
+func (c *client) Subscribe(patterns []string, f func(channel string, payload []byte)) error {
+	sub, err := newClient(c.addr)
+	if err != nil {
+		return err
+	}
+	defer sub.Close()
+	joined := make([][]byte, len(patterns))
+	for i, pattern := range patterns {
+		joined[i] = []byte(pattern)
+	}
+	if _, err := sub.send(commandSubscribe, join(joined)); err != nil {
+		return err
+	}
+	header := make([]byte, 4)
+	for {
+		if _, err := io.ReadFull(sub.conn, header); err != nil {
+			return err
+		}
+		packet := make([]byte, parse32bit(header))
+		if _, err := io.ReadFull(sub.conn, packet); err != nil {
+			return err
+		}
+		message := split(packet)
+		f(string(message[0]), message[1])
+	}
+}
+
+// インデックスで value に載っているキーを新しい順 (sortKey の降順) に返す。limit が 0 以下なら全て
+func (c *client) IQuery(index, value string, limit int) ([]string, error) {
+	res, err := c.send(commandIQuery, []byte(index), []byte(value), []byte(""), encode(limit), encode(true))
+	if err != nil {
+		return nil, err
+	}
+	splitted := split(res)
+	if len(splitted[1]) != 0 {
+		return nil, errors.New(string(splitted[1]))
+	}
+	return splitBytesToStrs(splitted[0]), nil
+}
+
+// 空の応答は成功、それ以外はエラーメッセージ
+func (c *client) sendWithErrorMessage(command string, args ...[]byte) error {
+	res, err := c.send(command, args...)
+	if err != nil {
+		return err
+	}
+	if len(res) != 0 {
+		return errors.New(string(res))
+	}
+	return nil
+}
+
+// webapp の encodeToBytes と同じ
+func encode(x interface{}) []byte {
+	d, _ := msgpack.Encode(&x)
+	return d
+}
+
+// bytes utils (webapp と同じ形式)
+func parse32bit(input []byte) int {
+	return int(input[0]) | int(input[1])<<8 | int(input[2])<<16 | int(input[3])<<24
+}
+func format32bit(input int) []byte {
+	return []byte{byte(input), byte(input >> 8), byte(input >> 16), byte(input >> 24)}
+}
+func join(input [][]byte) []byte {
+	result := format32bit(len(input))
+	for _, bs := range input {
+		result = append(result, format32bit(len(bs))...)
+		result = append(result, bs...)
+	}
+	return result
+}
+func split(input []byte) [][]byte {
+	num := parse32bit(input[:4])
+	now := 4
+	result := make([][]byte, num)
+	for i := 0; i < num; i++ {
+		bsLen := parse32bit(input[now : now+4])
+		now += 4
+		result[i] = input[now : now+bsLen]
+		now += bsLen
+	}
+	return result
+}
+func splitBytesToStrs(input []byte) []string {
+	splitted := split(input)
+	result := make([]string, len(splitted))
+	for i, bs := range splitted {
+		result[i] = string(bs)
+	}
+	return result
+}
@@ -0,0 +1,435 @@
+// syncmapctl は SyncMapServer に直接つないで中身を覗いたり操作したりするためのコマンド。
+//
+//	syncmapctl -addr 127.0.0.1:8883 get 1
+//	syncmapctl -addr 127.0.0.1:8883 scan '1*' 10
+//	syncmapctl -addr 127.0.0.1:8884          # 引数が無ければ REPL
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const usage = `commands:
+  get KEY                  値を取得してデコードして表示
+  set KEY JSON             JSON を -type の型で msgpack にして保存
+  del KEY                  キーを削除
+  lrange KEY START STOP    リストを範囲取得 (0 -1 で全て)
+  scan [PATTERN] [COUNT]   キーを列挙 (PATTERN は path.Match 形式)
+  dbsize                   キーの数
+  locked KEY               キーがロック中か (IsLockedKey)
+  flushall                 全てのキーを削除
+  initialize               Initialize を実行
+  save [NAME]              スナップショットを書き出す (サーバー側の ./syncmapbackup-NAME。省略時はデフォルト)
+  load [NAME]              スナップショットを読み込む (サーバー側の ./syncmapbackup-NAME。省略時はデフォルト)
+  export [FILE]            全てのキーを NDJSON で書き出す (FILE はローカル。省略時は標準出力)
+  import FILE              NDJSON を読み込む (既存のキーは上書き)
+  reencode CODEC           codec (msgpack|gob|json|binary|legacy) を変えて全ての値を書き直す
+  publish CHANNEL MESSAGE  メッセージを送る
+  subscribe PATTERN...     パターン (path.Match 形式) にマッチするチャンネルのメッセージを表示し続ける
+                           (キースペース通知は __keyspace__:KEY)
+  iquery INDEX VALUE [COUNT]
+                           セカンダリインデックスで VALUE に載っているキーを新しい順に表示
+  type [NAME]              (REPL) デコードする型を変更
+  help                     これ
+  quit                     (REPL) 終了
+`
+
+type ctl struct {
+	client   *client
+	typeName string
+	indent   bool
+	force    bool // 確認なしで破壊的な操作をする
+	in       *bufio.Reader
+	out      io.Writer
+}
+
+func main() {
+	addr := flag.String("addr", "127.0.0.1:8884", "SyncMapServer address")
+	typeName := flag.String("type", "", "value type ("+valueTypeNames()+"). 空ならポート番号から推測")
+	indent := flag.Bool("indent", false, "JSON をインデントして表示")
+	force := flag.Bool("y", false, "flushall / load / initialize / reencode で確認しない")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: syncmapctl [flags] [command args...]\n\nflags:\n")
+		flag.PrintDefaults()
+		fmt.Fprint(flag.CommandLine.Output(), "\n"+usage)
+	}
+	flag.Parse()
+
+	c, err := newClient(*addr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer c.Close()
+
+	if *typeName == "" {
+		*typeName = guessTypeName(*addr)
+	}
+	if _, ok := valueTypes[*typeName]; !ok {
+		fmt.Fprintf(os.Stderr, "unknown type %q (%s)\n", *typeName, valueTypeNames())
+		os.Exit(2)
+	}
+	this := &ctl{
+		client:   c,
+		typeName: *typeName,
+		indent:   *indent,
+		force:    *force,
+		in:       bufio.NewReader(os.Stdin),
+		out:      os.Stdout,
+	}
+	if flag.NArg() == 0 {
+		this.repl(*addr)
+		return
+	}
+	if err := this.run(flag.Args()); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func guessTypeName(addr string) string {
+	index := strings.LastIndex(addr, ":")
+	if index < 0 {
+		return "auto"
+	}
+	if typeName, ok := defaultTypeOfPort[addr[index+1:]]; ok {
+		return typeName
+	}
+	return "auto"
+}
+
+func (this *ctl) repl(addr string) {
+	fmt.Fprintf(this.out, "connected to %s (type: %s). type 'help' for commands.\n", addr, this.typeName)
+	for {
+		fmt.Fprintf(this.out, "%s> ", addr)
+		line, err := this.in.ReadString('\n')
+		if err != nil && line == "" {
+			fmt.Fprintln(this.out)
+			return
+		}
+		args := splitArgs(strings.TrimSpace(line))
+		if len(args) == 0 {
+			continue
+		}
+		switch args[0] {
+		case "quit", "exit":
+			return
+		case "type":
+			if len(args) == 1 {
+				fmt.Fprintln(this.out, this.typeName)
+				continue
+			}
+			if _, ok := valueTypes[args[1]]; !ok {
+				fmt.Fprintf(this.out, "(error) unknown type %q (%s)\n", args[1], valueTypeNames())
+				continue
+			}
+			this.typeName = args[1]
+			continue
+		}
+		if err := this.run(args); err != nil {
+			fmt.Fprintln(this.out, "(error)", err)
+		}
+	}
+}
+
+func (this *ctl) run(args []string) error {
+	command := strings.ToLower(args[0])
+	args = args[1:]
+	need := func(n int) error {
+		if len(args) < n {
+			return fmt.Errorf("%s: not enough arguments", command)
+		}
+		return nil
+	}
+	switch command {
+	case "get":
+		if err := need(1); err != nil {
+			return err
+		}
+		encoded, err := this.client.Get(args[0])
+		if err != nil {
+			return err
+		}
+		if encoded == nil {
+			fmt.Fprintln(this.out, "(nil)")
+			return nil
+		}
+		return this.printValue(encoded)
+	case "set":
+		if err := need(2); err != nil {
+			return err
+		}
+		encoded, err := encodeFromJSON(strings.Join(args[1:], " "), this.typeName)
+		if err != nil {
+			return err
+		}
+		if err := this.client.Set(args[0], encoded); err != nil {
+			return err
+		}
+		fmt.Fprintln(this.out, "OK")
+	case "del":
+		if err := need(1); err != nil {
+			return err
+		}
+		if err := this.client.Del(args[0]); err != nil {
+			return err
+		}
+		fmt.Fprintln(this.out, "OK")
+	case "lrange":
+		if err := need(3); err != nil {
+			return err
+		}
+		start, err := strconv.Atoi(args[1])
+		if err != nil {
+			return err
+		}
+		stop, err := strconv.Atoi(args[2])
+		if err != nil {
+			return err
+		}
+		values, err := this.client.LRange(args[0], start, stop)
+		if err != nil {
+			return err
+		}
+		if len(values) == 0 {
+			fmt.Fprintln(this.out, "(empty list)")
+		}
+		for i, value := range values {
+			fmt.Fprintf(this.out, "%d) ", i)
+			if err := this.printValue(value); err != nil {
+				return err
+			}
+		}
+	case "scan":
+		pattern := "*"
+		if len(args) > 0 {
+			pattern = args[0]
+		}
+		count := -1
+		if len(args) > 1 {
+			n, err := strconv.Atoi(args[1])
+			if err != nil {
+				return err
+			}
+			count = n
+		}
+		keys, err := this.client.AllKeys()
+		if err != nil {
+			return err
+		}
+		sort.Strings(keys)
+		printed := 0
+		for _, key := range keys {
+			if count >= 0 && printed >= count {
+				break
+			}
+			matched, err := path.Match(pattern, key)
+			if err != nil {
+				return err
+			}
+			if !matched {
+				continue
+			}
+			fmt.Fprintln(this.out, key)
+			printed++
+		}
+	case "dbsize":
+		n, err := this.client.DBSize()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(this.out, n)
+	case "locked":
+		if err := need(1); err != nil {
+			return err
+		}
+		locked, err := this.client.IsLockedKey(args[0])
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(this.out, locked)
+	case "flushall":
+		if !this.confirm("flush all keys") {
+			return errors.New("canceled")
+		}
+		if err := this.client.FlushAll(); err != nil {
+			return err
+		}
+		fmt.Fprintln(this.out, "OK")
+	case "initialize":
+		if !this.confirm("re-initialize the store") {
+			return errors.New("canceled")
+		}
+		if err := this.client.Initialize(); err != nil {
+			return err
+		}
+		fmt.Fprintln(this.out, "OK")
+	case "save":
+		if err := this.client.Save(optionalArg(args)); err != nil {
+			return err
+		}
+		fmt.Fprintln(this.out, "OK")
+	case "load":
+		if !this.confirm("replace all keys with the snapshot") {
+			return errors.New("canceled")
+		}
+		if err := this.client.Load(optionalArg(args)); err != nil {
+			return err
+		}
+		fmt.Fprintln(this.out, "OK")
+	case "export":
+		exported, err := this.client.Export()
+		if err != nil {
+			return err
+		}
+		if len(args) == 0 {
+			_, err = this.out.Write(exported)
+			return err
+		}
+		return ioutil.WriteFile(args[0], exported, 0644)
+	case "import":
+		if err := need(1); err != nil {
+			return err
+		}
+		input, err := ioutil.ReadFile(args[0])
+		if err != nil {
+			return err
+		}
+		n, err := this.client.Import(input)
+		if err != nil {
+			return fmt.Errorf("imported %d keys: %v", n, err)
+		}
+		fmt.Fprintf(this.out, "imported %d keys\n", n)
+	case "reencode":
+		if err := need(1); err != nil {
+			return err
+		}
+		if !this.confirm("re-encode all values with " + args[0]) {
+			return errors.New("canceled")
+		}
+		n, err := this.client.Reencode(args[0])
+		if err != nil {
+			return fmt.Errorf("re-encoded %d values: %v", n, err)
+		}
+		fmt.Fprintf(this.out, "re-encoded %d values\n", n)
+	case "publish":
+		if err := need(2); err != nil {
+			return err
+		}
+		n, err := this.client.Publish(args[0], []byte(strings.Join(args[1:], " ")))
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(this.out, "received by %d subscriptions\n", n)
+	case "subscribe":
+		if err := need(1); err != nil {
+			return err
+		}
+		return this.client.Subscribe(args, func(channel string, payload []byte) {
+			fmt.Fprintf(this.out, "%s %s\n", channel, payload)
+		})
+	case "iquery":
+		if err := need(2); err != nil {
+			return err
+		}
+		count := 0
+		if len(args) > 2 {
+			n, err := strconv.Atoi(args[2])
+			if err != nil {
+				return err
+			}
+			count = n
+		}
+		keys, err := this.client.IQuery(args[0], args[1], count)
+		if err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			fmt.Fprintln(this.out, "(empty list)")
+		}
+		for _, key := range keys {
+			fmt.Fprintln(this.out, key)
+		}
+	case "help":
+		fmt.Fprint(this.out, usage)
+	default:
+		return fmt.Errorf("unknown command %q", command)
+	}
+	return nil
+}
+
+func (this *ctl) printValue(encoded []byte) error {
+	decoded, err := decodeToJSON(encoded, this.typeName, this.indent)
+	if err != nil {
+		// デコードできなければそのまま出す
+		fmt.Fprintf(this.out, "%q (decode error: %v)\n", encoded, err)
+		return nil
+	}
+	fmt.Fprintln(this.out, decoded)
+	return nil
+}
+
+func (this *ctl) confirm(what string) bool {
+	if this.force {
+		return true
+	}
+	fmt.Fprintf(this.out, "really %s on %s? [y/N] ", what, this.client.addr)
+	answer, _ := this.in.ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+func optionalArg(args []string) string {
+	if len(args) == 0 {
+		return ""
+	}
+	return args[0]
+}
+
+// 空白区切り。"..." / '...' で囲めば空白を含められる (JSON を set するため)
+func splitArgs(line string) []string {
+	var args []string
+	var current strings.Builder
+	var quote rune
+	inArg := false
+	for _, r := range line {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '"' || r == '\'':
+			if current.Len() == 0 && !inArg {
+				quote = r
+				inArg = true
+			} else {
+				current.WriteRune(r)
+			}
+		case r == ' ' || r == '\t':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/isucon/isucon9-qualify/webapp/go/internal/kvtypes"
+	"github.com/shamaton/msgpack"
+)
+
+// -type で指定できる型 (webapp と同じ internal/kvtypes の型)。 auto は msgpack をそのまま汎用的にデコードする
+var valueTypes = map[string]func() interface{}{
+	"auto":         func() interface{} { var x interface{}; return &x },
+	"item":         func() interface{} { return &kvtypes.Item{} },
+	"user":         func() interface{} { return &kvtypes.User{} },
+	"te":           func() interface{} { return &kvtypes.TransactionEvidence{} },
+	"shipping":     func() interface{} { return &kvtypes.Shipping{} },
+	"session":      func() interface{} { return &kvtypes.Session{} },
+	"login":        func() interface{} { return &kvtypes.LoginAttempt{} },
+	"2fa":          func() interface{} { return &kvtypes.TwoFactor{} },
+	"apitoken":     func() interface{} { return &kvtypes.APIToken{} },
+	"payment":      func() interface{} { return &kvtypes.Payment{} },
+	"campaign":     func() interface{} { return &kvtypes.Campaign{} },
+	"points":       func() interface{} { return &kvtypes.UserPoints{} },
+	"review":       func() interface{} { return &kvtypes.Review{} },
+	"like":         func() interface{} { return &kvtypes.ItemLike{} },
+	"comment":      func() interface{} { return &kvtypes.ItemComment{} },
+	"message":      func() interface{} { return &kvtypes.TransactionMessage{} },
+	"notification": func() interface{} { return &kvtypes.Notification{} },
+	"int":          func() interface{} { x := 0; return &x },
+	"string":       func() interface{} { x := ""; return &x },
+}
+
+// デフォルトのポートに保存されている型 (webapp/go/vars.go)
+var defaultTypeOfPort = map[string]string{
+	"8885": "string",
+	"8884": "user",
+	"8883": "item",
+	"8882": "shipping",
+	"8881": "te",
+	"8879": "session",
+	"8878": "login",
+	"8877": "2fa",
+	"8876": "apitoken",
+	"8875": "payment",
+	"8874": "campaign",
+	"8873": "points",
+	"8872": "review",
+	"8871": "like",
+	"8870": "int",
+	"8869": "comment",
+	"8867": "message",
+	"8865": "notification",
+}
+
+func valueTypeNames() string {
+	names := make([]string, 0, len(valueTypes))
+	for name := range valueTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, "|")
+}
+
+// 値を指定の型でデコードして JSON にする
+func decodeToJSON(encoded []byte, typeName string, indent bool) (string, error) {
+	newValue, ok := valueTypes[typeName]
+	if !ok {
+		return "", fmt.Errorf("unknown type %q (%s)", typeName, valueTypeNames())
+	}
+	value := newValue()
+	if err := kvtypes.DecodeWithCodec(encoded, value); err != nil {
+		return "", err
+	}
+	// webapp の NDJSON の Export と同じ形にする。json:"-" のフィールドを持つ型はフィールド名で全て出す
+	shown := kvtypes.NormalizeForJSON(value)
+	if kvtypes.JSONDropsFields(reflect.TypeOf(value)) {
+		shown = kvtypes.ExportFields(value)
+	}
+	var marshaled []byte
+	var err error
+	if indent {
+		marshaled, err = json.MarshalIndent(shown, "", "  ")
+	} else {
+		marshaled, err = json.Marshal(shown)
+	}
+	return string(marshaled), err
+}
+
+// JSON を指定の型で読んで msgpack にする。
+// 常に prefix 無しの msgpack で書く (サーバー側はストアの codec が何でも読める)
+func encodeFromJSON(input string, typeName string) ([]byte, error) {
+	newValue, ok := valueTypes[typeName]
+	if !ok {
+		return nil, fmt.Errorf("unknown type %q (%s)", typeName, valueTypeNames())
+	}
+	value := newValue()
+	if typeName == "string" && !strings.HasPrefix(input, "\"") {
+		// 文字列はクォートなしでも受け付ける
+		input = fmt.Sprintf("%q", input)
+	}
+	var err error
+	if kvtypes.JSONDropsFields(reflect.TypeOf(value)) {
+		err = kvtypes.ImportFields(json.RawMessage(input), value)
+	} else {
+		err = json.Unmarshal([]byte(input), value)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if typeName == "auto" {
+		value = *(value.(*interface{}))
+	}
+	return msgpack.Encode(value)
+}
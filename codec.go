@@ -0,0 +1,88 @@
+package main
+
+// ストアの値のエンコード方式の切り替え。codec そのものは internal/kvtypes (syncmapctl と共有)
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/isucon/isucon9-qualify/webapp/go/internal/kvtypes"
+)
+
+// atomic.Value は nil や違う型を入れられないので包んでおく
+type valueCodecHolder struct {
+	codec kvtypes.ValueCodec
+}
+
+func (this *SyncMapServer) SetCodec(codec kvtypes.ValueCodec) {
+	this.codec.Store(valueCodecHolder{codec})
+}
+func (this *SyncMapServer) GetCodec() kvtypes.ValueCodec {
+	holder, _ := this.codec.Load().(valueCodecHolder)
+	return holder.codec
+}
+
+// REENCODE : ストアの Codec を codecName に変えて、保存済みの値を全てそれで書き直す。
+// codecName が空なら今の Codec のまま、"legacy" なら tag 無しの msgpack に戻す。
+// NewValueFunction の型で読めない値(IncrBy したカウンタなど)はそのまま残す。
+// 変わるのは master の Codec だけなので、slave 側の Codec は setInitializeFunction で揃えること
+func (this *SyncMapServerConn) Reencode(codecName string) (int, error) {
+	if !this.IsMasterServer() {
+		res := split(this.send(syncMapCommandReencode, []byte(codecName)))
+		return decodeInt(res[0]), decodeError(res[1])
+	}
+	codec := this.server.GetCodec()
+	switch codecName {
+	case "":
+	case "legacy":
+		codec = nil
+	default:
+		found, ok := kvtypes.ValueCodecByName(codecName)
+		if !ok {
+			return 0, fmt.Errorf("%v: %q", kvtypes.ErrCodecUnknownTag, codecName)
+		}
+		codec = found
+	}
+	if this.server.NewValueFunction == nil {
+		return 0, errors.New("codec: NewValueFunction is not set")
+	}
+	this.server.SetCodec(codec)
+	reencode := func(encoded []byte) ([]byte, bool) {
+		value := this.server.NewValueFunction()
+		if decodeFromBytes(encoded, value) != nil {
+			return encoded, false
+		}
+		return this.server.encodeValue(reflect.ValueOf(value).Elem().Interface()), true
+	}
+	reencoded := 0
+	for _, key := range this.AllKeys() {
+		conn := this.New()
+		conn.lockKeysDirect([]string{key})
+		loaded, ok := conn.loadDirect(key)
+		if ok {
+			if bs, isBytes := loaded.([]byte); isBytes {
+				if result, ok := reencode(bs); ok {
+					conn.storeDirect(key, result)
+					reencoded++
+				}
+			} else {
+				list := loaded.([][]byte)
+				results := make([][]byte, len(list))
+				for i, bs := range list {
+					result, ok := reencode(bs)
+					results[i] = result
+					if ok {
+						reencoded++
+					}
+				}
+				conn.storeDirect(key, results)
+			}
+		}
+		conn.unlockKeysDirect([]string{key})
+	}
+	return reencoded, nil
+}
+func (this *SyncMapServerConn) parseReencode(input [][]byte) []byte {
+	n, err := this.Reencode(string(input[1]))
+	return join([][]byte{encodeToBytes(n), encodeError(err)})
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/isucon/isucon9-qualify/webapp/go/internal/kvtypes"
+)
+
+// 読み書きしている最中に Reencode で Codec を変えても、どの値も読める
+func TestReencodeWhileWriting(t *testing.T) {
+	conn := newTestSyncMapServerConn(t)
+	conn.server.NewValueFunction = func() interface{} { return &Item{} }
+	conn.server.SetCodec(kvtypes.BinaryCodec{})
+	item := Item{ID: 1, SellerID: 2, Status: ItemStatusOnSale, Name: "椅子", Price: 1000, CreatedAt: time.Unix(1565575823, 0)}
+	for i := 0; i < 20; i++ {
+		conn.Set(strconv.Itoa(i), item)
+	}
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; ; i++ {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			key := strconv.Itoa(i % 20)
+			conn.Set(key, item)
+			got := Item{}
+			if !conn.Get(key, &got) || got.Name != item.Name {
+				t.Errorf("Get(%s) = %+v", key, got)
+				return
+			}
+		}
+	}()
+	for _, name := range []string{"json", "binary", "legacy", "gob", "binary"} {
+		if _, err := conn.Reencode(name); err != nil {
+			t.Errorf("Reencode(%s): %v", name, err)
+		}
+	}
+	close(done)
+	wg.Wait()
+	if _, ok := conn.server.GetCodec().(kvtypes.BinaryCodec); !ok {
+		t.Errorf("codec = %v", conn.server.GetCodec())
+	}
+}
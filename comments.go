@@ -0,0 +1,246 @@
+package main
+
+// 商品ページの公開 Q&A コメント
+//
+// 販売中 (on_sale) の商品にだけ書き込める。出品者の書き込みは is_seller で返すので、フロントで返信として目立たせる。
+// 自分のコメントはいつでも消せる。ID は item_comments への INSERT で採番するので同期で書き、
+// commentServer にコメント ID をキーにして保存する。商品毎の数は counterServer に "comments:itemId" で IncrBy して持つ。
+// 一覧は古い順で、getUserItems と同じように最後のコメントの created_at / comment_id をカーソルにする。
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/isucon/isucon9-qualify/webapp/go/internal/kvtypes"
+	"goji.io/pat"
+)
+
+const (
+	commentMaxLength    = 500 // 文字数
+	indexCommentsByItem = "comments.item"
+)
+
+type itemComment = kvtypes.ItemComment
+
+type reqPostComment struct {
+	CSRFToken string `json:"csrf_token"`
+	ItemID    int64  `json:"item_id"`
+	Body      string `json:"body"`
+}
+
+type reqDeleteComment struct {
+	CSRFToken string `json:"csrf_token"`
+	CommentID int64  `json:"comment_id"`
+}
+
+type resComment struct {
+	ID        int64       `json:"id"`
+	ItemID    int64       `json:"item_id"`
+	User      *UserSimple `json:"user"`
+	IsSeller  bool        `json:"is_seller"`
+	Body      string      `json:"body"`
+	CreatedAt int64       `json:"created_at"`
+}
+
+type resItemComments struct {
+	Comments []resComment `json:"comments"`
+	HasNext  bool         `json:"has_next"`
+}
+
+func registerCommentIndexes() {
+	commentServer.server.RegisterIndex(indexCommentsByItem, func(value interface{}) ([]string, string) {
+		comment := value.(*itemComment)
+		return []string{strconv.Itoa(int(comment.ItemID))}, formatTimeDateID(comment.CreatedAt.Unix(), comment.ID)
+	})
+}
+
+func itemCommentCount(itemID int64) int {
+	count := 0
+	counterServer.Get(counterKeyComments+strconv.Itoa(int(itemID)), &count)
+	return count
+}
+
+func itemCommentCounts(items []Item) map[int64]int {
+	return itemCounters(counterKeyComments, items)
+}
+
+func postComment(w http.ResponseWriter, r *http.Request) {
+	rpc := reqPostComment{}
+	err := json.NewDecoder(r.Body).Decode(&rpc)
+	if err != nil {
+		outputErrorMsg(w, http.StatusBadRequest, "json decode error")
+		return
+	}
+	if !csrfTokenOK(r, rpc.CSRFToken) {
+		outputErrorMsg(w, http.StatusUnprocessableEntity, "csrf token error")
+		return
+	}
+	user, errCode, errMsg := getUser(r)
+	if errMsg != "" {
+		outputErrorMsg(w, errCode, errMsg)
+		return
+	}
+	body := strings.TrimSpace(rpc.Body)
+	if body == "" {
+		outputErrorMsg(w, http.StatusBadRequest, "all parameters are required")
+		return
+	}
+	if utf8.RuneCountInString(body) > commentMaxLength {
+		outputErrorMsg(w, http.StatusBadRequest, "コメントが長すぎます")
+		return
+	}
+	itemIDStr := strconv.Itoa(int(rpc.ItemID))
+	// 購入や停止と同じ商品のロックを取って、販売中だと確かめてから書くまでの間に売れないようにする
+	comment := itemComment{}
+	posted := false
+	idToItemServer.Transaction(itemIDStr, func(tx KeyValueStoreConn) {
+		item := Item{}
+		if !tx.Get(itemIDStr, &item) || (item.Status == ItemStatusStop && item.SellerID != user.ID) {
+			outputErrorMsg(w, http.StatusNotFound, "item not found")
+			return
+		}
+		if item.Status != ItemStatusOnSale {
+			outputErrorMsg(w, http.StatusForbidden, "販売中の商品にしかコメントできません")
+			return
+		}
+		comment = itemComment{
+			ItemID:    item.ID,
+			UserID:    user.ID,
+			IsSeller:  item.SellerID == user.ID,
+			Body:      body,
+			CreatedAt: time.Now().Truncate(time.Second),
+		}
+		result, err := dbx.Exec("INSERT INTO `item_comments` (`item_id`, `user_id`, `is_seller`, `body`, `created_at`) VALUES (?, ?, ?, ?, ?)",
+			comment.ItemID,
+			comment.UserID,
+			comment.IsSeller,
+			comment.Body,
+			comment.CreatedAt,
+		)
+		if err != nil {
+			log.Print(err)
+			outputErrorMsg(w, http.StatusInternalServerError, "db error")
+			return
+		}
+		comment.ID, _ = result.LastInsertId()
+		commentServer.Set(strconv.Itoa(int(comment.ID)), comment)
+		counterServer.IncrBy(counterKeyComments+itemIDStr, 1)
+		posted = true
+	})
+	if !posted {
+		return
+	}
+	userSimple, _ := getUserSimpleByID(dbx, user.ID)
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	json.NewEncoder(w).Encode(newResComment(comment, &userSimple))
+}
+
+func postDeleteComment(w http.ResponseWriter, r *http.Request) {
+	rdc := reqDeleteComment{}
+	err := json.NewDecoder(r.Body).Decode(&rdc)
+	if err != nil {
+		outputErrorMsg(w, http.StatusBadRequest, "json decode error")
+		return
+	}
+	if !csrfTokenOK(r, rdc.CSRFToken) {
+		outputErrorMsg(w, http.StatusUnprocessableEntity, "csrf token error")
+		return
+	}
+	user, errCode, errMsg := getUser(r)
+	if errMsg != "" {
+		outputErrorMsg(w, errCode, errMsg)
+		return
+	}
+	key := strconv.Itoa(int(rdc.CommentID))
+	deleted := false
+	commentServer.Transaction(key, func(tx KeyValueStoreConn) {
+		comment := itemComment{}
+		if !tx.Get(key, &comment) {
+			outputErrorMsg(w, http.StatusNotFound, "comment not found")
+			return
+		}
+		if comment.UserID != user.ID {
+			outputErrorMsg(w, http.StatusForbidden, "自分のコメント以外は削除できません")
+			return
+		}
+		tx.Del(key)
+		counterServer.IncrBy(counterKeyComments+strconv.Itoa(int(comment.ItemID)), -1)
+		writeBehind("DELETE FROM `item_comments` WHERE `id` = ?", comment.ID)
+		deleted = true
+	})
+	if deleted {
+		w.Header().Set("Content-Type", "application/json;charset=utf-8")
+		w.Write([]byte("{}"))
+	}
+}
+
+func newResComment(comment itemComment, user *UserSimple) resComment {
+	return resComment{
+		ID:        comment.ID,
+		ItemID:    comment.ItemID,
+		User:      user,
+		IsSeller:  comment.IsSeller,
+		Body:      comment.Body,
+		CreatedAt: comment.CreatedAt.Unix(),
+	}
+}
+
+// ログインしていなくても見られる
+func getItemComments(w http.ResponseWriter, r *http.Request) {
+	itemIDStr := pat.Param(r, "item_id")
+	itemID, err := strconv.ParseInt(itemIDStr, 10, 64)
+	if err != nil || itemID <= 0 {
+		outputErrorMsg(w, http.StatusBadRequest, "incorrect item id")
+		return
+	}
+	item := Item{}
+	if !idToItemServer.Get(itemIDStr, &item) {
+		outputErrorMsg(w, http.StatusNotFound, "item not found")
+		return
+	}
+	if userID, _ := currentUserID(r); item.Status == ItemStatusStop && item.SellerID != userID {
+		outputErrorMsg(w, http.StatusNotFound, "item not found")
+		return
+	}
+
+	query := r.URL.Query()
+	cursor, errMsg := parsePagingCursor(query, "comment_id")
+	if errMsg != "" {
+		outputErrorMsg(w, http.StatusBadRequest, errMsg)
+		return
+	}
+	keys, err := commentServer.IQuery(indexCommentsByItem, itemIDStr, cursor, CommentsPerPage+1, false)
+	if err != nil {
+		log.Print(err)
+		outputErrorMsg(w, http.StatusInternalServerError, "kvs error")
+		return
+	}
+	mGot := commentServer.MGet(keys)
+	comments := make([]itemComment, 0, len(keys))
+	userIDs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		comment := itemComment{}
+		if mGot.Get(key, &comment) {
+			comments = append(comments, comment)
+			userIDs = append(userIDs, strconv.Itoa(int(comment.UserID)))
+		}
+	}
+	mGotUsers := idToUserServer.MGet(userIDs)
+	res := resItemComments{Comments: []resComment{}}
+	for _, comment := range comments {
+		var user User
+		mGotUsers.Get(strconv.Itoa(int(comment.UserID)), &user)
+		userSimple := newUserSimple(user)
+		res.Comments = append(res.Comments, newResComment(comment, &userSimple))
+	}
+	if len(res.Comments) > CommentsPerPage {
+		res.HasNext = true
+		res.Comments = res.Comments[0:CommentsPerPage]
+	}
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	json.NewEncoder(w).Encode(res)
+}
@@ -0,0 +1,22 @@
+package main
+
+// :9876 (pprof と同じ DefaultServeMux) に生やすデバッグ用のエンドポイント
+//
+// 認証なしで状態を書き換えるもの(修復や再試行、ロック解除、キャンペーンの変更)があるので、
+// 外からは叩けないように 127.0.0.1 でだけ待ち受ける。他の台からは ssh のポートフォワードで使うこと
+import (
+	"net/http"
+)
+
+const debugListenAddress = "127.0.0.1:9876"
+
+func registerDebugHandlers() {
+	http.HandleFunc("/debug/writebehind", getDebugWriteBehind)
+	http.HandleFunc("/debug/writebehind/retry", postDebugWriteBehindRetry)
+	http.HandleFunc("/debug/reconcile", getDebugReconcile)
+	http.HandleFunc("/debug/login-attempts", getDebugLoginAttempts)
+	http.HandleFunc("/debug/login-attempts/unlock", postDebugLoginAttemptsUnlock)
+	http.HandleFunc("/debug/campaigns", debugCampaigns)
+	http.HandleFunc("/debug/campaigns/delete", postDebugCampaignsDelete)
+	http.HandleFunc("/debug/campaign-controller", debugCampaignController)
+}
@@ -7,7 +7,6 @@ import (
 	"log"
 	"net/http"
 	"strconv"
-	"sync"
 	"time"
 
 	"github.com/gorilla/sessions"
@@ -16,23 +15,9 @@ import (
 )
 
 func getSession(r *http.Request) *sessions.Session {
-	// これをキーにして返す
-	cookie, err := r.Cookie("session_isucari")
-	if err == nil {
-		// csrf_token / user_id
-		// fmt.Println("COOKIE:", cookie.Value)
-		if val, ok := sessionCache.Load(cookie.Value); ok {
-			res := val.(sessions.Session) // copy
-			return &res
-		} else {
-			session, _ := store.Get(r, sessionName)
-			sessionCache.Store(cookie.Value, *session)
-			return session
-		}
-	} else {
-		session, _ := store.Get(r, sessionName)
-		return session
-	}
+	// 同じリクエストの中では同じものが返る (sessions.go)
+	session, _ := store.Get(r, sessionName)
+	return session
 }
 
 func getCSRFToken(r *http.Request) string {
@@ -46,14 +31,30 @@ func getCSRFToken(r *http.Request) string {
 	return csrfToken.(string)
 }
 
+// API トークンのリクエストは cookie を使わないので CSRF の確認は要らない (apitokens.go)
+func csrfTokenOK(r *http.Request, csrfToken string) bool {
+	if apiTokenFromRequest(r) != nil {
+		return true
+	}
+	return csrfToken == getCSRFToken(r)
+}
+
+// API トークンかセッションのユーザー ID
+func currentUserID(r *http.Request) (int64, bool) {
+	if token := apiTokenFromRequest(r); token != nil {
+		return token.UserID, true
+	}
+	userID, ok := getSession(r).Values["user_id"].(int64)
+	return userID, ok
+}
+
 func getUser(r *http.Request) (user User, errCode int, errMsg string) {
-	session := getSession(r)
-	userID, ok := session.Values["user_id"]
+	userID, ok := currentUserID(r)
 	if !ok {
 		return user, http.StatusNotFound, "no session"
 	}
 
-	userIDStr := strconv.Itoa(int(userID.(int64)))
+	userIDStr := strconv.Itoa(int(userID))
 	exists := idToUserServer.Get(userIDStr, &user)
 	if !exists {
 		return user, http.StatusNotFound, "user not found"
@@ -141,6 +142,7 @@ func getNewItems(w http.ResponseWriter, r *http.Request) {
 	}
 
 	itemSimples := []ItemSimple{}
+	likeCounts := itemLikeCounts(items)
 	for _, item := range items {
 		var seller User
 		sellerIDStr := strconv.Itoa(int(item.SellerID))
@@ -150,10 +152,7 @@ func getNewItems(w http.ResponseWriter, r *http.Request) {
 			outputErrorMsg(w, http.StatusNotFound, "category not found")
 			return
 		}
-		var simpleSeller UserSimple
-		simpleSeller.ID = seller.ID
-		simpleSeller.AccountName = seller.AccountName
-		simpleSeller.NumSellItems = seller.NumSellItems
+		simpleSeller := newUserSimple(seller)
 		itemSimples = append(itemSimples, ItemSimple{
 			ID:         item.ID,
 			SellerID:   item.SellerID,
@@ -165,6 +164,7 @@ func getNewItems(w http.ResponseWriter, r *http.Request) {
 			CategoryID: item.CategoryID,
 			Category:   &category,
 			CreatedAt:  item.CreatedAt.Unix(),
+			LikeCount:  likeCounts[item.ID],
 		})
 	}
 
@@ -259,6 +259,7 @@ func getNewCategoryItems(w http.ResponseWriter, r *http.Request) {
 	}
 
 	itemSimples := []ItemSimple{}
+	likeCounts := itemLikeCounts(items)
 	keys := make([]string, len(items))
 	for i, item := range items {
 		keys[i] = strconv.Itoa(int(item.SellerID))
@@ -273,10 +274,7 @@ func getNewCategoryItems(w http.ResponseWriter, r *http.Request) {
 		var seller User
 		sellerIdStr := strconv.Itoa(int(item.SellerID))
 		mGot.Get(sellerIdStr, &seller)
-		var simpleSeller UserSimple
-		simpleSeller.ID = seller.ID
-		simpleSeller.AccountName = seller.AccountName
-		simpleSeller.NumSellItems = seller.NumSellItems
+		simpleSeller := newUserSimple(seller)
 		itemSimples = append(itemSimples, ItemSimple{
 			ID:         item.ID,
 			SellerID:   item.SellerID,
@@ -288,6 +286,7 @@ func getNewCategoryItems(w http.ResponseWriter, r *http.Request) {
 			CategoryID: item.CategoryID,
 			Category:   &category,
 			CreatedAt:  item.CreatedAt.Unix(),
+			LikeCount:  likeCounts[item.ID],
 		})
 	}
 
@@ -344,49 +343,23 @@ func getUserItems(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	items := []Item{}
+	cursor := ""
 	if itemID > 0 && createdAt > 0 {
 		// paging
-		err := dbx.Select(&items,
-			"SELECT * FROM `items` WHERE `seller_id` = ? AND `status` IN (?,?,?) AND (`created_at` < ?  OR (`created_at` <= ? AND `id` < ?)) ORDER BY `created_at` DESC, `id` DESC LIMIT ?",
-			userSimple.ID,
-			ItemStatusOnSale,
-			ItemStatusTrading,
-			ItemStatusSoldOut,
-			time.Unix(createdAt, 0),
-			time.Unix(createdAt, 0),
-			itemID,
-			ItemsPerPage+1,
-		)
-		if err != nil {
-			log.Print(err)
-			outputErrorMsg(w, http.StatusInternalServerError, "db error"+err.Error())
-			return
-		}
-	} else {
-		// 1st page
-		err := dbx.Select(&items,
-			"SELECT * FROM `items` WHERE `seller_id` = ? AND `status` IN (?,?,?) ORDER BY `created_at` DESC, `id` DESC LIMIT ?",
-			userSimple.ID,
-			ItemStatusOnSale,
-			ItemStatusTrading,
-			ItemStatusSoldOut,
-			ItemsPerPage+1,
-		)
-		if err != nil {
-			log.Print(err)
-			outputErrorMsg(w, http.StatusInternalServerError, "db error"+err.Error())
-			return
-		}
+		cursor = formatTimeDateID(createdAt, itemID)
+	}
+	items, err := queryItemsByIndex(indexItemsOnDisplayBySeller, userSimple.ID, cursor, ItemsPerPage+1)
+	if err != nil {
+		log.Print(err)
+		outputErrorMsg(w, http.StatusInternalServerError, "kvs error")
+		return
 	}
 	itemSimples := []ItemSimple{}
+	likeCounts := itemLikeCounts(items)
 	var seller User
-	var simpleSeller UserSimple
 	sellerIdStr := strconv.Itoa(int(userSimple.ID))
 	idToUserServer.Get(sellerIdStr, &seller)
-	simpleSeller.ID = seller.ID
-	simpleSeller.AccountName = seller.AccountName
-	simpleSeller.NumSellItems = seller.NumSellItems
+	simpleSeller := newUserSimple(seller)
 	for _, item := range items {
 		category, err := getCategoryByID(dbx, item.CategoryID)
 		if err != nil {
@@ -404,6 +377,7 @@ func getUserItems(w http.ResponseWriter, r *http.Request) {
 			CategoryID: item.CategoryID,
 			Category:   &category,
 			CreatedAt:  item.CreatedAt.Unix(),
+			LikeCount:  likeCounts[item.ID],
 		})
 	}
 	hasNext := false
@@ -449,65 +423,69 @@ func getTransactions(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	items := []Item{}
+	cursor := ""
 	if itemID > 0 && createdAt > 0 {
 		// paging
-		dbx.Select(&items,
-			"SELECT * FROM `items` WHERE (`seller_id` = ? OR `buyer_id` = ?) AND (`created_at` < ?  OR (`created_at` <= ? AND `id` < ?)) ORDER BY `created_at` DESC, `id` DESC LIMIT ?",
-			user.ID,
-			user.ID,
-			time.Unix(createdAt, 0),
-			time.Unix(createdAt, 0),
-			itemID,
-			TransactionsPerPage+1,
-		)
-	} else {
-		// 1st page
-		dbx.Select(&items,
-			"SELECT * FROM `items` WHERE (`seller_id` = ? OR `buyer_id` = ?) ORDER BY `created_at` DESC, `id` DESC LIMIT ?",
-			user.ID,
-			user.ID,
-			TransactionsPerPage+1,
-		)
+		cursor = formatTimeDateID(createdAt, itemID)
+	}
+	// 出品したものと買ったものをそれぞれ取ってきて新しい順に混ぜる
+	items, err := queryItemsByIndex(indexItemsBySeller, user.ID, cursor, TransactionsPerPage+1)
+	if err != nil {
+		log.Print(err)
+		outputErrorMsg(w, http.StatusInternalServerError, "kvs error")
+		return
+	}
+	boughtItems, err := queryItemsByIndex(indexItemsByBuyer, user.ID, cursor, TransactionsPerPage+1)
+	if err != nil {
+		log.Print(err)
+		outputErrorMsg(w, http.StatusInternalServerError, "kvs error")
+		return
+	}
+	items = append(items, boughtItems...)
+	sortItemsByTimeDateIDDesc(items)
+	if len(items) > TransactionsPerPage+1 {
+		items = items[:TransactionsPerPage+1]
 	}
 	sellerIds := make([]string, len(items))
 	for i, item := range items {
 		sellerIds[i] = strconv.Itoa(int(item.SellerID))
 	}
 	mGotIdToUser := idToUserServer.MGet(sellerIds)
-	wg := sync.WaitGroup{}
-	chans := make([]chan string, len(items))
+	likeCounts := itemLikeCounts(items)
+	commentCounts := itemCommentCounts(items)
 	itemIdStrs := make([]string, len(items))
 	for i, item := range items {
 		itemIdStrs[i] = strconv.Itoa(int(item.ID))
 	}
 	mGotItemIdToTE := itemIdToTransactionEvidenceServer.MGet(itemIdStrs)
 	itemDetails := make([]ItemDetail, 0)
-	for i, item := range items {
-		chans[i] = make(chan string, 1)
-		var seller UserSimple
-		ok := mGotIdToUser.Get(strconv.Itoa(int(item.SellerID)), &seller)
+	for _, item := range items {
+		var sellerUser User
+		ok := mGotIdToUser.Get(strconv.Itoa(int(item.SellerID)), &sellerUser)
 		if !ok {
 			outputErrorMsg(w, http.StatusNotFound, "seller not found")
 			return
 		}
+		seller := newUserSimple(sellerUser)
 		category, err := getCategoryByID(dbx, item.CategoryID)
 		if err != nil {
 			outputErrorMsg(w, http.StatusNotFound, "category not found")
 			return
 		}
 		itemDetail := ItemDetail{
-			ID:          item.ID,
-			SellerID:    item.SellerID,
-			Seller:      &seller,
-			Status:      item.Status,
-			Name:        item.Name,
-			Price:       item.Price,
-			Description: item.Description,
-			ImageURL:    getImageURL(item.ImageName),
-			CategoryID:  item.CategoryID,
-			Category:    &category,
-			CreatedAt:   item.CreatedAt.Unix(),
+			ID:           item.ID,
+			SellerID:     item.SellerID,
+			Seller:       &seller,
+			Status:       item.Status,
+			Name:         item.Name,
+			Price:        item.Price,
+			Description:  item.Description,
+			ImageURL:     getImageURL(item.ImageName),
+			CategoryID:   item.CategoryID,
+			Category:     &category,
+			CreatedAt:    item.CreatedAt.Unix(),
+			LikeCount:    likeCounts[item.ID],
+			CommentCount: commentCounts[item.ID],
 		}
 		if item.BuyerID != 0 {
 			buyer, err := getUserSimpleByID(dbx, item.BuyerID)
@@ -528,39 +506,34 @@ func getTransactions(w http.ResponseWriter, r *http.Request) {
 				outputErrorMsg(w, http.StatusNotFound, "shipping not found")
 				return
 			}
-			ssrStatus := shipping.Status
-			if ssrStatus != ShippingsStatusDone {
-				wg.Add(1)
-				go func(i int) {
-					ssr, err := APIShipmentStatus(getShipmentServiceURL(), &APIShipmentStatusReq{
-						ReserveID: shipping.ReserveID,
-					})
-					if err != nil {
-						log.Print(err)
-						outputErrorMsg(w, http.StatusInternalServerError, "failed to request to shipment service")
-						return
-					}
-					<-chans[i]
-					itemDetails[i].ShippingStatus = ssr.Status
-					wg.Done()
-				}(i)
+			// 配送状況はワーカーが shipment service に問い合わせて更新している(workers.go)
+			if shipping.Status != ShippingsStatusDone && shipping.Status != ShippingsStatusCancel {
+				ensureShipmentStatusRefresh(transactionEvidence.ID, shipping.ReserveID)
 			}
 			itemDetail.TransactionEvidenceID = transactionEvidence.ID
 			itemDetail.TransactionEvidenceStatus = transactionEvidence.Status
-			itemDetail.ShippingStatus = ssrStatus
+			itemDetail.ShippingStatus = shipping.Status
 		}
 		itemDetails = append(itemDetails, itemDetail)
-		chans[i] <- ""
 		if len(itemDetails) > TransactionsPerPage {
 			break
 		}
 	}
-	wg.Wait()
 	hasNext := false
 	if len(itemDetails) > TransactionsPerPage {
 		hasNext = true
 		itemDetails = itemDetails[0:TransactionsPerPage]
 	}
+	transactionEvidenceIDs := make([]int64, 0, len(itemDetails))
+	for _, itemDetail := range itemDetails {
+		if itemDetail.TransactionEvidenceID > 0 {
+			transactionEvidenceIDs = append(transactionEvidenceIDs, itemDetail.TransactionEvidenceID)
+		}
+	}
+	unreadCounts := unreadMessageCounts(transactionEvidenceIDs, user.ID)
+	for i := range itemDetails {
+		itemDetails[i].UnreadMessageCount = unreadCounts[itemDetails[i].TransactionEvidenceID]
+	}
 
 	rts := resTransactions{
 		Items:   itemDetails,
@@ -580,8 +553,7 @@ func getItem(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	session := getSession(r)
-	userID, ok := session.Values["user_id"]
+	userID, ok := currentUserID(r)
 	if !ok {
 		outputErrorMsg(w, http.StatusNotFound, "no session")
 		return
@@ -589,7 +561,8 @@ func getItem(w http.ResponseWriter, r *http.Request) {
 
 	item := Item{}
 	ok = idToItemServer.Get(strconv.Itoa(int(itemID)), &item)
-	if !ok {
+	if !ok || (item.Status == ItemStatusStop && item.SellerID != userID) {
+		// 出品を停止した商品は出品者にしか見せない
 		outputErrorMsg(w, http.StatusNotFound, "item not found")
 		return
 	}
@@ -620,8 +593,11 @@ func getItem(w http.ResponseWriter, r *http.Request) {
 		// TransactionEvidenceID
 		// TransactionEvidenceStatus
 		// ShippingStatus
-		Category:  &category,
-		CreatedAt: item.CreatedAt.Unix(),
+		Category:     &category,
+		CreatedAt:    item.CreatedAt.Unix(),
+		LikeCount:    itemLikeCount(item.ID),
+		Liked:        likeServer.Exists(likeKey(userID, item.ID)),
+		CommentCount: itemCommentCount(item.ID),
 	}
 
 	if (userID == item.SellerID || userID == item.BuyerID) && item.BuyerID != 0 {
@@ -714,6 +690,8 @@ func getSettings(w http.ResponseWriter, r *http.Request) {
 	ress.CSRFToken = csrfToken
 	if errMsg == "" {
 		ress.User = &user
+		ress.TwoFactorEnabled = twoFactorEnabled(user.ID)
+		ress.UnreadNotificationCount = unreadNotificationCount(user.ID)
 	}
 
 	ress.PaymentServiceURL = getPaymentServiceURL()
@@ -0,0 +1,204 @@
+package main
+
+// SyncMapServer のセカンダリインデックス
+//
+// RegisterIndex で「デコードした値 -> (インデックスの値, ソート用のキー)」を返す関数を登録しておくと、
+// master の storeDirect / deleteDirect でインデックスを更新する(キーとしては保存しないので DBSize は変わらない)。
+// IQuery でインデックスの値に対応するキーをソート用のキーの順に取得できる。
+//   IQUERY packet : join(["IQUERY", index, value, cursor, limit, reverse]) -> join([joinStrs(keys), error])
+// インデックスを持つストアの書き込みは 1 本の mutex で直列になる(値のデコードはその外で行う)。
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+)
+
+// value は NewValueFunction が返す型(ptr)。インデックスに載せないなら indexValues を空で返す
+type SyncMapIndexFunction func(value interface{}) (indexValues []string, sortKey string)
+
+var ErrIndexNotFound = errors.New("syncmap: index not found")
+
+type syncMapIndex struct {
+	extract SyncMapIndexFunction
+	entries map[string][]syncMapIndexEntry // インデックスの値 -> (sortKey, key) の昇順
+	indexed map[string]syncMapIndexEntry   // key -> 今載っている場所
+	values  map[string][]string            // key -> 今載っているインデックスの値
+}
+type syncMapIndexEntry struct {
+	sortKey string
+	key     string
+}
+
+func (this syncMapIndexEntry) less(that syncMapIndexEntry) bool {
+	if this.sortKey != that.sortKey {
+		return this.sortKey < that.sortKey
+	}
+	return this.key < that.key
+}
+
+// 起動時に全てのサーバーで同じものを登録しておくこと(master では既にある値からインデックスを作る)。
+// 値のデコードに NewValueFunction を使うので先に設定しておくこと
+func (this *SyncMapServer) RegisterIndex(name string, extract SyncMapIndexFunction) {
+	if this.NewValueFunction == nil {
+		log.Panic("RegisterIndex には NewValueFunction が必要です: ", name)
+	}
+	index := &syncMapIndex{extract: extract}
+	index.clear()
+	this.indexesMutex.Lock()
+	defer this.indexesMutex.Unlock()
+	if this.indexes == nil {
+		this.indexes = map[string]*syncMapIndex{}
+	}
+	this.indexes[name] = index
+	this.SyncMap.Range(func(key, value interface{}) bool {
+		if encoded, ok := value.([]byte); ok {
+			if decoded := this.decodeForIndex(key.(string), encoded); decoded != nil {
+				indexValues, sortKey := extract(decoded)
+				index.store(key.(string), indexValues, sortKey)
+			}
+		}
+		return true
+	})
+}
+func (this *SyncMapServer) hasIndexes() bool {
+	this.indexesMutex.RLock()
+	defer this.indexesMutex.RUnlock()
+	return len(this.indexes) > 0
+}
+
+// 壊れていれば nil (インデックスからは外れる)
+func (this *SyncMapServer) decodeForIndex(key string, encoded []byte) interface{} {
+	decoded := this.NewValueFunction()
+	if !this.decodeValue(key, encoded, decoded) {
+		return nil
+	}
+	return decoded
+}
+
+// storeDirect から呼ばれる。SyncMap への保存とインデックスの更新を一緒に行う
+func (this *SyncMapServer) storeWithIndexes(key string, value interface{}) {
+	var decoded interface{}
+	if encoded, ok := value.([]byte); ok {
+		decoded = this.decodeForIndex(key, encoded)
+	}
+	this.indexesMutex.Lock()
+	defer this.indexesMutex.Unlock()
+	this.SyncMap.Store(key, value)
+	for _, index := range this.indexes {
+		index.remove(key)
+		if decoded != nil {
+			indexValues, sortKey := index.extract(decoded)
+			index.store(key, indexValues, sortKey)
+		}
+	}
+}
+func (this *SyncMapServer) deleteWithIndexes(key string) {
+	this.indexesMutex.Lock()
+	defer this.indexesMutex.Unlock()
+	this.SyncMap.Delete(key)
+	for _, index := range this.indexes {
+		index.remove(key)
+	}
+}
+
+// FlushAll から呼ばれる。定義は残して中身だけ捨てる
+func (this *SyncMapServer) clearIndexes() {
+	this.indexesMutex.Lock()
+	defer this.indexesMutex.Unlock()
+	for _, index := range this.indexes {
+		index.clear()
+	}
+}
+
+func (this *syncMapIndex) clear() {
+	this.entries = map[string][]syncMapIndexEntry{}
+	this.indexed = map[string]syncMapIndexEntry{}
+	this.values = map[string][]string{}
+}
+func (this *syncMapIndex) store(key string, indexValues []string, sortKey string) {
+	if len(indexValues) == 0 {
+		return
+	}
+	entry := syncMapIndexEntry{sortKey: sortKey, key: key}
+	for _, indexValue := range indexValues {
+		list := this.entries[indexValue]
+		i := sort.Search(len(list), func(i int) bool { return !list[i].less(entry) })
+		list = append(list, syncMapIndexEntry{})
+		copy(list[i+1:], list[i:])
+		list[i] = entry
+		this.entries[indexValue] = list
+	}
+	this.indexed[key] = entry
+	this.values[key] = indexValues
+}
+func (this *syncMapIndex) remove(key string) {
+	entry, ok := this.indexed[key]
+	if !ok {
+		return
+	}
+	for _, indexValue := range this.values[key] {
+		list := this.entries[indexValue]
+		i := sort.Search(len(list), func(i int) bool { return !list[i].less(entry) })
+		if i < len(list) && list[i] == entry {
+			list = append(list[:i], list[i+1:]...)
+		}
+		if len(list) == 0 {
+			delete(this.entries, indexValue)
+		} else {
+			this.entries[indexValue] = list
+		}
+	}
+	delete(this.indexed, key)
+	delete(this.values, key)
+}
+
+// reverse なら降順。cursor が空でなければ sortKey が cursor より後ろ(降順なら前)のものだけ
+func (this *syncMapIndex) query(indexValue string, cursor string, limit int, reverse bool) []string {
+	list := this.entries[indexValue]
+	start, end := 0, len(list)
+	if cursor != "" {
+		if reverse {
+			end = sort.Search(len(list), func(i int) bool { return list[i].sortKey >= cursor })
+		} else {
+			start = sort.Search(len(list), func(i int) bool { return list[i].sortKey > cursor })
+		}
+	}
+	count := end - start
+	if limit > 0 && count > limit {
+		count = limit
+	}
+	keys := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		if reverse {
+			keys = append(keys, list[end-1-i].key)
+		} else {
+			keys = append(keys, list[start+i].key)
+		}
+	}
+	return keys
+}
+
+// IQUERY : インデックス index で value に載っているキーを sortKey の順に返す。
+// reverse なら降順。cursor が空でなければ sortKey が cursor より後ろ(降順なら前)のものだけ(ページング用)。
+// limit が 0 以下なら全て
+func (this *SyncMapServerConn) IQuery(index, value, cursor string, limit int, reverse bool) ([]string, error) {
+	if this.IsMasterServer() {
+		return this.server.iqueryImpl(index, value, cursor, limit, reverse)
+	}
+	res := split(this.send(syncMapCommandIQuery, []byte(index), []byte(value), []byte(cursor), encodeToBytes(limit), encodeToBytes(reverse)))
+	return splitBytesToStrs(res[0]), decodeError(res[1])
+}
+func (this *SyncMapServer) iqueryImpl(name, value, cursor string, limit int, reverse bool) ([]string, error) {
+	this.indexesMutex.RLock()
+	defer this.indexesMutex.RUnlock()
+	index, ok := this.indexes[name]
+	if !ok {
+		return []string{}, fmt.Errorf("%v: %s", ErrIndexNotFound, name)
+	}
+	return index.query(value, cursor, limit, reverse), nil
+}
+func (this *SyncMapServerConn) parseIQuery(input [][]byte) []byte {
+	keys, err := this.server.iqueryImpl(string(input[1]), string(input[2]), string(input[3]), decodeInt(input[4]), decodeBool(input[5]))
+	return join([][]byte{joinStrsToBytes(keys), encodeError(err)})
+}
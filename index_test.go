@@ -0,0 +1,184 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+type indexedDoc struct {
+	Owner string
+	Tags  []string
+	Order string
+}
+
+const (
+	testIndexByOwner = "docs.owner"
+	testIndexByTag   = "docs.tag"
+)
+
+func newTestIndexedConn(t *testing.T) *SyncMapServerConn {
+	conn := newTestSyncMapServerConn(t)
+	conn.server.NewValueFunction = func() interface{} { return &indexedDoc{} }
+	conn.server.RegisterIndex(testIndexByOwner, func(value interface{}) ([]string, string) {
+		doc := value.(*indexedDoc)
+		if doc.Owner == "" {
+			return nil, ""
+		}
+		return []string{doc.Owner}, doc.Order
+	})
+	conn.server.RegisterIndex(testIndexByTag, func(value interface{}) ([]string, string) {
+		doc := value.(*indexedDoc)
+		return doc.Tags, doc.Order
+	})
+	return conn
+}
+
+func iquery(t *testing.T, conn KeyValueStoreConn, index, value, cursor string, limit int, reverse bool) []string {
+	t.Helper()
+	keys, err := conn.(*SyncMapServerConn).IQuery(index, value, cursor, limit, reverse)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return keys
+}
+
+func TestIQueryCursor(t *testing.T) {
+	master := newTestIndexedConn(t)
+	for i, order := range []string{"03", "01", "05", "02", "04"} {
+		master.Set("k"+order, indexedDoc{Owner: "alice", Order: order})
+		master.Set("other"+string(rune('0'+i)), indexedDoc{Owner: "bob", Order: order})
+	}
+	tests := []struct {
+		name    string
+		cursor  string
+		limit   int
+		reverse bool
+		want    []string
+	}{
+		{"all", "", 0, false, []string{"k01", "k02", "k03", "k04", "k05"}},
+		{"all reverse", "", 0, true, []string{"k05", "k04", "k03", "k02", "k01"}},
+		{"limit", "", 2, false, []string{"k01", "k02"}},
+		{"limit reverse", "", 2, true, []string{"k05", "k04"}},
+		{"limit larger than entries", "", 10, false, []string{"k01", "k02", "k03", "k04", "k05"}},
+		{"after cursor", "02", 0, false, []string{"k03", "k04", "k05"}},
+		{"before cursor", "04", 0, true, []string{"k03", "k02", "k01"}},
+		{"after cursor with limit", "02", 2, false, []string{"k03", "k04"}},
+		{"before cursor with limit", "04", 2, true, []string{"k03", "k02"}},
+		{"cursor between entries", "025", 0, false, []string{"k03", "k04", "k05"}},
+		{"cursor between entries reverse", "025", 0, true, []string{"k02", "k01"}},
+		{"cursor at the last", "05", 0, false, []string{}},
+		{"cursor at the first reverse", "01", 0, true, []string{}},
+		{"cursor before all", "00", 0, false, []string{"k01", "k02", "k03", "k04", "k05"}},
+		{"cursor after all reverse", "99", 1, true, []string{"k05"}},
+	}
+	conns := map[string]KeyValueStoreConn{"master": master, "slave": newTestSlaveConn(master)}
+	for connName, conn := range conns {
+		for _, test := range tests {
+			got := iquery(t, conn, testIndexByOwner, "alice", test.cursor, test.limit, test.reverse)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("%s/%s: IQuery = %v, want %v", connName, test.name, got, test.want)
+			}
+		}
+		if got := iquery(t, conn, testIndexByOwner, "nobody", "", 0, false); len(got) != 0 {
+			t.Errorf("%s: IQuery for unknown value = %v", connName, got)
+		}
+		if _, err := conn.(*SyncMapServerConn).IQuery("no.such.index", "alice", "", 0, false); err == nil {
+			t.Errorf("%s: IQuery for unknown index returned no error", connName)
+		}
+	}
+}
+
+func TestIndexMaintenance(t *testing.T) {
+	conn := newTestIndexedConn(t)
+	byOwner := func(owner string) []string { return iquery(t, conn, testIndexByOwner, owner, "", 0, false) }
+	byTag := func(tag string) []string { return iquery(t, conn, testIndexByTag, tag, "", 0, false) }
+
+	conn.Set("a", indexedDoc{Owner: "alice", Tags: []string{"x", "y"}, Order: "1"})
+	conn.Set("b", indexedDoc{Owner: "alice", Tags: []string{"y"}, Order: "2"})
+	if got := byOwner("alice"); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("after Set: alice = %v", got)
+	}
+	if got := byTag("y"); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("after Set: tag y = %v", got)
+	}
+	// インデックスに載せないものは DBSize に数えない
+	if conn.DBSize() != 2 {
+		t.Errorf("DBSize = %d, want 2", conn.DBSize())
+	}
+
+	// 上書きすると前の場所から外れる
+	conn.Set("a", indexedDoc{Owner: "bob", Tags: []string{"x"}, Order: "3"})
+	if got := byOwner("alice"); !reflect.DeepEqual(got, []string{"b"}) {
+		t.Errorf("after update: alice = %v", got)
+	}
+	if got := byOwner("bob"); !reflect.DeepEqual(got, []string{"a"}) {
+		t.Errorf("after update: bob = %v", got)
+	}
+	if got := byTag("y"); !reflect.DeepEqual(got, []string{"b"}) {
+		t.Errorf("after update: tag y = %v", got)
+	}
+
+	// sortKey が変われば並びも変わる。同じ sortKey はキー順
+	conn.Set("b", indexedDoc{Owner: "bob", Order: "3"})
+	if got := byOwner("bob"); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("same sortKey: bob = %v", got)
+	}
+	conn.Set("b", indexedDoc{Owner: "bob", Order: "0"})
+	if got := byOwner("bob"); !reflect.DeepEqual(got, []string{"b", "a"}) {
+		t.Errorf("reordered: bob = %v", got)
+	}
+
+	// インデックスの値を返さなくなったら外れる
+	conn.Set("b", indexedDoc{Order: "0"})
+	if got := byOwner("bob"); !reflect.DeepEqual(got, []string{"a"}) {
+		t.Errorf("unindexed: bob = %v", got)
+	}
+
+	// トランザクション中の Set / Del も反映する
+	conn.Transaction("c", func(tx KeyValueStoreConn) {
+		tx.Set("c", indexedDoc{Owner: "bob", Order: "9"})
+	})
+	conn.Transaction("a", func(tx KeyValueStoreConn) {
+		tx.Del("a")
+	})
+	if got := byOwner("bob"); !reflect.DeepEqual(got, []string{"c"}) {
+		t.Errorf("after transaction: bob = %v", got)
+	}
+	if got := byTag("x"); len(got) != 0 {
+		t.Errorf("after Del: tag x = %v", got)
+	}
+
+	// 同じキーに List を置いたらインデックスから外れる
+	conn.Del("c")
+	conn.RPush("c", indexedDoc{Owner: "bob", Order: "9"})
+	if got := byOwner("bob"); len(got) != 0 {
+		t.Errorf("list value: bob = %v", got)
+	}
+
+	// FlushAll で中身は消えるが定義は残る
+	conn.Set("d", indexedDoc{Owner: "carol", Order: "1"})
+	conn.FlushAll()
+	if got := byOwner("carol"); len(got) != 0 {
+		t.Errorf("after FlushAll: carol = %v", got)
+	}
+	conn.Set("d", indexedDoc{Owner: "carol", Order: "1"})
+	if got := byOwner("carol"); !reflect.DeepEqual(got, []string{"d"}) {
+		t.Errorf("Set after FlushAll: carol = %v", got)
+	}
+}
+
+// 既に値のあるストアに後から登録すると、今ある値からインデックスを作る
+func TestRegisterIndexBuildsFromExistingValues(t *testing.T) {
+	conn := newTestSyncMapServerConn(t)
+	conn.server.NewValueFunction = func() interface{} { return &indexedDoc{} }
+	conn.Set("a", indexedDoc{Owner: "alice", Order: "2"})
+	conn.Set("b", indexedDoc{Owner: "alice", Order: "1"})
+	conn.RPush("list", indexedDoc{Owner: "alice", Order: "0"})
+	conn.server.RegisterIndex(testIndexByOwner, func(value interface{}) ([]string, string) {
+		doc := value.(*indexedDoc)
+		return []string{doc.Owner}, doc.Order
+	})
+	if got := iquery(t, conn, testIndexByOwner, "alice", "", 0, false); !reflect.DeepEqual(got, []string{"b", "a"}) {
+		t.Errorf("IQuery = %v, want [b a]", got)
+	}
+}
@@ -0,0 +1,399 @@
+package kvtypes
+
+// KVS に保存する値のエンコード方式 (ValueCodec)
+//
+// SyncMapServer.SetCodec でストア毎に選べる。エンコード結果には
+//   0xc1 (msgpack では使われないバイト) + codec の tag 1B + 本体
+// の prefix が付くので、デコード時は tag を見て codec を選ぶ。
+// prefix の無いものは従来どおりの msgpack として読むので、途中で codec を変えても
+// 古い値はそのまま読めて、次に Set された時に新しい codec になる(Reencode で一括変換もできる)。
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/shamaton/msgpack"
+)
+
+const codecTagMarker = 0xc1
+
+const ( // codec tags
+	ValueCodecTagMsgpack = 'm'
+	ValueCodecTagGob     = 'g'
+	ValueCodecTagJSON    = 'j'
+	ValueCodecTagBinary  = 'b'
+)
+
+var (
+	// この型はエンコードできない(Codec を指定したストアでは msgpack で保存される)
+	ErrCodecUnsupportedType = errors.New("codec: unsupported type")
+	ErrCodecUnknownTag      = errors.New("codec: unknown tag")
+	ErrCodecBroken          = errors.New("codec: broken input")
+)
+
+type ValueCodec interface {
+	Tag() byte
+	Name() string
+	Encode(x interface{}) ([]byte, error)
+	Decode(input []byte, x interface{}) error // ptr
+}
+
+var valueCodecs = map[byte]ValueCodec{}
+
+func RegisterValueCodec(codec ValueCodec) {
+	valueCodecs[codec.Tag()] = codec
+}
+
+func ValueCodecByName(name string) (ValueCodec, bool) {
+	for _, codec := range valueCodecs {
+		if codec.Name() == name {
+			return codec, true
+		}
+	}
+	return nil, false
+}
+
+func init() {
+	RegisterValueCodec(MsgpackCodec{})
+	RegisterValueCodec(GobCodec{})
+	RegisterValueCodec(JSONCodec{})
+	RegisterValueCodec(BinaryCodec{})
+}
+
+// codec が nil なら prefix 無しの msgpack (webapp の encodeToBytes と同じ)
+func EncodeWithCodec(codec ValueCodec, x interface{}) ([]byte, error) {
+	if codec == nil {
+		return msgpack.Encode(&x)
+	}
+	body, err := codec.Encode(x)
+	if err == ErrCodecUnsupportedType && codec.Tag() != ValueCodecTagMsgpack {
+		codec = valueCodecs[ValueCodecTagMsgpack]
+		body, err = codec.Encode(x)
+	}
+	if err != nil {
+		return nil, err
+	}
+	result := make([]byte, len(body)+2)
+	result[0] = codecTagMarker
+	result[1] = codec.Tag()
+	copy(result[2:], body)
+	return result, nil
+}
+
+// tag を見て codec を選ぶ。tag が無ければ msgpack
+func DecodeWithCodec(input []byte, x interface{}) error {
+	if len(input) < 2 || input[0] != codecTagMarker {
+		return decodeMsgpack(input, x)
+	}
+	codec, ok := valueCodecs[input[1]]
+	if !ok {
+		return fmt.Errorf("%v: %q", ErrCodecUnknownTag, input[1])
+	}
+	return codec.Decode(input[2:], x)
+}
+
+// どの codec でエンコードされているか (prefix 無しは "msgpack(legacy)")
+func CodecNameOf(input []byte) string {
+	if len(input) < 2 || input[0] != codecTagMarker {
+		return "msgpack(legacy)"
+	}
+	if codec, ok := valueCodecs[input[1]]; ok {
+		return codec.Name()
+	}
+	return "unknown"
+}
+
+// msgpack
+type MsgpackCodec struct{}
+
+func (MsgpackCodec) Tag() byte    { return ValueCodecTagMsgpack }
+func (MsgpackCodec) Name() string { return "msgpack" }
+func (MsgpackCodec) Encode(x interface{}) ([]byte, error) {
+	return msgpack.Encode(&x)
+}
+func (MsgpackCodec) Decode(input []byte, x interface{}) error {
+	return decodeMsgpack(input, x)
+}
+
+// shamaton/msgpack は途中で切れた入力で panic するのでエラーにする
+func decodeMsgpack(input []byte, x interface{}) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v: %v", ErrCodecBroken, r)
+		}
+	}()
+	return msgpack.Decode(input, x)
+}
+
+// encoding/gob : 型情報も毎回書くので大きいが、型が変わっても読める
+type GobCodec struct{}
+
+func (GobCodec) Tag() byte    { return ValueCodecTagGob }
+func (GobCodec) Name() string { return "gob" }
+func (GobCodec) Encode(x interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(x); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+func (GobCodec) Decode(input []byte, x interface{}) error {
+	return gob.NewDecoder(bytes.NewReader(input)).Decode(x)
+}
+
+// encoding/json : 人が読める。`json:"-"` のフィールドがある型は欠落するので扱わない
+type JSONCodec struct{}
+
+func (JSONCodec) Tag() byte    { return ValueCodecTagJSON }
+func (JSONCodec) Name() string { return "json" }
+func (JSONCodec) Encode(x interface{}) ([]byte, error) {
+	if JSONDropsFields(reflect.TypeOf(x)) {
+		return nil, ErrCodecUnsupportedType
+	}
+	return json.Marshal(x)
+}
+func (JSONCodec) Decode(input []byte, x interface{}) error {
+	return json.Unmarshal(input, x)
+}
+
+var jsonDropsFieldsCache = sync.Map{} // reflect.Type -> bool
+
+// `json:"-"` のフィールドを持つ構造体か。持っていれば json タグを使わずに読み書きする (ExportFields)
+func JSONDropsFields(t reflect.Type) bool {
+	if t == nil {
+		return false
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	if cached, ok := jsonDropsFieldsCache.Load(t); ok {
+		return cached.(bool)
+	}
+	drops := false
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).Tag.Get("json") == "-" {
+			drops = true
+			break
+		}
+	}
+	jsonDropsFieldsCache.Store(t, drops)
+	return drops
+}
+
+// Item / User 専用の手書きのバイナリ形式。それ以外の型は ErrCodecUnsupportedType
+//
+//	型 1B | version 1B | フィールドを順に (int は varint, string/[]byte は長さ uvarint + 本体, time は unix 秒 varint + ナノ秒 uvarint)
+//
+// フィールドを増やしたら version を上げて、古い version も読めるようにしておくこと
+type BinaryCodec struct{}
+
+const (
+	binaryCodecTypeItem = 'I'
+	binaryCodecTypeUser = 'U'
+	binaryCodecVersion  = 3 // 2: User から平文のパスワードを外した 3: User に評価を足した
+)
+
+func (BinaryCodec) Tag() byte    { return ValueCodecTagBinary }
+func (BinaryCodec) Name() string { return "binary" }
+func (BinaryCodec) Encode(x interface{}) ([]byte, error) {
+	w := binaryWriter{}
+	switch v := x.(type) {
+	case Item:
+		w.item(&v)
+	case *Item:
+		w.item(v)
+	case User:
+		w.user(&v)
+	case *User:
+		w.user(v)
+	default:
+		return nil, ErrCodecUnsupportedType
+	}
+	return w.buf, nil
+}
+func (BinaryCodec) Decode(input []byte, x interface{}) error {
+	if len(input) < 2 {
+		return ErrCodecBroken
+	}
+	if input[1] < 1 || input[1] > binaryCodecVersion {
+		return fmt.Errorf("%v: version %d", ErrCodecBroken, input[1])
+	}
+	r := binaryReader{buf: input[2:], version: input[1]}
+	switch input[0] {
+	case binaryCodecTypeItem:
+		v, ok := x.(*Item)
+		if !ok {
+			return fmt.Errorf("codec: cannot decode Item into %T", x)
+		}
+		r.item(v)
+	case binaryCodecTypeUser:
+		v, ok := x.(*User)
+		if !ok {
+			return fmt.Errorf("codec: cannot decode User into %T", x)
+		}
+		r.user(v)
+	default:
+		return fmt.Errorf("%v: type %q", ErrCodecBroken, input[0])
+	}
+	return r.err
+}
+
+type binaryWriter struct {
+	buf []byte
+}
+
+func (w *binaryWriter) item(v *Item) {
+	w.buf = append(w.buf, binaryCodecTypeItem, binaryCodecVersion)
+	w.int(v.ID)
+	w.int(v.SellerID)
+	w.int(v.BuyerID)
+	w.string(v.Status)
+	w.string(v.Name)
+	w.int(int64(v.Price))
+	w.string(v.Description)
+	w.string(v.ImageName)
+	w.int(int64(v.CategoryID))
+	w.time(v.CreatedAt)
+	w.time(v.UpdatedAt)
+	w.string(v.TimeDateID)
+}
+func (w *binaryWriter) user(v *User) {
+	w.buf = append(w.buf, binaryCodecTypeUser, binaryCodecVersion)
+	w.int(v.ID)
+	w.string(v.AccountName)
+	w.bytes(v.HashedPassword)
+	w.string(v.Address)
+	w.int(int64(v.NumSellItems))
+	w.time(v.LastBump)
+	w.time(v.CreatedAt)
+	w.int(int64(v.RatingCount))
+	w.int(int64(v.RatingSum))
+}
+func (w *binaryWriter) int(x int64) {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(tmp[:], x)
+	w.buf = append(w.buf, tmp[:n]...)
+}
+func (w *binaryWriter) uint(x uint64) {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tmp[:], x)
+	w.buf = append(w.buf, tmp[:n]...)
+}
+func (w *binaryWriter) bytes(x []byte) {
+	w.uint(uint64(len(x)))
+	w.buf = append(w.buf, x...)
+}
+func (w *binaryWriter) string(x string) {
+	w.uint(uint64(len(x)))
+	w.buf = append(w.buf, x...)
+}
+func (w *binaryWriter) time(x time.Time) {
+	w.int(x.Unix())
+	w.uint(uint64(x.Nanosecond()))
+}
+
+type binaryReader struct {
+	buf     []byte
+	err     error
+	version byte
+}
+
+func (r *binaryReader) item(v *Item) {
+	v.ID = r.int()
+	v.SellerID = r.int()
+	v.BuyerID = r.int()
+	v.Status = r.string()
+	v.Name = r.string()
+	v.Price = int(r.int())
+	v.Description = r.string()
+	v.ImageName = r.string()
+	v.CategoryID = int(r.int())
+	v.CreatedAt = r.time()
+	v.UpdatedAt = r.time()
+	v.TimeDateID = r.string()
+}
+func (r *binaryReader) user(v *User) {
+	v.ID = r.int()
+	v.AccountName = r.string()
+	v.HashedPassword = r.bytes()
+	v.Address = r.string()
+	v.NumSellItems = int(r.int())
+	v.LastBump = r.time()
+	v.CreatedAt = r.time()
+	if r.version == 1 {
+		r.string() // 平文のパスワード。読み捨てる
+	}
+	if r.version >= 3 {
+		v.RatingCount = int(r.int())
+		v.RatingSum = int(r.int())
+	}
+}
+func (r *binaryReader) int() int64 {
+	if r.err != nil {
+		return 0
+	}
+	x, n := binary.Varint(r.buf)
+	if n <= 0 {
+		r.err = ErrCodecBroken
+		return 0
+	}
+	r.buf = r.buf[n:]
+	return x
+}
+func (r *binaryReader) uint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	x, n := binary.Uvarint(r.buf)
+	if n <= 0 {
+		r.err = ErrCodecBroken
+		return 0
+	}
+	r.buf = r.buf[n:]
+	return x
+}
+func (r *binaryReader) bytes() []byte {
+	n := r.uint()
+	if r.err != nil {
+		return nil
+	}
+	if n > uint64(len(r.buf)) {
+		r.err = ErrCodecBroken
+		return nil
+	}
+	result := make([]byte, n)
+	copy(result, r.buf[:n])
+	r.buf = r.buf[n:]
+	return result
+}
+func (r *binaryReader) string() string {
+	n := r.uint()
+	if r.err != nil {
+		return ""
+	}
+	if n > uint64(len(r.buf)) {
+		r.err = ErrCodecBroken
+		return ""
+	}
+	result := string(r.buf[:n])
+	r.buf = r.buf[n:]
+	return result
+}
+func (r *binaryReader) time() time.Time {
+	sec := r.int()
+	nsec := r.uint()
+	if r.err != nil || nsec > math.MaxInt32 {
+		return time.Time{}
+	}
+	return time.Unix(sec, int64(nsec))
+}
@@ -0,0 +1,295 @@
+package kvtypes
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/shamaton/msgpack"
+)
+
+// prefix 無しの msgpack (webapp の encodeToBytes)
+func legacyMsgpack(x interface{}) []byte {
+	encoded, _ := msgpack.Encode(&x)
+	return encoded
+}
+
+// 実際に保存している型の見本
+func codecSamples() []struct {
+	name     string
+	value    interface{}
+	newValue func() interface{}
+} {
+	now := time.Unix(1565575823, 123456789)
+	return []struct {
+		name     string
+		value    interface{}
+		newValue func() interface{}
+	}{
+		{"Item", Item{
+			ID: 50000, SellerID: 1234, BuyerID: 5678, Status: "on_sale",
+			Name: "椅子", Price: 1000, Description: "よく座れる椅子です。ほとんど使っていません。",
+			ImageName: "2b0a7b0f9a9c6e8d.jpg", CategoryID: 32,
+			CreatedAt: now, UpdatedAt: now, TimeDateID: "1565575823050000",
+		}, func() interface{} { return &Item{} }},
+		{"User", User{
+			ID: 1234, AccountName: "isucon", HashedPassword: make([]byte, 60),
+			Address: "東京都港区六本木1-2-3", NumSellItems: 10, LastBump: now, CreatedAt: now,
+			RatingCount: 3, RatingSum: 14,
+		}, func() interface{} { return &User{} }},
+		{"TransactionEvidence", TransactionEvidence{
+			ID: 1, SellerID: 1234, BuyerID: 5678, Status: "wait_shipping",
+			ItemID: 50000, ItemName: "椅子", ItemPrice: 1000, ItemDescription: "よく座れる椅子です。",
+			ItemCategoryID: 32, ItemRootCategoryID: 30, CreatedAt: now, UpdatedAt: now,
+		}, func() interface{} { return &TransactionEvidence{} }},
+		{"Shipping", Shipping{
+			TransactionEvidenceID: 1, Status: "initial", ItemName: "椅子", ItemID: 50000,
+			ReserveID: "0123456789", ReserveTime: now.Unix(), ToAddress: "東京都港区六本木1-2-3", ToName: "isucon",
+			FromAddress: "東京都新宿区1-2-3", FromName: "isucari", ImgBinary: make([]byte, 1024),
+			CreatedAt: now, UpdatedAt: now,
+		}, func() interface{} { return &Shipping{} }},
+	}
+}
+
+func sortedCodecs() []ValueCodec {
+	tags := make([]int, 0, len(valueCodecs))
+	for tag := range valueCodecs {
+		tags = append(tags, int(tag))
+	}
+	sort.Ints(tags)
+	codecs := make([]ValueCodec, len(tags))
+	for i, tag := range tags {
+		codecs[i] = valueCodecs[byte(tag)]
+	}
+	return codecs
+}
+
+// time.Time は monotonic clock と Location の違いで DeepEqual が通らないので揃えてから比べる
+func normalizeTimes(value interface{}) interface{} {
+	v := reflect.New(reflect.TypeOf(value)).Elem()
+	v.Set(reflect.ValueOf(value))
+	for i := 0; i < v.NumField(); i++ {
+		if t, ok := v.Field(i).Interface().(time.Time); ok {
+			v.Field(i).Set(reflect.ValueOf(t.UTC().Round(0)))
+		}
+	}
+	return v.Interface()
+}
+
+// 各 codec で、エンコードできる型は prefix 付きで元に戻る。
+// エンコードできない型 (BinaryCodec の Item / User 以外, JSONCodec の json:"-" を持つ型) は msgpack で保存される
+func TestCodecRoundTrip(t *testing.T) {
+	for _, sample := range codecSamples() {
+		for _, codec := range sortedCodecs() {
+			encoded, err := EncodeWithCodec(codec, sample.value)
+			if err != nil {
+				t.Errorf("%s/%s: encode: %v", sample.name, codec.Name(), err)
+				continue
+			}
+			if _, err := codec.Encode(sample.value); err == ErrCodecUnsupportedType {
+				if got := CodecNameOf(encoded); got != "msgpack" {
+					t.Errorf("%s/%s: stored as %s, want msgpack", sample.name, codec.Name(), got)
+				}
+			} else if got := CodecNameOf(encoded); got != codec.Name() {
+				t.Errorf("%s/%s: stored as %s", sample.name, codec.Name(), got)
+			}
+			decoded := sample.newValue()
+			if err := DecodeWithCodec(encoded, decoded); err != nil {
+				t.Errorf("%s/%s: decode: %v", sample.name, codec.Name(), err)
+				continue
+			}
+			got := normalizeTimes(reflect.ValueOf(decoded).Elem().Interface())
+			if want := normalizeTimes(sample.value); !reflect.DeepEqual(got, want) {
+				t.Errorf("%s/%s: decoded %+v, want %+v", sample.name, codec.Name(), got, want)
+			}
+		}
+	}
+}
+
+// prefix の無い値は従来どおりの msgpack として読む
+func TestCodecDecodeLegacyMsgpack(t *testing.T) {
+	for _, sample := range codecSamples() {
+		legacy := legacyMsgpack(sample.value)
+		if legacy[0] == codecTagMarker {
+			t.Fatalf("%s: legacy msgpack starts with the codec marker", sample.name)
+		}
+		if got := CodecNameOf(legacy); got != "msgpack(legacy)" {
+			t.Errorf("%s: codecNameOf = %s", sample.name, got)
+		}
+		decoded := sample.newValue()
+		if err := DecodeWithCodec(legacy, decoded); err != nil {
+			t.Errorf("%s: decode: %v", sample.name, err)
+			continue
+		}
+		got := normalizeTimes(reflect.ValueOf(decoded).Elem().Interface())
+		if want := normalizeTimes(sample.value); !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: decoded %+v, want %+v", sample.name, got, want)
+		}
+	}
+	// 0xc1 は msgpack では使われないので、1 バイトだけのものも msgpack として読む
+	var n int
+	if err := DecodeWithCodec([]byte{0x05}, &n); err != nil || n != 5 {
+		t.Errorf("decode fixint = %d, %v", n, err)
+	}
+}
+
+// 古い version の BinaryCodec で保存された User も読める
+func TestBinaryCodecUserVersions(t *testing.T) {
+	now := time.Unix(1565575823, 0)
+	user := User{
+		ID: 1234, AccountName: "isucon", HashedPassword: []byte("$2a$10$hash"),
+		Address: "東京都港区六本木1-2-3", NumSellItems: 10, LastBump: now, CreatedAt: now,
+		RatingCount: 3, RatingSum: 14,
+	}
+	legacy := func(version byte) []byte {
+		w := binaryWriter{}
+		w.buf = append(w.buf, binaryCodecTypeUser, version)
+		w.int(user.ID)
+		w.string(user.AccountName)
+		w.bytes(user.HashedPassword)
+		w.string(user.Address)
+		w.int(int64(user.NumSellItems))
+		w.time(user.LastBump)
+		w.time(user.CreatedAt)
+		switch version {
+		case 1:
+			w.string("plain password")
+		case 3:
+			w.int(int64(user.RatingCount))
+			w.int(int64(user.RatingSum))
+		}
+		return w.buf
+	}
+	withoutRating := user
+	withoutRating.RatingCount = 0
+	withoutRating.RatingSum = 0
+	current, _ := BinaryCodec{}.Encode(user)
+	tests := []struct {
+		name    string
+		encoded []byte
+		want    User
+	}{
+		{"version 1", legacy(1), withoutRating},
+		{"version 2", legacy(2), withoutRating},
+		{"version 3", legacy(3), user},
+		{"current", current, user},
+	}
+	for _, test := range tests {
+		got := User{}
+		if err := (BinaryCodec{}).Decode(test.encoded, &got); err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(normalizeTimes(got), normalizeTimes(test.want)) {
+			t.Errorf("%s: decoded %+v, want %+v", test.name, got, test.want)
+		}
+	}
+	if !reflect.DeepEqual(legacy(binaryCodecVersion), current) {
+		t.Errorf("current version differs from version %d", binaryCodecVersion)
+	}
+}
+
+// 壊れた入力はエラーにする (panic しない)
+func TestCodecCorruptInput(t *testing.T) {
+	item, _ := BinaryCodec{}.Encode(codecSamples()[0].value)
+	user, _ := BinaryCodec{}.Encode(codecSamples()[1].value)
+	for _, encoded := range [][]byte{item, user} {
+		for n := 0; n < len(encoded); n++ {
+			var err error
+			if encoded[0] == binaryCodecTypeItem {
+				err = BinaryCodec{}.Decode(encoded[:n], &Item{})
+			} else {
+				err = BinaryCodec{}.Decode(encoded[:n], &User{})
+			}
+			if err == nil {
+				t.Errorf("%c: truncated to %d bytes: no error", encoded[0], n)
+			}
+		}
+	}
+	tests := []struct {
+		name    string
+		encoded []byte
+		value   interface{}
+	}{
+		{"unknown tag", []byte{codecTagMarker, 'z', 0x01}, &Item{}},
+		{"binary: version 0", []byte{codecTagMarker, ValueCodecTagBinary, binaryCodecTypeItem, 0}, &Item{}},
+		{"binary: future version", []byte{codecTagMarker, ValueCodecTagBinary, binaryCodecTypeItem, binaryCodecVersion + 1}, &Item{}},
+		{"binary: unknown type", []byte{codecTagMarker, ValueCodecTagBinary, 'X', binaryCodecVersion}, &Item{}},
+		{"binary: Item into User", append([]byte{codecTagMarker, ValueCodecTagBinary}, item...), &User{}},
+		{"binary: too long string", []byte{codecTagMarker, ValueCodecTagBinary, binaryCodecTypeUser, binaryCodecVersion, 0x02, 0xff, 0x01}, &User{}},
+		{"json: broken", []byte{codecTagMarker, ValueCodecTagJSON, '{'}, &TransactionEvidence{}},
+		{"gob: broken", []byte{codecTagMarker, ValueCodecTagGob, 0xff, 0xff}, &Item{}},
+		{"msgpack: broken", []byte{codecTagMarker, ValueCodecTagMsgpack, 0xdf}, &Item{}},
+	}
+	for _, test := range tests {
+		if err := DecodeWithCodec(test.encoded, test.value); err == nil {
+			t.Errorf("%s: no error", test.name)
+		}
+	}
+}
+
+func benchmarkCodecs(b *testing.B, run func(b *testing.B, codec ValueCodec, value interface{}, newValue func() interface{})) {
+	for _, sample := range codecSamples() {
+		for _, codec := range sortedCodecs() {
+			if _, err := codec.Encode(sample.value); err != nil {
+				continue
+			}
+			sample, codec := sample, codec
+			b.Run(sample.name+"/"+codec.Name(), func(b *testing.B) {
+				b.ReportAllocs()
+				run(b, codec, sample.value, sample.newValue)
+			})
+		}
+	}
+}
+
+// 実際に保存している型で各 codec を比べる
+//
+//	go test -run '^$' -bench Codec -benchmem
+func BenchmarkEncodeCodec(b *testing.B) {
+	benchmarkCodecs(b, func(b *testing.B, codec ValueCodec, value interface{}, newValue func() interface{}) {
+		for i := 0; i < b.N; i++ {
+			codec.Encode(value)
+		}
+	})
+}
+
+func BenchmarkDecodeCodec(b *testing.B) {
+	benchmarkCodecs(b, func(b *testing.B, codec ValueCodec, value interface{}, newValue func() interface{}) {
+		encoded, _ := codec.Encode(value)
+		b.SetBytes(int64(len(encoded)))
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			codec.Decode(encoded, newValue())
+		}
+	})
+}
+
+// prefix 無しの msgpack (codec を指定していないストア)
+func BenchmarkEncodeLegacyMsgpack(b *testing.B) {
+	for _, sample := range codecSamples() {
+		sample := sample
+		b.Run(sample.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				msgpack.Encode(&sample.value)
+			}
+		})
+	}
+}
+
+func BenchmarkDecodeLegacyMsgpack(b *testing.B) {
+	for _, sample := range codecSamples() {
+		sample := sample
+		b.Run(sample.name, func(b *testing.B) {
+			encoded := legacyMsgpack(sample.value)
+			b.ReportAllocs()
+			b.SetBytes(int64(len(encoded)))
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				DecodeWithCodec(encoded, sample.newValue())
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package kvtypes
+
+// 値を JSON で見せる / JSON から読むための補助 (webapp の NDJSON Export / Import と syncmapctl の get / set)
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// 構造体 (ptr) の公開フィールドを全て、フィールド名 -> 値にする
+func ExportFields(value interface{}) map[string]interface{} {
+	v := reflect.Indirect(reflect.ValueOf(value))
+	t := v.Type()
+	result := make(map[string]interface{}, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).PkgPath != "" {
+			continue
+		}
+		result[t.Field(i).Name] = v.Field(i).Interface()
+	}
+	return result
+}
+
+// ExportFields の逆。知らないフィールドがあればエラー
+func ImportFields(raw json.RawMessage, value interface{}) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return err
+	}
+	v := reflect.ValueOf(value).Elem()
+	for name, fieldRaw := range fields {
+		field, ok := v.Type().FieldByName(name)
+		if !ok || field.PkgPath != "" || len(field.Index) != 1 {
+			return fmt.Errorf("unknown field %q", name)
+		}
+		if err := json.Unmarshal(fieldRaw, v.Field(field.Index[0]).Addr().Interface()); err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+// msgpack の汎用デコード結果は map[interface{}]interface{} を含むので JSON にできる形に直す
+func NormalizeForJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case *interface{}:
+		return NormalizeForJSON(*v)
+	case map[interface{}]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, elem := range v {
+			result[fmt.Sprint(key)] = NormalizeForJSON(elem)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, elem := range v {
+			result[i] = NormalizeForJSON(elem)
+		}
+		return result
+	default:
+		return v
+	}
+}
+
+// 整数は int に戻す(float にすると IncrBy などで読めなくなる)
+func DenormalizeFromJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		if n, err := strconv.Atoi(v.String()); err == nil {
+			return n
+		}
+		f, _ := v.Float64()
+		return f
+	case map[string]interface{}:
+		for key, elem := range v {
+			v[key] = DenormalizeFromJSON(elem)
+		}
+		return v
+	case []interface{}:
+		for i, elem := range v {
+			v[i] = DenormalizeFromJSON(elem)
+		}
+		return v
+	default:
+		return v
+	}
+}
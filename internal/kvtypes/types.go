@@ -0,0 +1,188 @@
+// SyncMapServer に保存している値の型と、そのエンコード方式 (codec.go)
+//
+// webapp と cmd/syncmapctl の両方から使うので、保存する型のフィールドはここだけで定義する。
+// webapp 側では同じ名前の別名 (type User = kvtypes.User) にし、webapp でメソッドを生やしている型は
+// type loginAttempt kvtypes.LoginAttempt のようにフィールドだけを借りる(msgpack はフィールド名で読み書きする)。
+// Item / User は BinaryCodec が型で判定するので、必ず別名にすること。
+package kvtypes
+
+import "time"
+
+type User struct {
+	ID             int64     `json:"id" db:"id"`
+	AccountName    string    `json:"account_name" db:"account_name"`
+	HashedPassword []byte    `json:"-" db:"hashed_password"`
+	Address        string    `json:"address,omitempty" db:"address"`
+	NumSellItems   int       `json:"num_sell_items" db:"num_sell_items"`
+	LastBump       time.Time `json:"-" db:"last_bump"`
+	CreatedAt      time.Time `json:"-" db:"created_at"`
+	RatingCount    int       `json:"-" db:"rating_count"` // 受け取った評価の数と合計 (reviews.go)
+	RatingSum      int       `json:"-" db:"rating_sum"`
+}
+
+type Item struct {
+	ID          int64     `json:"id" db:"id"`
+	SellerID    int64     `json:"seller_id" db:"seller_id"`
+	BuyerID     int64     `json:"buyer_id" db:"buyer_id"`
+	Status      string    `json:"status" db:"status"`
+	Name        string    `json:"name" db:"name"`
+	Price       int       `json:"price" db:"price"`
+	Description string    `json:"description" db:"description"`
+	ImageName   string    `json:"image_name" db:"image_name"`
+	CategoryID  int       `json:"category_id" db:"category_id"`
+	CreatedAt   time.Time `json:"-" db:"created_at"`
+	UpdatedAt   time.Time `json:"-" db:"updated_at"`
+	TimeDateID  string    `json:"-" db:"timedateid"`
+}
+
+type TransactionEvidence struct {
+	ID                 int64     `json:"id" db:"id"`
+	SellerID           int64     `json:"seller_id" db:"seller_id"`
+	BuyerID            int64     `json:"buyer_id" db:"buyer_id"`
+	Status             string    `json:"status" db:"status"`
+	ItemID             int64     `json:"item_id" db:"item_id"`
+	ItemName           string    `json:"item_name" db:"item_name"`
+	ItemPrice          int       `json:"item_price" db:"item_price"`
+	ItemDescription    string    `json:"item_description" db:"item_description"`
+	ItemCategoryID     int       `json:"item_category_id" db:"item_category_id"`
+	ItemRootCategoryID int       `json:"item_root_category_id" db:"item_root_category_id"`
+	CreatedAt          time.Time `json:"-" db:"created_at"`
+	UpdatedAt          time.Time `json:"-" db:"updated_at"`
+}
+
+type Shipping struct {
+	TransactionEvidenceID int64     `json:"transaction_evidence_id" db:"transaction_evidence_id"`
+	Status                string    `json:"status" db:"status"`
+	ItemName              string    `json:"item_name" db:"item_name"`
+	ItemID                int64     `json:"item_id" db:"item_id"`
+	ReserveID             string    `json:"reserve_id" db:"reserve_id"`
+	ReserveTime           int64     `json:"reserve_time" db:"reserve_time"`
+	ToAddress             string    `json:"to_address" db:"to_address"`
+	ToName                string    `json:"to_name" db:"to_name"`
+	FromAddress           string    `json:"from_address" db:"from_address"`
+	FromName              string    `json:"from_name" db:"from_name"`
+	ImgBinary             []byte    `json:"-" db:"img_binary"`
+	CreatedAt             time.Time `json:"-" db:"created_at"`
+	UpdatedAt             time.Time `json:"-" db:"updated_at"`
+}
+
+// cookie の署名したセッション ID をキーにして保存する (sessions.go)
+type Session struct {
+	ID                     string
+	UserID                 int64
+	CSRFToken              string
+	PendingTwoFactorUserID int64 // パスワードは合って二段階認証を待っている (twofactor.go)
+	PendingTwoFactorAt     int64 // unix 秒
+	CreatedAt              time.Time
+	LastSeenAt             time.Time
+	ExpiresAt              time.Time
+	UserAgent              string
+	RemoteAddr             string
+}
+
+type LoginAttempt struct {
+	Failures      int
+	LastFailureAt time.Time
+	LockedUntil   time.Time
+	Pending       int // 確認中の試行の数。retryAfter では見ない
+}
+
+type TwoFactor struct {
+	UserID             int64
+	Enabled            bool
+	Secret             []byte
+	PendingSecret      []byte   // setup してまだ enable していない鍵
+	RecoveryCodeHashes [][]byte // 使ったものは消す
+	LastUsedStep       int64
+	EnabledAt          time.Time
+}
+
+type APIToken struct {
+	ID         string // 一覧や失効に使う ID。トークンそのものではない
+	UserID     int64
+	Name       string
+	Scopes     []string
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+}
+
+type Payment struct {
+	TransactionEvidenceID int64     `json:"transaction_evidence_id" db:"transaction_evidence_id"`
+	PaymentID             string    `json:"payment_id" db:"payment_id"`
+	Amount                int       `json:"amount" db:"amount"`
+	Status                string    `json:"status" db:"status"`
+	CreatedAt             time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt             time.Time `json:"updated_at" db:"updated_at"`
+}
+
+type Campaign struct {
+	ID          string
+	Level       int       // /initialize で返す段階 (0 - 4)
+	Rate        int       // ポイントの還元率 (%)
+	StartAt     time.Time // ゼロなら無期限
+	EndAt       time.Time // ゼロなら無期限。EndAt ちょうどは含まない
+	CategoryIDs []int     // 空なら全て。親カテゴリを指定すればその子も対象
+	UpdatedAt   time.Time
+}
+
+type PointGrant struct {
+	TransactionEvidenceID int64
+	ItemID                int64
+	CampaignID            string
+	Rate                  int
+	Points                int
+	CreatedAt             time.Time
+}
+
+type UserPoints struct {
+	Balance   int
+	History   []PointGrant // 新しい順に pointHistoryLimit 件まで
+	UpdatedAt time.Time
+}
+
+type Review struct {
+	TransactionEvidenceID int64
+	ItemID                int64
+	ItemName              string
+	Role                  string // 評価した側
+	ReviewerID            int64
+	RevieweeID            int64
+	Rating                int
+	Comment               string
+	CreatedAt             time.Time
+}
+
+type ItemLike struct {
+	UserID    int64
+	ItemID    int64
+	CreatedAt time.Time
+}
+
+type ItemComment struct {
+	ID        int64
+	ItemID    int64
+	UserID    int64
+	IsSeller  bool // 出品者の書き込み (返信)
+	Body      string
+	CreatedAt time.Time
+}
+
+type TransactionMessage struct {
+	ID                    int64
+	TransactionEvidenceID int64
+	SenderID              int64
+	Body                  string
+	CreatedAt             time.Time
+}
+
+type Notification struct {
+	ID                    int64
+	UserID                int64 // 通知を受け取る人
+	Event                 string
+	ActorID               int64 // 操作をした人
+	ItemID                int64
+	ItemName              string
+	TransactionEvidenceID int64
+	Read                  bool
+	CreatedAt             time.Time
+}
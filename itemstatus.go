@@ -0,0 +1,190 @@
+package main
+
+// 出品の停止 / 再開と、発送前の取引のキャンセル
+//
+// 停止 (stop) した商品は出品者にしか見えず、買えない。再開すると販売中 (on_sale) に戻る。
+// キャンセルは出品者か購入者が発送前(集荷を待っている間まで)に行える。取引と配送と商品を全て cancel にする。
+// transaction_evidences の item_id は UNIQUE なので、キャンセルした商品はもう一度売りには出せない。
+// 決済は与信の取り消しか返金をする (payments.go)。payment service に断られたらキャンセルしない。
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type reqItemStatus struct {
+	CSRFToken string `json:"csrf_token"`
+	ItemID    int64  `json:"item_id"`
+}
+
+type resItemStatus struct {
+	ItemID        int64  `json:"item_id"`
+	ItemStatus    string `json:"item_status"`
+	ItemUpdatedAt int64  `json:"item_updated_at"`
+}
+
+type reqCancel struct {
+	CSRFToken string `json:"csrf_token"`
+	ItemID    int64  `json:"item_id"`
+}
+
+func postItemStop(w http.ResponseWriter, r *http.Request) {
+	changeItemStatus(w, r, ItemStatusOnSale, ItemStatusStop)
+}
+
+func postItemResume(w http.ResponseWriter, r *http.Request) {
+	changeItemStatus(w, r, ItemStatusStop, ItemStatusOnSale)
+}
+
+func changeItemStatus(w http.ResponseWriter, r *http.Request, from, to string) {
+	ris := reqItemStatus{}
+	err := json.NewDecoder(r.Body).Decode(&ris)
+	if err != nil {
+		outputErrorMsg(w, http.StatusBadRequest, "json decode error")
+		return
+	}
+	if !csrfTokenOK(r, ris.CSRFToken) {
+		outputErrorMsg(w, http.StatusUnprocessableEntity, "csrf token error")
+		return
+	}
+	seller, errCode, errMsg := getUser(r)
+	if errMsg != "" {
+		outputErrorMsg(w, errCode, errMsg)
+		return
+	}
+	now := time.Now().Truncate(time.Second)
+	result, err := callItemProcedure(procedureItemStatus, strconv.Itoa(int(ris.ItemID)), int(seller.ID), from, to, int(now.Unix()))
+	if err != nil {
+		log.Print(err)
+		outputErrorMsg(w, http.StatusInternalServerError, "kvs error")
+		return
+	}
+	if result.Status != http.StatusOK {
+		outputErrorMsg(w, result.Status, result.Message)
+		return
+	}
+	writeBehind("UPDATE `items` SET `status` = ?, `updated_at` = ? WHERE `id` = ?", to, now, ris.ItemID)
+	publishStreamEvent([]int64{seller.ID}, streamEvent{
+		Event:      StreamEventItem,
+		ItemID:     result.Item.ID,
+		ItemStatus: result.Item.Status,
+		CreatedAt:  now.Unix(),
+	})
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	json.NewEncoder(w).Encode(resItemStatus{
+		ItemID:        result.Item.ID,
+		ItemStatus:    result.Item.Status,
+		ItemUpdatedAt: result.Item.UpdatedAt.Unix(),
+	})
+}
+
+func postCancel(w http.ResponseWriter, r *http.Request) {
+	rc := reqCancel{}
+	err := json.NewDecoder(r.Body).Decode(&rc)
+	if err != nil {
+		outputErrorMsg(w, http.StatusBadRequest, "json decode error")
+		return
+	}
+	if !csrfTokenOK(r, rc.CSRFToken) {
+		outputErrorMsg(w, http.StatusUnprocessableEntity, "csrf token error")
+		return
+	}
+	user, errCode, errMsg := getUser(r)
+	if errMsg != "" {
+		outputErrorMsg(w, errCode, errMsg)
+		return
+	}
+	itemIDStr := strconv.Itoa(int(rc.ItemID))
+	successed := false
+	transactionEvidence := TransactionEvidence{}
+	idToItemServer.Transaction(itemIDStr, func(tx KeyValueStoreConn) {
+		item := Item{}
+		if !tx.Get(itemIDStr, &item) {
+			outputErrorMsg(w, http.StatusNotFound, "item not found")
+			return
+		}
+		if item.Status != ItemStatusTrading {
+			outputErrorMsg(w, http.StatusForbidden, "商品が取引中ではありません")
+			return
+		}
+		if !itemIdToTransactionEvidenceServer.Get(itemIDStr, &transactionEvidence) {
+			outputErrorMsg(w, http.StatusNotFound, "transaction_evidences not found")
+			return
+		}
+		if transactionEvidence.SellerID != user.ID && transactionEvidence.BuyerID != user.ID {
+			outputErrorMsg(w, http.StatusForbidden, "権限がありません")
+			return
+		}
+		if transactionEvidence.Status != TransactionEvidenceStatusWaitShipping {
+			outputErrorMsg(w, http.StatusForbidden, "発送済みの取引はキャンセルできません")
+			return
+		}
+		trIdStr := strconv.Itoa(int(transactionEvidence.ID))
+		transactionEvidenceToShippingsServer.Transaction(trIdStr, func(stx KeyValueStoreConn) {
+			shipping := Shipping{}
+			if !stx.Get(trIdStr, &shipping) {
+				outputErrorMsg(w, http.StatusNotFound, "shippings not found")
+				return
+			}
+			switch shipping.Status {
+			case ShippingsStatusInitial:
+			case ShippingsStatusWaitPickup:
+				// ワーカーがまだ反映していないだけかもしれないので確かめる
+				ssr, err := APIShipmentStatus(getShipmentServiceURL(), &APIShipmentStatusReq{
+					ReserveID: shipping.ReserveID,
+				})
+				if err != nil {
+					log.Println(err)
+					outputErrorMsg(w, http.StatusInternalServerError, "failed to request to shipment service")
+					return
+				}
+				if ssr.Status != ShippingsStatusInitial && ssr.Status != ShippingsStatusWaitPickup {
+					outputErrorMsg(w, http.StatusForbidden, "発送済みの取引はキャンセルできません")
+					return
+				}
+			default:
+				outputErrorMsg(w, http.StatusForbidden, "発送済みの取引はキャンセルできません")
+				return
+			}
+			err := refundPayment(transactionEvidence.ID)
+			if err == ErrPaymentNotRecorded {
+				// /token で決済済みの取引は返金できない
+				outputErrorMsg(w, http.StatusForbidden, "この取引はキャンセルできません")
+				return
+			}
+			if err != nil {
+				log.Println(err)
+				outputErrorMsg(w, http.StatusInternalServerError, "payment service is failed")
+				return
+			}
+			now := time.Now().Truncate(time.Second)
+			shipping.Status = ShippingsStatusCancel
+			shipping.UpdatedAt = now
+			stx.Set(trIdStr, shipping)
+			transactionEvidence.Status = TransactionEvidenceStatusCancel
+			transactionEvidence.UpdatedAt = now
+			itemIdToTransactionEvidenceServer.Set(itemIDStr, transactionEvidence)
+			writeBehind("UPDATE `transaction_evidences` SET `status` = ?, `updated_at` = ? WHERE `id` = ?",
+				TransactionEvidenceStatusCancel,
+				now,
+				transactionEvidence.ID,
+			)
+			item.Status = ItemStatusCancel
+			item.UpdatedAt = now
+			tx.Set(itemIDStr, item)
+			writeBehind("UPDATE `items` SET `status` = ?, `updated_at` = ? WHERE `id` = ?",
+				ItemStatusCancel,
+				now,
+				item.ID,
+			)
+			successed = true
+		})
+	})
+	if successed {
+		emitTransactionEvent(TransactionEventCancel, transactionEvidence, user.ID)
+		w.Header().Set("Content-Type", "application/json;charset=utf-8")
+		json.NewEncoder(w).Encode(resBuy{TransactionEvidenceID: transactionEvidence.ID})
+	}
+}
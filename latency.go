@@ -0,0 +1,257 @@
+package main
+
+// エンドポイント毎のレイテンシとエラー率
+//
+// latencyMiddleware がリクエスト毎にルートのパターン ("POST /buy" など) 毎のヒストグラムに数え、
+// 各台が latencyReportInterval 毎にその間の分を eventServer の channelLatency に publish する。
+// eventServer の master の台で受け取って直近のものだけを持ち、キャンペーンの制御 (campaigncontroller.go) に使う。
+// ヒストグラムはバケットの境界が全台で同じなので足し合わせられる。
+import (
+	"log"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"goji.io/middleware"
+	"goji.io/pat"
+)
+
+const (
+	latencyReportInterval = 2 * time.Second
+	latencyKeepReports    = time.Minute // master で持っておく期間
+	latencyOtherEndpoint  = "other"     // どのルートにもマッチしなかったもの
+)
+
+// バケットの上限 (ms)。最後のバケットはそれより遅いもの全て
+var latencyBucketsMs = []int64{5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000}
+
+type endpointLatency struct {
+	Count     int64
+	Errors    int64 // 5xx
+	SumMicros int64
+	Buckets   []int64 // len(latencyBucketsMs) + 1
+}
+
+func (this *endpointLatency) add(elapsed time.Duration, status int) {
+	if this.Buckets == nil {
+		this.Buckets = make([]int64, len(latencyBucketsMs)+1)
+	}
+	this.Count++
+	if status >= 500 {
+		this.Errors++
+	}
+	this.SumMicros += int64(elapsed / time.Microsecond)
+	ms := int64(elapsed / time.Millisecond)
+	i := 0
+	for i < len(latencyBucketsMs) && ms > latencyBucketsMs[i] {
+		i++
+	}
+	this.Buckets[i]++
+}
+
+func (this *endpointLatency) merge(other endpointLatency) {
+	if this.Buckets == nil {
+		this.Buckets = make([]int64, len(latencyBucketsMs)+1)
+	}
+	this.Count += other.Count
+	this.Errors += other.Errors
+	this.SumMicros += other.SumMicros
+	for i := 0; i < len(other.Buckets) && i < len(this.Buckets); i++ {
+		this.Buckets[i] += other.Buckets[i]
+	}
+}
+
+// q (0-1) 分位点が入っているバケットの上限 (ms)。最後のバケットなら最後の境界の 2 倍にする
+func (this *endpointLatency) quantileMs(q float64) int64 {
+	if this.Count == 0 {
+		return 0
+	}
+	threshold := int64(float64(this.Count)*q + 0.5)
+	if threshold < 1 {
+		threshold = 1
+	}
+	cumulative := int64(0)
+	for i, n := range this.Buckets {
+		cumulative += n
+		if cumulative >= threshold {
+			if i < len(latencyBucketsMs) {
+				return latencyBucketsMs[i]
+			}
+			break
+		}
+	}
+	return latencyBucketsMs[len(latencyBucketsMs)-1] * 2
+}
+
+func (this *endpointLatency) errorRate() float64 {
+	if this.Count == 0 {
+		return 0
+	}
+	return float64(this.Errors) / float64(this.Count)
+}
+
+func (this *endpointLatency) averageMs() float64 {
+	if this.Count == 0 {
+		return 0
+	}
+	return float64(this.SumMicros) / float64(this.Count) / 1000
+}
+
+type latencyReport struct {
+	Host      string
+	At        int64 // 集計を終えた時刻 (unix nano)
+	Endpoints map[string]endpointLatency
+}
+
+// この台で集計中のもの
+var localLatency struct {
+	mutex     sync.Mutex
+	endpoints map[string]*endpointLatency
+}
+
+// master の台で受け取ったもの
+var receivedLatency struct {
+	mutex   sync.Mutex
+	reports []latencyReport
+}
+
+var latencyHost = func() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	return host
+}()
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (this *statusRecorder) WriteHeader(status int) {
+	if this.status == 0 {
+		this.status = status
+	}
+	this.ResponseWriter.WriteHeader(status)
+}
+func (this *statusRecorder) Write(b []byte) (int, error) {
+	if this.status == 0 {
+		this.status = http.StatusOK
+	}
+	return this.ResponseWriter.Write(b)
+}
+
+// ストリーミングするハンドラのために Flush を透過する
+func (this *statusRecorder) Flush() {
+	if flusher, ok := this.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func latencyMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w}
+		next.ServeHTTP(recorder, r)
+		if recorder.status == 0 {
+			recorder.status = http.StatusOK
+		}
+		recordLatency(latencyEndpointOf(r), time.Since(start), recorder.status)
+	})
+}
+
+func latencyEndpointOf(r *http.Request) string {
+	if p, ok := middleware.Pattern(r.Context()).(*pat.Pattern); ok {
+		return r.Method + " " + p.String()
+	}
+	return latencyOtherEndpoint
+}
+
+func recordLatency(endpoint string, elapsed time.Duration, status int) {
+	localLatency.mutex.Lock()
+	defer localLatency.mutex.Unlock()
+	if localLatency.endpoints == nil {
+		localLatency.endpoints = map[string]*endpointLatency{}
+	}
+	stats, ok := localLatency.endpoints[endpoint]
+	if !ok {
+		stats = &endpointLatency{}
+		localLatency.endpoints[endpoint] = stats
+	}
+	stats.add(elapsed, status)
+}
+
+// 全台で集計を publish し続ける。master はそれを受け取る
+func startLatencyReporter() {
+	if isMasterServerIP {
+		subscription := eventServer.Subscribe(channelLatency)
+		go func() {
+			for {
+				select {
+				case message := <-subscription.Messages():
+					report := latencyReport{}
+					if err := decodeFromBytes(message.Payload, &report); err != nil {
+						log.Println("latency: broken report", err)
+						continue
+					}
+					receiveLatencyReport(report)
+				case <-subscription.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		for {
+			time.Sleep(latencyReportInterval)
+			localLatency.mutex.Lock()
+			endpoints := localLatency.endpoints
+			localLatency.endpoints = nil
+			localLatency.mutex.Unlock()
+			if len(endpoints) == 0 {
+				continue
+			}
+			report := latencyReport{Host: latencyHost, At: time.Now().UnixNano(), Endpoints: map[string]endpointLatency{}}
+			for endpoint, stats := range endpoints {
+				report.Endpoints[endpoint] = *stats
+			}
+			eventServer.Publish(channelLatency, encodeToBytes(report))
+		}
+	}()
+}
+
+func receiveLatencyReport(report latencyReport) {
+	receivedLatency.mutex.Lock()
+	defer receivedLatency.mutex.Unlock()
+	oldest := time.Now().Add(-latencyKeepReports).UnixNano()
+	kept := receivedLatency.reports[:0] // 台毎に届く順番は前後するので全て見る
+	for _, r := range receivedLatency.reports {
+		if r.At >= oldest {
+			kept = append(kept, r)
+		}
+	}
+	receivedLatency.reports = append(kept, report)
+}
+
+// 直近 window の全台の分を足し合わせる
+func recentLatency(window time.Duration) map[string]*endpointLatency {
+	since := time.Now().Add(-window).UnixNano()
+	result := map[string]*endpointLatency{}
+	receivedLatency.mutex.Lock()
+	defer receivedLatency.mutex.Unlock()
+	for _, report := range receivedLatency.reports {
+		if report.At < since {
+			continue
+		}
+		for endpoint, stats := range report.Endpoints {
+			total, ok := result[endpoint]
+			if !ok {
+				total = &endpointLatency{}
+				result[endpoint] = total
+			}
+			total.merge(stats)
+		}
+	}
+	return result
+}
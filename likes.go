@@ -0,0 +1,197 @@
+package main
+
+// いいね (ウォッチリスト)
+//
+// likeServer に "userId:itemId" をキーにしていいねを保存し、いいねした順のインデックスで一覧を引く。
+// 商品毎の数は counterServer に "likes:itemId" で IncrBy して持ち、一覧では MGet でまとめて取る(getNewItems などで MySQL を引かない)。
+// いいね / 取り消しは何度呼んでも同じ結果になる。KV にしか保存しない。
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/isucon/isucon9-qualify/webapp/go/internal/kvtypes"
+)
+
+const indexLikesByUser = "likes.user"
+
+type itemLike = kvtypes.ItemLike
+
+type reqLike struct {
+	CSRFToken string `json:"csrf_token"`
+	ItemID    int64  `json:"item_id"`
+}
+
+type resLike struct {
+	ItemID    int64 `json:"item_id"`
+	LikeCount int   `json:"like_count"`
+	Liked     bool  `json:"liked"`
+}
+
+type resLikedItems struct {
+	Items   []resLikedItem `json:"items"`
+	HasNext bool           `json:"has_next"`
+}
+
+type resLikedItem struct {
+	ItemSimple
+	LikedAt int64 `json:"liked_at"` // ページングの created_at にはこれを渡す
+}
+
+func likeKey(userID, itemID int64) string {
+	return strconv.Itoa(int(userID)) + ":" + strconv.Itoa(int(itemID))
+}
+
+func registerLikeIndexes() {
+	likeServer.server.RegisterIndex(indexLikesByUser, func(value interface{}) ([]string, string) {
+		like := value.(*itemLike)
+		return []string{strconv.Itoa(int(like.UserID))}, formatTimeDateID(like.CreatedAt.Unix(), like.ItemID)
+	})
+}
+
+func itemLikeCount(itemID int64) int {
+	count := 0
+	counterServer.Get(counterKeyLikes+strconv.Itoa(int(itemID)), &count)
+	return count
+}
+
+func itemLikeCounts(items []Item) map[int64]int {
+	return itemCounters(counterKeyLikes, items)
+}
+
+func postLike(w http.ResponseWriter, r *http.Request) {
+	changeLike(w, r, true)
+}
+
+func postUnlike(w http.ResponseWriter, r *http.Request) {
+	changeLike(w, r, false)
+}
+
+func changeLike(w http.ResponseWriter, r *http.Request, liked bool) {
+	rl := reqLike{}
+	err := json.NewDecoder(r.Body).Decode(&rl)
+	if err != nil {
+		outputErrorMsg(w, http.StatusBadRequest, "json decode error")
+		return
+	}
+	if !csrfTokenOK(r, rl.CSRFToken) {
+		outputErrorMsg(w, http.StatusUnprocessableEntity, "csrf token error")
+		return
+	}
+	user, errCode, errMsg := getUser(r)
+	if errMsg != "" {
+		outputErrorMsg(w, errCode, errMsg)
+		return
+	}
+	itemIDStr := strconv.Itoa(int(rl.ItemID))
+	item := Item{}
+	if !idToItemServer.Get(itemIDStr, &item) || (liked && item.Status == ItemStatusStop && item.SellerID != user.ID) {
+		outputErrorMsg(w, http.StatusNotFound, "item not found")
+		return
+	}
+	key := likeKey(user.ID, item.ID)
+	count := 0
+	likeServer.Transaction(key, func(tx KeyValueStoreConn) {
+		exists := tx.Exists(key)
+		switch {
+		case liked && !exists:
+			tx.Set(key, itemLike{UserID: user.ID, ItemID: item.ID, CreatedAt: time.Now()})
+			count = counterServer.IncrBy(counterKeyLikes+itemIDStr, 1)
+		case !liked && exists:
+			tx.Del(key)
+			count = counterServer.IncrBy(counterKeyLikes+itemIDStr, -1)
+		default:
+			count = itemLikeCount(item.ID)
+		}
+	})
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	json.NewEncoder(w).Encode(resLike{ItemID: item.ID, LikeCount: count, Liked: liked})
+}
+
+func getLikedItems(w http.ResponseWriter, r *http.Request) {
+	user, errCode, errMsg := getUser(r)
+	if errMsg != "" {
+		outputErrorMsg(w, errCode, errMsg)
+		return
+	}
+
+	query := r.URL.Query()
+	cursor, errMsg := parsePagingCursor(query, "item_id")
+	if errMsg != "" {
+		outputErrorMsg(w, http.StatusBadRequest, errMsg)
+		return
+	}
+	keys, err := likeServer.IQuery(indexLikesByUser, strconv.Itoa(int(user.ID)), cursor, ItemsPerPage+1, true)
+	if err != nil {
+		log.Print(err)
+		outputErrorMsg(w, http.StatusInternalServerError, "kvs error")
+		return
+	}
+	// 停止中などで見せないものを除く前の数で決める。除いた後だと、次のページがあっても無いことになる
+	hasNext := false
+	if len(keys) > ItemsPerPage {
+		hasNext = true
+		keys = keys[0:ItemsPerPage]
+	}
+	mGotLikes := likeServer.MGet(keys)
+	likes := make([]itemLike, 0, len(keys))
+	itemIDStrs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		like := itemLike{}
+		if mGotLikes.Get(key, &like) {
+			likes = append(likes, like)
+			itemIDStrs = append(itemIDStrs, strconv.Itoa(int(like.ItemID)))
+		}
+	}
+	mGotItems := idToItemServer.MGet(itemIDStrs)
+	items := make([]Item, 0, len(likes))
+	likedAt := map[int64]time.Time{}
+	for _, like := range likes {
+		item := Item{}
+		if !mGotItems.Get(strconv.Itoa(int(like.ItemID)), &item) {
+			continue
+		}
+		if item.Status == ItemStatusStop && item.SellerID != user.ID {
+			continue // 停止中の商品は見せない (itemstatus.go)
+		}
+		items = append(items, item)
+		likedAt[item.ID] = like.CreatedAt
+	}
+	sellerIDs := make([]string, len(items))
+	for i, item := range items {
+		sellerIDs[i] = strconv.Itoa(int(item.SellerID))
+	}
+	mGotSellers := idToUserServer.MGet(sellerIDs)
+	likeCounts := itemLikeCounts(items)
+	res := resLikedItems{Items: []resLikedItem{}, HasNext: hasNext}
+	for _, item := range items {
+		category, err := getCategoryByID(dbx, item.CategoryID)
+		if err != nil {
+			outputErrorMsg(w, http.StatusNotFound, "category not found")
+			return
+		}
+		var seller User
+		mGotSellers.Get(strconv.Itoa(int(item.SellerID)), &seller)
+		simpleSeller := newUserSimple(seller)
+		res.Items = append(res.Items, resLikedItem{
+			ItemSimple: ItemSimple{
+				ID:         item.ID,
+				SellerID:   item.SellerID,
+				Seller:     &simpleSeller,
+				Status:     item.Status,
+				Name:       item.Name,
+				Price:      item.Price,
+				ImageURL:   getImageURL(item.ImageName),
+				CategoryID: item.CategoryID,
+				Category:   &category,
+				CreatedAt:  item.CreatedAt.Unix(),
+				LikeCount:  likeCounts[item.ID],
+			},
+			LikedAt: likedAt[item.ID].Unix(),
+		})
+	}
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	json.NewEncoder(w).Encode(res)
+}
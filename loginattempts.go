@@ -0,0 +1,278 @@
+package main
+
+// ログインの総当たり対策
+//
+// 失敗した回数をアカウント名毎と IP 毎に loginAttemptServer に数える。
+// delayAfter 回を超えて失敗すると次に試せるまでの間隔を倍々に延ばし、maxFailures 回でしばらくロックする。
+// ロック中や間隔を空けていない試行は 429 と Retry-After で断る(パスワードの確認もしない)。
+// 試せるかどうかの確認と試行中の数 (Pending) の加算は 1 つのトランザクションで行い (reserveLoginAttempt)、
+// パスワードなどが間違っていた時にだけ失敗として数える (fail)。試行中の数は間隔の計算には使わないので、
+// 同じ IP から正しいログインが同時にいくつ来ても断らない。
+// ロックは時間が経つか、:9876 の /debug/login-attempts/unlock で解除する。ログインに成功したらアカウントの数は戻す。
+import (
+	"encoding/json"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/isucon/isucon9-qualify/webapp/go/internal/kvtypes"
+)
+
+const (
+	loginAttemptWindow        = 10 * time.Minute // 最後の失敗からこれだけ経てば数え直す
+	loginAttemptBaseDelay     = time.Second
+	loginAttemptMaxDelay      = time.Minute
+	loginAttemptSweepInterval = 10 * time.Minute
+	loginAttemptKeyAccount    = "account:"
+	loginAttemptKeyIP         = "ip:"
+)
+
+type loginAttemptPolicy struct {
+	delayAfter  int
+	maxFailures int
+	lockout     time.Duration
+}
+
+var (
+	loginAttemptPolicyAccount = loginAttemptPolicy{delayAfter: 3, maxFailures: 10, lockout: 15 * time.Minute}
+	// ベンチマーカーや NAT の後ろの利用者は同じ IP なので緩めにする
+	loginAttemptPolicyIP = loginAttemptPolicy{delayAfter: 20, maxFailures: 100, lockout: 15 * time.Minute}
+)
+
+type loginAttempt kvtypes.LoginAttempt
+
+// 前の失敗が古いかロックが明けていれば数え直す
+func (this *loginAttempt) stale(now time.Time) bool {
+	if !this.LockedUntil.IsZero() {
+		return !now.Before(this.LockedUntil)
+	}
+	return now.Sub(this.LastFailureAt) > loginAttemptWindow
+}
+
+// 次に試せるまでの時間。今すぐ試せるなら 0
+func (this *loginAttempt) retryAfter(policy loginAttemptPolicy, now time.Time) time.Duration {
+	if this.stale(now) {
+		return 0
+	}
+	if now.Before(this.LockedUntil) {
+		return this.LockedUntil.Sub(now)
+	}
+	if this.Failures < policy.delayAfter {
+		return 0
+	}
+	delay := loginAttemptBaseDelay << uint(this.Failures-policy.delayAfter)
+	if delay > loginAttemptMaxDelay || delay <= 0 {
+		delay = loginAttemptMaxDelay
+	}
+	if wait := this.LastFailureAt.Add(delay).Sub(now); wait > 0 {
+		return wait
+	}
+	return 0
+}
+
+func loginAttemptPolicyOf(key string) loginAttemptPolicy {
+	if strings.HasPrefix(key, loginAttemptKeyIP) {
+		return loginAttemptPolicyIP
+	}
+	return loginAttemptPolicyAccount
+}
+
+func loginAttemptKeys(accountName, ip string) []string {
+	return []string{loginAttemptKeyAccount + accountName, loginAttemptKeyIP + ip}
+}
+
+// アカウントと IP の両方がまだ試せるか確かめ、試行中として数える (まとめて 1 つのトランザクションで行う)。
+// どちらかがまだ試せなければ何も数えずに待つ時間を返す。
+// 確認が終わったら、間違っていれば fail、合っていれば release を呼ぶ
+func reserveLoginAttempt(accountName, ip string) (*loginReservation, time.Duration) {
+	return reserveLoginAttemptAt(accountName, ip, time.Now())
+}
+
+type loginReservation struct {
+	keys []string
+}
+
+func reserveLoginAttemptAt(accountName, ip string, now time.Time) (*loginReservation, time.Duration) {
+	keys := loginAttemptKeys(accountName, ip)
+	var wait time.Duration
+	loginAttemptServer.TransactionWithKeys(keys, func(tx KeyValueStoreConn) {
+		attempts := make([]loginAttempt, len(keys))
+		for i, key := range keys {
+			tx.Get(key, &attempts[i])
+			if w := attempts[i].retryAfter(loginAttemptPolicyOf(key), now); w > wait {
+				wait = w
+			}
+		}
+		if wait > 0 {
+			return
+		}
+		for i, key := range keys {
+			attempts[i].Pending++
+			tx.Set(key, attempts[i])
+		}
+	})
+	if wait > 0 {
+		return nil, wait
+	}
+	return &loginReservation{keys: keys}, 0
+}
+
+// 間違っていたので失敗として数える。maxFailures 回目ならロックする
+func (this *loginReservation) fail() {
+	this.failAt(time.Now())
+}
+
+func (this *loginReservation) failAt(now time.Time) {
+	this.finish(func(key string, attempt *loginAttempt) {
+		policy := loginAttemptPolicyOf(key)
+		if attempt.stale(now) {
+			attempt.Failures = 0
+			attempt.LockedUntil = time.Time{}
+		}
+		attempt.Failures++
+		attempt.LastFailureAt = now
+		if attempt.Failures >= policy.maxFailures && attempt.LockedUntil.IsZero() {
+			attempt.LockedUntil = now.Add(policy.lockout)
+			log.Println("login locked:", key, "until", attempt.LockedUntil.Format(time.RFC3339))
+		}
+	})
+}
+
+// 合っていたので試行中の数を戻すだけにする
+func (this *loginReservation) release() {
+	this.finish(func(key string, attempt *loginAttempt) {})
+}
+
+func (this *loginReservation) finish(f func(key string, attempt *loginAttempt)) {
+	loginAttemptServer.TransactionWithKeys(this.keys, func(tx KeyValueStoreConn) {
+		for _, key := range this.keys {
+			attempt := loginAttempt{}
+			tx.Get(key, &attempt)
+			if attempt.Pending > 0 {
+				attempt.Pending--
+			}
+			f(key, &attempt)
+			if attempt.Failures == 0 && attempt.Pending == 0 && attempt.LockedUntil.IsZero() {
+				tx.Del(key)
+				continue
+			}
+			tx.Set(key, attempt)
+		}
+	})
+}
+
+func recordLoginSuccess(accountName string) {
+	loginAttemptServer.Del(loginAttemptKeyAccount + accountName)
+}
+
+func outputLoginRetryAfter(w http.ResponseWriter, wait time.Duration) {
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+	outputErrorMsg(w, http.StatusTooManyRequests, "ログインの試行回数が多すぎます。しばらくしてからやり直してください")
+}
+
+func startLoginAttemptSweeper() {
+	if !isMasterServerIP {
+		return
+	}
+	go func() {
+		for {
+			time.Sleep(loginAttemptSweepInterval)
+			keys := loginAttemptServer.AllKeys()
+			mGot := loginAttemptServer.MGet(keys)
+			now := time.Now()
+			for _, key := range keys {
+				attempt := loginAttempt{}
+				if mGot.Get(key, &attempt) && attempt.stale(now) {
+					loginAttemptServer.Del(key)
+				}
+			}
+		}
+	}()
+}
+
+type loginAttemptStatus struct {
+	Key           string     `json:"key"`
+	Failures      int        `json:"failures"`
+	Pending       int        `json:"pending"`
+	LastFailureAt time.Time  `json:"last_failure_at"`
+	LockedUntil   *time.Time `json:"locked_until,omitempty"`
+	RetryAfter    int        `json:"retry_after"` // 秒
+}
+
+// ?account= / ?ip= で指定したもの。どちらも無ければ今ロックされているもの全て
+//
+//	curl 'localhost:9876/debug/login-attempts?account=foo'
+func getDebugLoginAttempts(w http.ResponseWriter, r *http.Request) {
+	keys := debugLoginAttemptKeys(r)
+	lockedOnly := len(keys) == 0
+	if lockedOnly {
+		keys = loginAttemptServer.AllKeys()
+	}
+	mGot := loginAttemptServer.MGet(keys)
+	now := time.Now()
+	result := []loginAttemptStatus{}
+	for _, key := range keys {
+		attempt := loginAttempt{}
+		if !mGot.Get(key, &attempt) || attempt.stale(now) {
+			continue
+		}
+		locked := now.Before(attempt.LockedUntil)
+		if lockedOnly && !locked {
+			continue
+		}
+		status := loginAttemptStatus{
+			Key:           key,
+			Failures:      attempt.Failures,
+			Pending:       attempt.Pending,
+			LastFailureAt: attempt.LastFailureAt,
+			RetryAfter:    int(math.Ceil(attempt.retryAfter(loginAttemptPolicyOf(key), now).Seconds())),
+		}
+		if locked {
+			lockedUntil := attempt.LockedUntil
+			status.LockedUntil = &lockedUntil
+		}
+		result = append(result, status)
+	}
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	json.NewEncoder(w).Encode(result)
+}
+
+// 指定したアカウント名 / IP の数を戻してロックを解除する
+//
+//	curl -XPOST 'localhost:9876/debug/login-attempts/unlock?account=foo'
+func postDebugLoginAttemptsUnlock(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		outputErrorMsg(w, http.StatusMethodNotAllowed, "POST only")
+		return
+	}
+	keys := debugLoginAttemptKeys(r)
+	if len(keys) == 0 {
+		outputErrorMsg(w, http.StatusBadRequest, "account or ip is required")
+		return
+	}
+	unlocked := 0
+	for _, key := range keys {
+		if loginAttemptServer.Exists(key) {
+			loginAttemptServer.Del(key)
+			unlocked++
+		}
+	}
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	json.NewEncoder(w).Encode(struct {
+		Unlocked int `json:"unlocked"`
+	}{Unlocked: unlocked})
+}
+
+func debugLoginAttemptKeys(r *http.Request) []string {
+	keys := []string{}
+	if account := r.URL.Query().Get("account"); account != "" {
+		keys = append(keys, loginAttemptKeyAccount+account)
+	}
+	if ip := r.URL.Query().Get("ip"); ip != "" {
+		keys = append(keys, loginAttemptKeyIP+ip)
+	}
+	return keys
+}
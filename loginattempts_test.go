@@ -0,0 +1,185 @@
+package main
+
+import (
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func useTestLoginAttemptServer(t *testing.T) (restore func()) {
+	saved := loginAttemptServer
+	loginAttemptServer = newTestSyncMapServerConn(t)
+	loginAttemptServer.server.NewValueFunction = func() interface{} { return &loginAttempt{} }
+	return func() { loginAttemptServer = saved }
+}
+
+func TestLoginAttemptRetryAfter(t *testing.T) {
+	now := time.Unix(1565575823, 0)
+	policy := loginAttemptPolicy{delayAfter: 3, maxFailures: 10, lockout: 15 * time.Minute}
+	tests := []struct {
+		name    string
+		attempt loginAttempt
+		want    time.Duration
+	}{
+		{"no failures", loginAttempt{}, 0},
+		{"below delayAfter", loginAttempt{Failures: 2, LastFailureAt: now}, 0},
+		{"at delayAfter", loginAttempt{Failures: 3, LastFailureAt: now}, time.Second},
+		{"doubles", loginAttempt{Failures: 5, LastFailureAt: now}, 4 * time.Second},
+		{"part of the delay passed", loginAttempt{Failures: 5, LastFailureAt: now.Add(-3 * time.Second)}, time.Second},
+		{"delay passed", loginAttempt{Failures: 5, LastFailureAt: now.Add(-4 * time.Second)}, 0},
+		{"capped", loginAttempt{Failures: 9, LastFailureAt: now}, loginAttemptMaxDelay},
+		{"shift overflow is capped", loginAttempt{Failures: 200, LastFailureAt: now}, loginAttemptMaxDelay},
+		{"locked", loginAttempt{Failures: 10, LastFailureAt: now, LockedUntil: now.Add(10 * time.Minute)}, 10 * time.Minute},
+		{"lock expired", loginAttempt{Failures: 10, LastFailureAt: now.Add(-time.Hour), LockedUntil: now}, 0},
+		{"outside the window", loginAttempt{Failures: 9, LastFailureAt: now.Add(-loginAttemptWindow - time.Second)}, 0},
+	}
+	for _, test := range tests {
+		if got := test.attempt.retryAfter(policy, now); got != test.want {
+			t.Errorf("%s: retryAfter = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+// 間隔を空けながら失敗し続けると maxFailures 回目でロックされる
+func TestReserveLoginAttemptLockout(t *testing.T) {
+	defer useTestLoginAttemptServer(t)()
+	policy := loginAttemptPolicyAccount
+	now := time.Unix(1565575823, 0)
+	for i := 1; i <= policy.maxFailures; i++ {
+		reservation, wait := reserveLoginAttemptAt("isucon", "192.0.2.1", now)
+		if wait > 0 || reservation == nil {
+			t.Fatalf("attempt %d: wait %v", i, wait)
+		}
+		reservation.failAt(now)
+		attempt := loginAttempt{}
+		loginAttemptServer.Get(loginAttemptKeyAccount+"isucon", &attempt)
+		if attempt.Failures != i || attempt.Pending != 0 {
+			t.Fatalf("attempt %d: failures = %d, pending = %d", i, attempt.Failures, attempt.Pending)
+		}
+		// 間隔が必要なら、その直前はまだ断られる
+		if wait := attempt.retryAfter(policy, now); wait > 0 && i < policy.maxFailures {
+			if _, w := reserveLoginAttemptAt("isucon", "192.0.2.1", now.Add(wait-time.Millisecond)); w <= 0 {
+				t.Errorf("attempt %d: retried before the delay", i)
+			}
+			now = now.Add(wait)
+		}
+	}
+	_, wait := reserveLoginAttemptAt("isucon", "192.0.2.1", now)
+	if wait != policy.lockout {
+		t.Errorf("after %d failures: wait = %v, want %v", policy.maxFailures, wait, policy.lockout)
+	}
+	// 別の IP からでもアカウントはロックされている。ロックが明ければ数え直す
+	if _, wait := reserveLoginAttemptAt("isucon", "192.0.2.2", now.Add(time.Minute)); wait <= 0 {
+		t.Error("locked account accepted an attempt from another IP")
+	}
+	reservation, wait := reserveLoginAttemptAt("isucon", "192.0.2.2", now.Add(policy.lockout))
+	if wait != 0 {
+		t.Fatalf("after the lockout: wait = %v", wait)
+	}
+	reservation.failAt(now.Add(policy.lockout))
+	attempt := loginAttempt{}
+	loginAttemptServer.Get(loginAttemptKeyAccount+"isucon", &attempt)
+	if attempt.Failures != 1 || !attempt.LockedUntil.IsZero() {
+		t.Errorf("after the lockout: %+v", attempt)
+	}
+}
+
+// 同じ IP とアカウントから同時に来ても、合っていれば全て通す
+func TestReserveLoginAttemptConcurrent(t *testing.T) {
+	defer useTestLoginAttemptServer(t)()
+	now := time.Unix(1565575823, 0)
+	const n = 150 // IP の maxFailures より多い
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	admitted := 0
+	reservations := make(chan *loginReservation, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			reservation, wait := reserveLoginAttemptAt("isucon", "192.0.2.1", now)
+			if wait == 0 {
+				mutex.Lock()
+				admitted++
+				mutex.Unlock()
+				reservations <- reservation
+			}
+		}()
+	}
+	wg.Wait()
+	close(reservations)
+	if admitted != n {
+		t.Fatalf("admitted %d attempts, want %d", admitted, n)
+	}
+	attempt := loginAttempt{}
+	loginAttemptServer.Get(loginAttemptKeyIP+"192.0.2.1", &attempt)
+	if attempt.Pending != n || attempt.Failures != 0 {
+		t.Errorf("in flight: %+v", attempt)
+	}
+	for reservation := range reservations {
+		reservation.release()
+	}
+	if loginAttemptServer.Exists(loginAttemptKeyAccount+"isucon") || loginAttemptServer.Exists(loginAttemptKeyIP+"192.0.2.1") {
+		t.Error("released reservations left attempts behind")
+	}
+	if _, wait := reserveLoginAttemptAt("isucon", "192.0.2.1", now); wait != 0 {
+		t.Errorf("after successful logins: wait = %v", wait)
+	}
+}
+
+// 失敗は確認が終わってから数え、試行中の数は間隔に効かない
+func TestLoginReservationFailAndRelease(t *testing.T) {
+	defer useTestLoginAttemptServer(t)()
+	now := time.Unix(1565575823, 0)
+	accountKey := loginAttemptKeyAccount + "isucon"
+	policy := loginAttemptPolicyAccount
+
+	// delayAfter 回失敗させ、その直前に別の試行を始めておく
+	inFlight, _ := reserveLoginAttemptAt("isucon", "192.0.2.1", now)
+	for i := 0; i < policy.delayAfter; i++ {
+		reservation, wait := reserveLoginAttemptAt("isucon", "192.0.2.1", now)
+		if wait > 0 {
+			t.Fatalf("failure %d: wait = %v", i, wait)
+		}
+		reservation.failAt(now)
+	}
+	if _, wait := reserveLoginAttemptAt("isucon", "192.0.2.1", now); wait != loginAttemptBaseDelay {
+		t.Errorf("after %d failures: wait = %v, want %v", policy.delayAfter, wait, loginAttemptBaseDelay)
+	}
+	// 先に始めていた試行が合っていても数えた失敗はそのまま
+	inFlight.release()
+	attempt := loginAttempt{}
+	loginAttemptServer.Get(accountKey, &attempt)
+	if attempt.Failures != policy.delayAfter || attempt.Pending != 0 || !attempt.LastFailureAt.Equal(now) {
+		t.Errorf("after release: %+v", attempt)
+	}
+}
+
+func TestRequestRemoteAddr(t *testing.T) {
+	tests := []struct {
+		name       string
+		remoteAddr string
+		headers    map[string]string
+		want       string
+	}{
+		{"direct", "203.0.113.5:40000", nil, "203.0.113.5"},
+		{"X-Forwarded-For is ignored", "203.0.113.5:40000", map[string]string{"X-Forwarded-For": "198.51.100.1"}, "203.0.113.5"},
+		{"X-Real-IP from outside is ignored", "203.0.113.5:40000", map[string]string{"X-Real-IP": "198.51.100.1"}, "203.0.113.5"},
+		{"X-Real-IP from local nginx", "127.0.0.1:40000", map[string]string{"X-Real-IP": "198.51.100.1"}, "198.51.100.1"},
+		{"X-Real-IP from nginx on another host", "172.24.122.184:40000", map[string]string{"X-Real-IP": "198.51.100.1"}, "198.51.100.1"},
+		{"internal without X-Real-IP", "10.0.0.2:40000", map[string]string{"X-Forwarded-For": "198.51.100.1"}, "10.0.0.2"},
+		{"IPv6", "[2001:db8::1]:40000", nil, "2001:db8::1"},
+		{"no port", "203.0.113.5", nil, "203.0.113.5"},
+	}
+	for _, test := range tests {
+		r := httptest.NewRequest("POST", "/login", nil)
+		r.RemoteAddr = test.remoteAddr
+		for key, value := range test.headers {
+			r.Header.Set(key, value)
+		}
+		if got := requestRemoteAddr(r); got != test.want {
+			t.Errorf("%s: requestRemoteAddr = %q, want %q", test.name, got, test.want)
+		}
+	}
+}
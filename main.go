@@ -12,7 +12,6 @@ import (
 	"time"
 
 	_ "github.com/go-sql-driver/mysql"
-	"github.com/gorilla/sessions"
 	"github.com/jmoiron/sqlx"
 	goji "goji.io"
 	"goji.io/pat"
@@ -20,7 +19,6 @@ import (
 
 func init() {
 	rand.Seed(time.Now().UnixNano())
-	store = sessions.NewCookieStore([]byte("abc"))
 	client = http.Client{
 		Timeout: 5 * time.Second,
 		Transport: &http.Transport{
@@ -37,68 +35,98 @@ func init() {
 	))
 }
 
-func main() {
-	go func() { log.Println(http.ListenAndServe(":9876", nil)) }()
-	host := os.Getenv("MYSQL_HOST")
-	if host == "" {
-		host = "127.0.0.1"
-	}
-	port := os.Getenv("MYSQL_PORT")
-	if port == "" {
-		port = "3306"
-	}
-	_, err := strconv.Atoi(port)
-	if err != nil {
-		log.Fatalf("failed to read DB port number from an environment variable MYSQL_PORT.\nError: %s", err.Error())
-	}
-	user := os.Getenv("MYSQL_USER")
-	if user == "" {
-		user = "isucari"
-	}
-	dbname := os.Getenv("MYSQL_DBNAME")
-	if dbname == "" {
-		dbname = "isucari"
+// サブコマンド。サーバーとしては起動せず、動いているサーバーの SyncMapServer に slave としてつなぐ
+var subcommandNames = []string{"reconcile", "migrate-users", "import-redis"}
+
+func subcommandName() string {
+	if len(os.Args) < 2 {
+		return ""
 	}
-	password := os.Getenv("MYSQL_PASS")
-	if password == "" {
-		password = "isucari"
+	for _, name := range subcommandNames {
+		if os.Args[1] == name {
+			return name
+		}
 	}
+	return ""
+}
 
-	dsn := fmt.Sprintf(
-		"%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=true&loc=Local&interpolateParams=true",
-		user,
-		password,
-		host,
-		port,
-		dbname,
-	)
+func runSubcommand(name string, args []string) int {
+	switch name {
+	case "reconcile":
+		return runReconcileCommand(args)
+	case "migrate-users":
+		return runMigrateUsersCommand(args)
+	case "import-redis":
+		return runImportRedisCommand(args)
+	}
+	return 2
+}
 
-	dbx, err = sqlx.Open("mysql", dsn)
+func main() {
+	if name := subcommandName(); name != "" {
+		dbx = connectDB()
+		os.Exit(runSubcommand(name, os.Args[2:]))
+	}
+	keys, err := sessionKeysFromEnv()
 	if err != nil {
-		log.Fatalf("failed to connect to DB: %s.", err.Error())
+		log.Fatal(err)
 	}
+	store = newKVSessionStore(keys)
+
+	registerDebugHandlers()
+	go func() { log.Println(http.ListenAndServe(debugListenAddress, nil)) }()
+	dbx = connectDB()
 	defer dbx.Close()
 
 	mux := goji.NewMux()
+	mux.Use(latencyMiddleware)
+	mux.Use(apiTokenMiddleware)
 
 	// API
 	mux.HandleFunc(pat.Post("/initialize"), postInitialize)
 	mux.HandleFunc(pat.Get("/new_items.json"), getNewItems)
 	mux.HandleFunc(pat.Get("/new_items/:root_category_id.json"), getNewCategoryItems)
 	mux.HandleFunc(pat.Get("/users/transactions.json"), getTransactions)
+	mux.HandleFunc(pat.Get("/users/transactions/stream"), getTransactionStream)
+	mux.HandleFunc(pat.Get("/users/sessions.json"), getSessions)
+	mux.HandleFunc(pat.Post("/users/sessions/revoke"), postRevokeSessions)
+	mux.HandleFunc(pat.Get("/users/tokens.json"), getAPITokens)
+	mux.HandleFunc(pat.Get("/users/points.json"), getPoints)
+	mux.HandleFunc(pat.Get("/users/likes.json"), getLikedItems)
+	mux.HandleFunc(pat.Post("/users/tokens"), postAPIToken)
+	mux.HandleFunc(pat.Post("/users/tokens/revoke"), postRevokeAPIToken)
+	mux.HandleFunc(pat.Get("/users/:user_id/reviews.json"), getUserReviews)
 	mux.HandleFunc(pat.Get("/users/:user_id.json"), getUserItems)
+	mux.HandleFunc(pat.Get("/items/:item_id/comments.json"), getItemComments)
+	mux.HandleFunc(pat.Get("/items/:item_id/messages.json"), getMessages)
 	mux.HandleFunc(pat.Get("/items/:item_id.json"), getItem)
 	mux.HandleFunc(pat.Post("/items/edit"), postItemEdit)
+	mux.HandleFunc(pat.Post("/items/stop"), postItemStop)
+	mux.HandleFunc(pat.Post("/items/resume"), postItemResume)
+	mux.HandleFunc(pat.Post("/items/like"), postLike)
+	mux.HandleFunc(pat.Post("/items/unlike"), postUnlike)
+	mux.HandleFunc(pat.Post("/items/comments"), postComment)
+	mux.HandleFunc(pat.Post("/items/comments/delete"), postDeleteComment)
+	mux.HandleFunc(pat.Post("/items/messages"), postMessage)
+	mux.HandleFunc(pat.Get("/notifications.json"), getNotifications)
+	mux.HandleFunc(pat.Post("/notifications/read"), postReadNotifications)
 	mux.HandleFunc(pat.Post("/buy"), postBuy)
 	mux.HandleFunc(pat.Post("/sell"), postSell)
 	mux.HandleFunc(pat.Post("/ship"), postShip)
 	mux.HandleFunc(pat.Post("/ship_done"), postShipDone)
 	mux.HandleFunc(pat.Post("/complete"), postComplete)
+	mux.HandleFunc(pat.Post("/cancel"), postCancel)
+	mux.HandleFunc(pat.Post("/review"), postReview)
 	mux.HandleFunc(pat.Get("/transactions/:transaction_evidence_id.png"), getQRCode)
 	mux.HandleFunc(pat.Post("/bump"), postBump)
 	mux.HandleFunc(pat.Get("/settings"), getSettings)
 	mux.HandleFunc(pat.Post("/login"), postLogin)
 	mux.HandleFunc(pat.Post("/register"), postRegister)
+	mux.HandleFunc(pat.Post("/login/2fa"), postLoginTwoFactor)
+	mux.HandleFunc(pat.Post("/logout"), postLogout)
+	mux.HandleFunc(pat.Post("/users/2fa/setup"), postTwoFactorSetup)
+	mux.HandleFunc(pat.Post("/users/2fa/enable"), postTwoFactorEnable)
+	mux.HandleFunc(pat.Post("/users/2fa/disable"), postTwoFactorDisable)
 	mux.HandleFunc(pat.Get("/reports.json"), getReports)
 	// Frontend
 	mux.HandleFunc(pat.Get("/"), getIndex)
@@ -120,3 +148,45 @@ func main() {
 	setInitializeFunction()
 	log.Fatal(http.ListenAndServe(":8000", mux))
 }
+
+func connectDB() *sqlx.DB {
+	host := os.Getenv("MYSQL_HOST")
+	if host == "" {
+		host = "127.0.0.1"
+	}
+	port := os.Getenv("MYSQL_PORT")
+	if port == "" {
+		port = "3306"
+	}
+	_, err := strconv.Atoi(port)
+	if err != nil {
+		log.Fatalf("failed to read DB port number from an environment variable MYSQL_PORT.\nError: %s", err.Error())
+	}
+	user := os.Getenv("MYSQL_USER")
+	if user == "" {
+		user = "isucari"
+	}
+	dbname := os.Getenv("MYSQL_DBNAME")
+	if dbname == "" {
+		dbname = "isucari"
+	}
+	password := os.Getenv("MYSQL_PASS")
+	if password == "" {
+		password = "isucari"
+	}
+
+	dsn := fmt.Sprintf(
+		"%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=true&loc=Local&interpolateParams=true",
+		user,
+		password,
+		host,
+		port,
+		dbname,
+	)
+
+	db, err := sqlx.Open("mysql", dsn)
+	if err != nil {
+		log.Fatalf("failed to connect to DB: %s.", err.Error())
+	}
+	return db
+}
@@ -0,0 +1,275 @@
+package main
+
+// 取引ごとの購入者と出品者のメッセージ
+//
+// TransactionEvidence 1 つに 1 スレッド。読み書きできるのはその取引の購入者と出品者だけ。
+// 取引が終わった (done / cancel) 後も揉めた時のために残し、書き込みもできる。
+// ID は transaction_messages への INSERT で採番するので同期で書き、messageServer にメッセージ ID をキーにして保存する。
+// 既読は counterServer に "取引ID:ユーザーID" 毎の最後に読んだ ID と未読数で持つ (getTransactions で未読数を MGet する)。
+// 一覧は since_id より後のものを古い順に返すので、クライアントは最後に受け取った ID を渡してポーリングする。
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/isucon/isucon9-qualify/webapp/go/internal/kvtypes"
+	"goji.io/pat"
+)
+
+const (
+	messageMaxLength           = 1000 // 文字数
+	indexMessagesByTransaction = "messages.transaction"
+)
+
+type transactionMessage = kvtypes.TransactionMessage
+
+type reqPostMessage struct {
+	CSRFToken string `json:"csrf_token"`
+	ItemID    int64  `json:"item_id"`
+	Body      string `json:"body"`
+}
+
+type resMessage struct {
+	ID                    int64       `json:"id"`
+	TransactionEvidenceID int64       `json:"transaction_evidence_id"`
+	Sender                *UserSimple `json:"sender"`
+	Body                  string      `json:"body"`
+	CreatedAt             int64       `json:"created_at"`
+}
+
+type resMessages struct {
+	Messages []resMessage `json:"messages"`
+	HasNext  bool         `json:"has_next"` // true なら最後の id を since_id にしてすぐ次を取る
+}
+
+// ID は増えていくだけなので、そのまま並び順にする
+func messageSortKey(messageID int64) string {
+	return fmt.Sprintf("%016d", messageID)
+}
+
+func messageReadKey(transactionEvidenceID, userID int64) string {
+	return strconv.Itoa(int(transactionEvidenceID)) + ":" + strconv.Itoa(int(userID))
+}
+
+func registerMessageIndexes() {
+	messageServer.server.RegisterIndex(indexMessagesByTransaction, func(value interface{}) ([]string, string) {
+		message := value.(*transactionMessage)
+		return []string{strconv.Itoa(int(message.TransactionEvidenceID))}, messageSortKey(message.ID)
+	})
+}
+
+// 取引ID -> そのユーザーの未読数
+func unreadMessageCounts(transactionEvidenceIDs []int64, userID int64) map[int64]int {
+	keys := make([]string, len(transactionEvidenceIDs))
+	for i, id := range transactionEvidenceIDs {
+		keys[i] = counterKeyUnreadMessages + messageReadKey(id, userID)
+	}
+	mGot := counterServer.MGet(keys)
+	counts := map[int64]int{}
+	for i, id := range transactionEvidenceIDs {
+		unread := 0
+		if mGot.Get(keys[i], &unread) {
+			counts[id] = unread
+		}
+	}
+	return counts
+}
+
+// 購入者か出品者でなければエラーを書いて false を返す
+func getTransactionEvidenceForParty(w http.ResponseWriter, itemID int64, userID int64) (TransactionEvidence, bool) {
+	transactionEvidence := TransactionEvidence{}
+	if !itemIdToTransactionEvidenceServer.Get(strconv.Itoa(int(itemID)), &transactionEvidence) {
+		outputErrorMsg(w, http.StatusNotFound, "transaction_evidences not found")
+		return transactionEvidence, false
+	}
+	if transactionEvidence.BuyerID != userID && transactionEvidence.SellerID != userID {
+		outputErrorMsg(w, http.StatusForbidden, "権限がありません")
+		return transactionEvidence, false
+	}
+	return transactionEvidence, true
+}
+
+func postMessage(w http.ResponseWriter, r *http.Request) {
+	rpm := reqPostMessage{}
+	err := json.NewDecoder(r.Body).Decode(&rpm)
+	if err != nil {
+		outputErrorMsg(w, http.StatusBadRequest, "json decode error")
+		return
+	}
+	if !csrfTokenOK(r, rpm.CSRFToken) {
+		outputErrorMsg(w, http.StatusUnprocessableEntity, "csrf token error")
+		return
+	}
+	user, errCode, errMsg := getUser(r)
+	if errMsg != "" {
+		outputErrorMsg(w, errCode, errMsg)
+		return
+	}
+	body := strings.TrimSpace(rpm.Body)
+	if body == "" {
+		outputErrorMsg(w, http.StatusBadRequest, "all parameters are required")
+		return
+	}
+	if utf8.RuneCountInString(body) > messageMaxLength {
+		outputErrorMsg(w, http.StatusBadRequest, "メッセージが長すぎます")
+		return
+	}
+	transactionEvidence, ok := getTransactionEvidenceForParty(w, rpm.ItemID, user.ID)
+	if !ok {
+		return
+	}
+	message := transactionMessage{
+		TransactionEvidenceID: transactionEvidence.ID,
+		SenderID:              user.ID,
+		Body:                  body,
+		CreatedAt:             time.Now().Truncate(time.Second),
+	}
+	result, err := dbx.Exec("INSERT INTO `transaction_messages` (`transaction_evidence_id`, `sender_id`, `body`, `created_at`) VALUES (?, ?, ?, ?)",
+		message.TransactionEvidenceID,
+		message.SenderID,
+		message.Body,
+		message.CreatedAt,
+	)
+	if err != nil {
+		log.Print(err)
+		outputErrorMsg(w, http.StatusInternalServerError, "db error")
+		return
+	}
+	message.ID, _ = result.LastInsertId()
+
+	recipientID := transactionEvidence.BuyerID
+	if user.ID == transactionEvidence.BuyerID {
+		recipientID = transactionEvidence.SellerID
+	}
+	// getMessages が既読にするのと同じ鍵を取って、保存と未読を増やすのを一度に見せる。
+	// 別々だと保存した後・増やす前に既読にされ、読んだメッセージが未読で残る
+	readKey := messageReadKey(transactionEvidence.ID, recipientID)
+	counterServer.TransactionWithKeys([]string{counterKeyLastReadMessage + readKey, counterKeyUnreadMessages + readKey}, func(tx KeyValueStoreConn) {
+		messageServer.Set(strconv.Itoa(int(message.ID)), message)
+		tx.IncrBy(counterKeyUnreadMessages+readKey, 1)
+	})
+
+	sender, _ := getUserSimpleByID(dbx, user.ID)
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	json.NewEncoder(w).Encode(newResMessage(message, &sender))
+}
+
+func newResMessage(message transactionMessage, sender *UserSimple) resMessage {
+	return resMessage{
+		ID:                    message.ID,
+		TransactionEvidenceID: message.TransactionEvidenceID,
+		Sender:                sender,
+		Body:                  message.Body,
+		CreatedAt:             message.CreatedAt.Unix(),
+	}
+}
+
+// 返したメッセージは既読にする
+func getMessages(w http.ResponseWriter, r *http.Request) {
+	itemIDStr := pat.Param(r, "item_id")
+	itemID, err := strconv.ParseInt(itemIDStr, 10, 64)
+	if err != nil || itemID <= 0 {
+		outputErrorMsg(w, http.StatusBadRequest, "incorrect item id")
+		return
+	}
+	user, errCode, errMsg := getUser(r)
+	if errMsg != "" {
+		outputErrorMsg(w, errCode, errMsg)
+		return
+	}
+
+	sinceIDStr := r.URL.Query().Get("since_id")
+	var sinceID int64
+	if sinceIDStr != "" {
+		sinceID, err = strconv.ParseInt(sinceIDStr, 10, 64)
+		if err != nil || sinceID < 0 {
+			outputErrorMsg(w, http.StatusBadRequest, "since_id param error")
+			return
+		}
+	}
+
+	transactionEvidence, ok := getTransactionEvidenceForParty(w, itemID, user.ID)
+	if !ok {
+		return
+	}
+
+	cursor := ""
+	if sinceID > 0 {
+		cursor = messageSortKey(sinceID)
+	}
+	keys, err := messageServer.IQuery(indexMessagesByTransaction, strconv.Itoa(int(transactionEvidence.ID)), cursor, MessagesPerPage+1, false)
+	if err != nil {
+		log.Print(err)
+		outputErrorMsg(w, http.StatusInternalServerError, "kvs error")
+		return
+	}
+	mGot := messageServer.MGet(keys)
+	messages := make([]transactionMessage, 0, len(keys))
+	for _, key := range keys {
+		message := transactionMessage{}
+		if mGot.Get(key, &message) {
+			messages = append(messages, message)
+		}
+	}
+	hasNext := false
+	if len(messages) > MessagesPerPage {
+		hasNext = true
+		messages = messages[0:MessagesPerPage]
+	}
+
+	// 送れるのは購入者と出品者だけなので、その 2 人をまとめて取る
+	mGotUsers := idToUserServer.MGet([]string{
+		strconv.Itoa(int(transactionEvidence.BuyerID)),
+		strconv.Itoa(int(transactionEvidence.SellerID)),
+	})
+	senders := map[int64]*UserSimple{}
+	res := resMessages{Messages: []resMessage{}, HasNext: hasNext}
+	for _, message := range messages {
+		sender, ok := senders[message.SenderID]
+		if !ok {
+			var senderUser User
+			mGotUsers.Get(strconv.Itoa(int(message.SenderID)), &senderUser)
+			senderSimple := newUserSimple(senderUser)
+			sender = &senderSimple
+			senders[message.SenderID] = sender
+		}
+		res.Messages = append(res.Messages, newResMessage(message, sender))
+	}
+
+	readKey := messageReadKey(transactionEvidence.ID, user.ID)
+	lastReadKey := counterKeyLastReadMessage + readKey
+	unreadKey := counterKeyUnreadMessages + readKey
+	counterServer.TransactionWithKeys([]string{lastReadKey, unreadKey}, func(tx KeyValueStoreConn) {
+		lastReadID := 0
+		tx.Get(lastReadKey, &lastReadID)
+		read := 0
+		for _, message := range messages {
+			// 同じ since_id で何度ポーリングされても数え直さない
+			if message.SenderID == user.ID || message.ID <= int64(lastReadID) {
+				continue
+			}
+			lastReadID = int(message.ID)
+			read++
+		}
+		if read == 0 {
+			return
+		}
+		tx.Set(lastReadKey, lastReadID)
+		unread := 0
+		tx.Get(unreadKey, &unread)
+		if read > unread {
+			read = unread
+		}
+		if read > 0 {
+			tx.IncrBy(unreadKey, -read)
+		}
+	})
+
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	json.NewEncoder(w).Encode(res)
+}
@@ -0,0 +1,206 @@
+package main
+
+// 取引の通知
+//
+// 購入 / 発送 / 発送完了 / 受取 / キャンセルが成功したら、各ハンドラは emitTransactionEvent を呼ぶだけにして、
+// 誰に何を通知するかはここで決める(その操作をした人ではない方の当事者に届ける)。
+// notificationServer に通知 ID をキーにして保存し、ユーザー毎の新しい順のインデックスで一覧を引く。
+// 未読数は counterServer に "unread_notifications:userId" で IncrBy して持ち、/settings で返す。KV にしか保存しない。
+// 全て既読にする時は通知を 1 件ずつ書き換えず、その時の最後の通知 ID をユーザー毎の既読の位置として持つ。
+// それ以下の ID の通知は Read が false でも既読として扱う。
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/isucon/isucon9-qualify/webapp/go/internal/kvtypes"
+)
+
+const (
+	TransactionEventBuy      = "buy"
+	TransactionEventShip     = "ship"
+	TransactionEventShipDone = "ship_done"
+	TransactionEventComplete = "complete"
+	TransactionEventCancel   = "cancel"
+
+	notificationIDKey        = "notification.id" // eventServer で採番する
+	indexNotificationsByUser = "notifications.user"
+)
+
+type notification = kvtypes.Notification
+
+type reqReadNotifications struct {
+	CSRFToken      string `json:"csrf_token"`
+	NotificationID int64  `json:"notification_id"` // 0 なら全て
+}
+
+type resNotification struct {
+	ID                    int64  `json:"id"`
+	Event                 string `json:"event"`
+	ActorID               int64  `json:"actor_id"`
+	ItemID                int64  `json:"item_id"`
+	ItemName              string `json:"item_name"`
+	TransactionEvidenceID int64  `json:"transaction_evidence_id"`
+	Read                  bool   `json:"read"`
+	CreatedAt             int64  `json:"created_at"`
+}
+
+type resNotifications struct {
+	Notifications []resNotification `json:"notifications"`
+	UnreadCount   int               `json:"unread_count"`
+	HasNext       bool              `json:"has_next"`
+}
+
+type resReadNotifications struct {
+	UnreadCount int `json:"unread_count"`
+}
+
+func registerNotificationIndexes() {
+	notificationServer.server.RegisterIndex(indexNotificationsByUser, func(value interface{}) ([]string, string) {
+		n := value.(*notification)
+		return []string{strconv.Itoa(int(n.UserID))}, formatTimeDateID(n.CreatedAt.Unix(), n.ID)
+	})
+}
+
+// 取引の状態が変わった時に呼ぶ。actorID はその操作をした人。
+// 通知を作り、つながっているクライアントに今の状態を送る (stream.go)
+func emitTransactionEvent(event string, transactionEvidence TransactionEvidence, actorID int64) {
+	recipientID := transactionEvidence.SellerID
+	if actorID == transactionEvidence.SellerID {
+		recipientID = transactionEvidence.BuyerID
+	}
+	n := notification{
+		UserID:                recipientID,
+		Event:                 event,
+		ActorID:               actorID,
+		ItemID:                transactionEvidence.ItemID,
+		ItemName:              transactionEvidence.ItemName,
+		TransactionEvidenceID: transactionEvidence.ID,
+		CreatedAt:             time.Now().Truncate(time.Second),
+	}
+	unreadKey := counterKeyUnreadNotifications + strconv.Itoa(int(recipientID))
+	// 全て既読にするのと重ならないように、採番から未読数を増やすまでをそのユーザーの未読数のロックの中でする
+	counterServer.Transaction(unreadKey, func(tx KeyValueStoreConn) {
+		n.ID = int64(eventServer.IncrBy(notificationIDKey, 1))
+		notificationServer.Set(strconv.Itoa(int(n.ID)), n)
+		tx.IncrBy(unreadKey, 1)
+	})
+	publishTransactionStatus(event, transactionEvidence)
+}
+
+func unreadNotificationCount(userID int64) int {
+	count := 0
+	counterServer.Get(counterKeyUnreadNotifications+strconv.Itoa(int(userID)), &count)
+	return count
+}
+
+// これ以下の ID の通知は既読
+func notificationsReadUpTo(conn KeyValueStoreConn, userID int64) int64 {
+	readUpTo := 0
+	conn.Get(counterKeyNotificationsReadUpTo+strconv.Itoa(int(userID)), &readUpTo)
+	return int64(readUpTo)
+}
+
+func getNotifications(w http.ResponseWriter, r *http.Request) {
+	user, errCode, errMsg := getUser(r)
+	if errMsg != "" {
+		outputErrorMsg(w, errCode, errMsg)
+		return
+	}
+
+	query := r.URL.Query()
+	cursor, errMsg := parsePagingCursor(query, "notification_id")
+	if errMsg != "" {
+		outputErrorMsg(w, http.StatusBadRequest, errMsg)
+		return
+	}
+	keys, err := notificationServer.IQuery(indexNotificationsByUser, strconv.Itoa(int(user.ID)), cursor, NotificationsPerPage+1, true)
+	if err != nil {
+		log.Print(err)
+		outputErrorMsg(w, http.StatusInternalServerError, "kvs error")
+		return
+	}
+	mGot := notificationServer.MGet(keys)
+	readUpTo := notificationsReadUpTo(counterServer, user.ID)
+	res := resNotifications{Notifications: []resNotification{}, UnreadCount: unreadNotificationCount(user.ID)}
+	for _, key := range keys {
+		n := notification{}
+		if !mGot.Get(key, &n) {
+			continue
+		}
+		res.Notifications = append(res.Notifications, resNotification{
+			ID:                    n.ID,
+			Event:                 n.Event,
+			ActorID:               n.ActorID,
+			ItemID:                n.ItemID,
+			ItemName:              n.ItemName,
+			TransactionEvidenceID: n.TransactionEvidenceID,
+			Read:                  n.Read || n.ID <= readUpTo,
+			CreatedAt:             n.CreatedAt.Unix(),
+		})
+	}
+	if len(res.Notifications) > NotificationsPerPage {
+		res.HasNext = true
+		res.Notifications = res.Notifications[0:NotificationsPerPage]
+	}
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	json.NewEncoder(w).Encode(res)
+}
+
+func postReadNotifications(w http.ResponseWriter, r *http.Request) {
+	rrn := reqReadNotifications{}
+	err := json.NewDecoder(r.Body).Decode(&rrn)
+	if err != nil {
+		outputErrorMsg(w, http.StatusBadRequest, "json decode error")
+		return
+	}
+	if !csrfTokenOK(r, rrn.CSRFToken) {
+		outputErrorMsg(w, http.StatusUnprocessableEntity, "csrf token error")
+		return
+	}
+	user, errCode, errMsg := getUser(r)
+	if errMsg != "" {
+		outputErrorMsg(w, errCode, errMsg)
+		return
+	}
+
+	unread := markNotificationsRead(user.ID, rrn.NotificationID)
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	json.NewEncoder(w).Encode(resReadNotifications{UnreadCount: unread})
+}
+
+// notificationID の通知を既読にする。0 なら全て。残った未読数を返す
+func markNotificationsRead(userID int64, notificationID int64) (unread int) {
+	userIDStr := strconv.Itoa(int(userID))
+	readUpToKey := counterKeyNotificationsReadUpTo + userIDStr
+	unreadKey := counterKeyUnreadNotifications + userIDStr
+	counterServer.TransactionWithKeys([]string{readUpToKey, unreadKey}, func(tx KeyValueStoreConn) {
+		if notificationID <= 0 {
+			// 全て既読にする。このユーザーへの通知はロックの中で採番するので、今の最後の ID までで全部
+			latest := 0
+			eventServer.Get(notificationIDKey, &latest)
+			tx.Set(readUpToKey, latest)
+			tx.Set(unreadKey, 0)
+			return
+		}
+		tx.Get(unreadKey, &unread)
+		if notificationID <= notificationsReadUpTo(tx, userID) {
+			return
+		}
+		key := strconv.Itoa(int(notificationID))
+		notificationServer.Transaction(key, func(ntx KeyValueStoreConn) {
+			n := notification{}
+			if !ntx.Get(key, &n) || n.UserID != userID || n.Read {
+				return
+			}
+			n.Read = true
+			ntx.Set(key, n)
+			if unread > 0 {
+				unread = tx.IncrBy(unreadKey, -1)
+			}
+		})
+	})
+	return unread
+}
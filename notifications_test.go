@@ -0,0 +1,84 @@
+package main
+
+import (
+	"strconv"
+	"testing"
+)
+
+// publishTransactionStatus が引く商品と配送も空のストアにする
+func useTestNotificationServers(t *testing.T) (restore func()) {
+	savedEvent, savedCounter, savedNotification := eventServer, counterServer, notificationServer
+	savedItem, savedShipping := idToItemServer, transactionEvidenceToShippingsServer
+	eventServer = newTestSyncMapServerConn(t)
+	idToItemServer = newTestSyncMapServerConn(t)
+	transactionEvidenceToShippingsServer = newTestSyncMapServerConn(t)
+	counterServer = newTestSyncMapServerConn(t)
+	counterServer.server.NewValueFunction = func() interface{} { return new(int) }
+	notificationServer = newTestSyncMapServerConn(t)
+	notificationServer.server.NewValueFunction = func() interface{} { return &notification{} }
+	registerNotificationIndexes()
+	return func() {
+		eventServer, counterServer, notificationServer = savedEvent, savedCounter, savedNotification
+		idToItemServer, transactionEvidenceToShippingsServer = savedItem, savedShipping
+	}
+}
+
+// 全て既読は既読の位置を進めるだけで、その後の通知は未読として数える
+func TestMarkNotificationsRead(t *testing.T) {
+	defer useTestNotificationServers(t)()
+	const sellerID, buyerID = 1, 2
+	te := TransactionEvidence{ID: 10, SellerID: sellerID, BuyerID: buyerID, ItemID: 100}
+	for i := 0; i < 3; i++ {
+		emitTransactionEvent(TransactionEventBuy, te, buyerID)
+	}
+	emitTransactionEvent(TransactionEventShip, te, sellerID) // 購入者への通知 (ID 4)
+	if got := unreadNotificationCount(sellerID); got != 3 {
+		t.Fatalf("unread = %d, want 3", got)
+	}
+
+	// 1 件だけ。同じものや他人宛てのものは数えない
+	if got := markNotificationsRead(sellerID, 2); got != 2 {
+		t.Errorf("mark 2: unread = %d, want 2", got)
+	}
+	if got := markNotificationsRead(sellerID, 2); got != 2 {
+		t.Errorf("mark 2 again: unread = %d, want 2", got)
+	}
+	if got := markNotificationsRead(sellerID, 4); got != 2 {
+		t.Errorf("mark someone else's: unread = %d, want 2", got)
+	}
+
+	if got := markNotificationsRead(sellerID, 0); got != 0 {
+		t.Errorf("mark all: unread = %d, want 0", got)
+	}
+	if got := notificationsReadUpTo(counterServer, sellerID); got != 4 {
+		t.Errorf("read up to %d, want 4", got)
+	}
+	// 既読の位置より前のものを 1 件ずつ既読にしても未読数は減らない
+	if got := markNotificationsRead(sellerID, 3); got != 0 {
+		t.Errorf("mark 3 after all: unread = %d, want 0", got)
+	}
+	n := notification{}
+	notificationServer.Get("3", &n)
+	if n.Read {
+		t.Error("mark all rewrote a notification")
+	}
+
+	emitTransactionEvent(TransactionEventComplete, te, buyerID)
+	if got := unreadNotificationCount(sellerID); got != 1 {
+		t.Errorf("after a new notification: unread = %d, want 1", got)
+	}
+	if got := markNotificationsRead(sellerID, 5); got != 0 {
+		t.Errorf("mark 5: unread = %d, want 0", got)
+	}
+	// 購入者の分はそのまま
+	if got := unreadNotificationCount(buyerID); got != 1 {
+		t.Errorf("buyer unread = %d, want 1", got)
+	}
+	if got := notificationsReadUpTo(counterServer, buyerID); got != 0 {
+		t.Errorf("buyer read up to %d", got)
+	}
+	keys, _ := notificationServer.IQuery(indexNotificationsByUser, strconv.Itoa(sellerID), "", 0, true)
+	if len(keys) != 4 {
+		t.Errorf("seller has %d notifications, want 4", len(keys))
+	}
+}
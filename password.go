@@ -0,0 +1,105 @@
+package main
+
+// ログインのパスワードの確認
+//
+// 平文のパスワードは保存しない。bcrypt は遅いので、一度確かめたパスワードは
+// HMAC-SHA256(台毎の鍵, ユーザーID + hash + パスワード) を台毎に覚えておいて次からはそれと比べる
+// (hash が変わると一致しなくなるので、古いパスワードが通ることはない)。
+// 保存している hash の cost が BcryptCost と違えば、ログインに成功した時に作り直す。
+import (
+	"bytes"
+	"crypto/hmac"
+	crand "crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"log"
+	"os"
+	"strconv"
+	"sync"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+var passwordVerifierKey = func() []byte {
+	key := make([]byte, sha256.Size)
+	if _, err := crand.Read(key); err != nil {
+		panic(err)
+	}
+	return key
+}()
+
+var passwordVerifierCache = sync.Map{} // userID(int64) -> HMAC ([]byte)
+
+func passwordVerifier(user *User, password string) []byte {
+	mac := hmac.New(sha256.New, passwordVerifierKey)
+	var id [8]byte
+	binary.LittleEndian.PutUint64(id[:], uint64(user.ID))
+	mac.Write(id[:])
+	mac.Write([]byte(strconv.Itoa(len(user.HashedPassword))))
+	mac.Write(user.HashedPassword)
+	mac.Write([]byte(password))
+	return mac.Sum(nil)
+}
+
+// パスワードが合っていれば true
+func verifyPassword(user *User, password string) bool {
+	verifier := passwordVerifier(user, password)
+	if cached, ok := passwordVerifierCache.Load(user.ID); ok && hmac.Equal(cached.([]byte), verifier) {
+		return true
+	}
+	if bcrypt.CompareHashAndPassword(user.HashedPassword, []byte(password)) != nil {
+		return false
+	}
+	passwordVerifierCache.Store(user.ID, verifier)
+	return true
+}
+
+// 確かめた後に呼ぶ。cost が BcryptCost と違えば作り直して KV と MySQL に保存する
+func rehashPasswordIfNeeded(user *User, password string) {
+	cost, err := bcrypt.Cost(user.HashedPassword)
+	if err != nil || cost == BcryptCost {
+		return
+	}
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), BcryptCost)
+	if err != nil {
+		log.Print(err)
+		return
+	}
+	idStr := strconv.Itoa(int(user.ID))
+	updated := false
+	idToUserServer.Transaction(idStr, func(tx KeyValueStoreConn) {
+		current := User{}
+		// その間に別の台が作り直していたら何もしない
+		if !tx.Get(idStr, &current) || !bytes.Equal(current.HashedPassword, user.HashedPassword) {
+			return
+		}
+		current.HashedPassword = hashedPassword
+		tx.Set(idStr, current)
+		updated = true
+	})
+	if !updated {
+		return
+	}
+	user.HashedPassword = hashedPassword
+	passwordVerifierCache.Store(user.ID, passwordVerifier(user, password))
+	writeBehind("UPDATE `users` SET `hashed_password` = ? WHERE `id` = ?", hashedPassword, user.ID)
+}
+
+// 以前は平文のパスワードも KV に保存していたので、今の形式で書き直して消す。
+// バックアップや初期化データから読み込んだ値に残っているので、起動時と Initialize の後に呼ぶ。
+// 初期化データも書き直す(バックアップは次のバックアップで書き直される)
+func scrubStoredPlainPasswords() {
+	if !isMasterServerIP {
+		return
+	}
+	if _, err := idToUserServer.Reencode(""); err != nil {
+		log.Println("scrub plain passwords:", err)
+		return
+	}
+	initPath := InitMarkPath + strconv.Itoa(idToUserServer.server.masterPort) + ".sm"
+	if _, err := os.Stat(initPath); err == nil {
+		if err := idToUserServer.Save(initPath); err != nil {
+			log.Println("scrub plain passwords:", err)
+		}
+	}
+}
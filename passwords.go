@@ -1,4004 +0,0 @@
-package main
-
-var userIdToPlainPassword = map[int]string{
-	3907: "pwhBq/lbL8Gz2xU4hAHcT4MaaS3gygDxxBGhlt+pr74",
-	1374: "5+qHwh2zs79WbM5uDdckEmU4HEc2SRr/ctMLAdIIPs4",
-	627:  "PGGNHUv+/sZJjahIJpYXdp3DcgWudAjMVKvQirD56uc",
-	654:  "HK624wvQTAkx0SIifGQ6i6+Zl6wAR3fDYeSQrcrMcm4",
-	2295: "DTB8rAJP+6qdW2c6C8e4t+KCXf9qNmv93rtMi1IisgI",
-	2026: "XtEXSLbOJ7epE1xDfSsiCgJnHPOJqM2iMTi5jMEDdGU",
-	1641: "1+uzV3P9aa1xfSLdmAv1JuY01p3XwzfhYlHyuMTsaKA",
-	3589: "Hniqi9iuDIP9597snh5uLtlI3QiAKjgOz3PKFCG6pPE",
-	3254: "XOh/WBA/f9DylK7OHoUznXc3jVjWsFN67dyEuf5VFDA",
-	1142: "gxHoTY3Kk2WRIPQQmYqlw4SyBykmCB7AlkME8ME5pyE",
-	1112: "iYrNQd8tkBu8vF3r48BSs8SAABtdoXYfoEXmxqXVGQQ",
-	1842: "S//+SUk0vCCXi3vpjsDmuLDxNH5qzAM2B9gCAX2B9Rw",
-	3312: "TmW1/T+yMZ7JxdTqnUnS9gzlg32k4depV5eOvYAKHiU",
-	653:  "74UgxCLrWW4ezJiNFID98oJ1BDxgbUjPl9oJIwSOAzA",
-	1090: "OysaE9R0oXPVzjQ1BKm9eQN2s5tzYowxC9eEFIHUmOk",
-	1037: "jgp5Ojsc0UO5tGlfN7uuxVkP4sa04B6Ao4Xh6d0zKMc",
-	1772: "53kyHbmEEXHEFmX9J9UZnoBv/Zt9qSU7KyaFUc2KjjE",
-	2790: "l4B8Y4vQXWMUXJ3siANwb4xmqFse9erVhbjv4omneVY",
-	3931: "AvpGPyIYAQSOw3/gvnpmXnUap23FDSoUDsJ6msBXnRo",
-	3912: "STAmEnBSaQTJIX0NtPgQZ0pZg+fcvNA35JY84XmLPo8",
-	1728: "GJGPx+Ek5KNPNDg6dq1SVgQg+mIv+ni9D2cw9n7eD78",
-	868:  "t22C/njI5gv4uvODs3VOWpC+x6CqtyaHTxnmKqmhfrM",
-	2405: "9eus4EjY0sT3Lk4MFDcVbv5ojJnJ/SD400kBAgkJaBk",
-	1885: "WaKVVdP9WzmUxG6E7w3j3N2b+0GvdN+oA+gQNgbt+vs",
-	1654: "wJ1EyC9ppgRhaxUhtIGHySyNZxbha/ipvGB6mT4p3oY",
-	376:  "WY7ZEeww6WP21BLPZIGr/uplHmHzWmbGauN4ipbMQhs",
-	264:  "P8J5I6Mrw9xuRI7YIS2ZprjNei6GAqR/k8klN0vRYCU",
-	3969: "LvEEfzh/MdZbMUc05aTqKVJ+zKiYEjY8mdeSDfqyMgU",
-	594:  "I+8uy8L1dRnl+Pojajs6nSqt1RfvtsrDrQFYoHW33nA",
-	3504: "IQh8UA8kNliAH33pi4UGbJML5ikHjhDrcvvpc0hN9uY",
-	3599: "fmGz7/XjG0ITGQS5bri4iwjQr1wvBsU7W7PftbutXf4",
-	2727: "HJve15T8gulg+2Wo8FtdCxd4YkrWHRJEFMiL5m+u7k4",
-	2396: "ikxdFILaEe7dRHiISvZ/2xexc0cZlOf69Uo8Hx7jfBg",
-	561:  "jIAwCuLMdTS+49R+NGn5njc9ss/p0yvbQZTxFl3h/P8",
-	2648: "8fRZQ/RbvOzLmpu9SYT+JJWkpCM/tFY4PgFqOgwzyL8",
-	1784: "Q2UrIzdqq1/zc26olSEhzxP4iiyvr4kmm0pRHSl5Cb4",
-	2926: "C1bApbdKtNB7Duv4AbveGJsRU/mkTf73by+ofFRYgN0",
-	381:  "7EoB/6l85ZvUPF/+MBDDUOc6JnNh/z8YzO6N2m77XG4",
-	1111: "4NPCJvnGqI/49il4l8yf+KybWoZo5jzMx3310iFc4KY",
-	3018: "7tlPIE+tV2+UQNup2lsaDM8mCIWs+qxz8+c4XkzBfh4",
-	2106: "66QnFmMOxR3bdEzhuLnL8lPAgsHMn6RnBNfvTJdzBko",
-	3934: "sUowmyS5gI4G+k81T66Vtx09JUlBCSajxxaM9qvGyFw",
-	1004: "+/lNuxStkTfVq5gSETJ4CyFi8yBAJtWdS/BRzE8OurQ",
-	119:  "6UaZEaf/d49L0b3+SZdKldvG1lu618FW1WXLqDh+zdI",
-	3284: "2yFI6A6a6Z28Fw/vaUbaixHzDB9O70ciyTrPwQhbYEE",
-	2807: "JzsTx+kktSjhtxJiAEknmN2dT0dlyqdd/0bFtncZNhE",
-	784:  "G8con0XA/nvViqsxoDxJTKR6x/FDAwApghZwS4p45bE",
-	2320: "1sihPazzKwJFq23DloMaLJQECXlKdI+Mz0dQ3VOwKu4",
-	2714: "lBQZpdPdW+gH8Vr1pVK97JBJ7gA+oeMHlYSjejTaYd4",
-	2315: "V9Egup6BPc/eSKBOWcLAqpLrSnTtTlsWZwqre3Ryp4Q",
-	210:  "jCYc/dfaUxIFdIKGp1kp5kIBC535Fu3fYTcGV6rq17g",
-	2906: "YrnxEe5XLTTb1E64C4E5FmW4iVN8xbr4ZYetnMC7x6M",
-	1191: "UmNvZJhI3f5eO3iwLFEr6ONjYQZFxDBWdfRlpeiSlVo",
-	3383: "s7eHkAT07zaWbW8GVUpb7zpjS76X4vJkowW+OU5MvY4",
-	515:  "iimrPdy+EmAsCrURjVP8WJgp08oqFvjQKMaByX9KubY",
-	723:  "oHJK9vQ9thDU/6klM1Qvk5KLXIUOYBb5HVkVQZ3Kbh4",
-	3990: "crClxJQDzzlFxY/RAIP7Mmdd4C7ATUKamgrm0IVYnm4",
-	2132: "VF2L3lflgsP+8C0srIvi7HrIuhzlTvzoQN6ntaIhrgE",
-	3671: "8APh+jZxNQ+VEXnDVccq5WjsjKnyLRISGvMOpYeZZ3Q",
-	866:  "42yWyV/kvHBTS73d0IetT0T+0PIzQE1YmIpWX9GF6aE",
-	1314: "bB4NIqi/iEsiFvQnqcNjaIQPlVGyoIb+WcctdFW8VtI",
-	1724: "G3cdpPAsV2b/4ZyTu1cNWlv+jmzASy5VztuPLno57gc",
-	1643: "uhPsWQ1U+FDnbTUy5aKXV1wqDrdmHRiT1AoYwnJmSAE",
-	2711: "188Jfzz0xWfoWTsGVsbYooOUkBO+fzNUXTNQF+pjZrQ",
-	2868: "EW3DDy/sPDsFbqZJnQZTS0ytZxJ3bxavsfols5KZDuA",
-	329:  "130kTpfF5lqd3Ap1qHmqsDVubxnRNiHxVJnPIpClazk",
-	1248: "07pYoIHANLMeDzRQyebiiA5UYCfao46AkKMDNmgYe8c",
-	1249: "lxqYt8+c9PxcZiKPI4ixN4CbTPcPdI4OhfQUutAd68Q",
-	771:  "zNx9EKXNgRMf/pOK41ESL5Cn7Lw3iKS2CtM/GLBkty0",
-	3751: "tMCnEK2rcgqwqg1weyQI96LMzkzhzxCJqJpfAf4mLxQ",
-	557:  "UY9a/NmtvXldVrzI+5VDUmX+Sr5KlTLjqW7rf7cfkO0",
-	3955: "kzbGaakHbisHWzNYXQ7jzT8Q+Vb00ZxULB5d/s5PGfQ",
-	735:  "HTKg5wpjDc0gwI0pbx+0deWotDf01id/D9OzJOsXa9Q",
-	1604: "3Bvzak5tde+66TvTkcJP5l+XIKvx2KdV9QcMvReBxS0",
-	3039: "tyrnkDm3SWHdp7K1noWbbYMJ1hAJHBIqDKE15BsFSWM",
-	762:  "fC733hA7CXaQLDxyimeWQOsrZ8BzFEud8sMgggF8rAY",
-	3764: "d1jXKCckVfypXWG/bPXY/cnc8j4kc3JfWCsHiG1U07E",
-	820:  "gySVZAKqNg6WUF8cdz8TYYFzFaNOJgoClMmSRruqm8E",
-	150:  "/esxAnJu2uMoS+2H3WtD+j+CgOuo/wd7ER0fC+OKBfw",
-	2858: "Q3SsLxqoIEl/KVJLCzoGLqkipxvYyshczHqWyLUcZVQ",
-	872:  "0JOTQcWdpzqf++vddm351fQPADKLMB7l19vOujVEbcY",
-	1788: "d+wZhpomEuA2RrEltVF8YfEBNa4jBSCw48eyeqhMNvw",
-	3318: "J+lmQ+DPeyy1SLmMmiQ7rq43LXzFSWHICR42IGLkp0c",
-	2577: "w6IYSYjEyXoOlyG1ULzP2uD7hcs6IPxeinj4nSY3gC4",
-	3913: "mZWFtTnVDlJ7egV/4enTj666EECKeK8a77uWl+lhRNk",
-	1113: "z9smW9QtiMywurU9Yima+/xqkD0+urJcppMemGIXuf8",
-	753:  "p1TkZDDrFzLnFuGEoAOiXaFUt+1UBPFcPw2YVPavcKk",
-	948:  "zhCQB5Wr1kF3ZpkrlbbIE/6I9ZcVtS2enaGPPaBuOQs",
-	1162: "02eT8/Fye2IcTsh4IBkOoUfidjU8jpeqv+VzXqP3WoA",
-	2557: "T3c+XLdYqLpYOomfCdlkFBX6aaNl33MHYwc2lH+j5Ak",
-	2113: "R5GDaocD00knkDMhZQGVlu0UiSZcmFPdaT/o2t9hcFc",
-	277:  "dVzFm4TLwyZt1x8y6n2gyPHIkd424nvruerFjMW7Rcg",
-	2241: "14PO+6H49a8zPSqjAc4RLkqp57gFbIFyu6hAsPcbs0g",
-	2095: "x+9YTr3IaI4gIF/YWYNLgBsRFnlwOb9oCbQoAW/t/ow",
-	2719: "l2/PkCh1m/vQ7u5o2vpXTCs/3Ghxh0KMt928fLAT6Bk",
-	3193: "oguQ2Xo2NakOaxUhNc3dVtr32ubwkXshRYSQZ8zn+PQ",
-	2819: "kK6HxYYgatxvW1LeGnH0RaG/0LS5z9a2bXCKqQajf6c",
-	682:  "qldB/oLie6s7Fcm4lOwrz0ymYcTmeAi5g4kqZsuByGU",
-	144:  "pCKuK/1bOrWbPAjIg1mNI/OebvLLA2g+Q1S7cOabJRk",
-	2458: "Tciu76PYD2fzFhhfMV2kxexM3bHdEa6hayy9CXWXzz0",
-	690:  "8LzG3CzspJ7qO5zfr4N2r8bw3iTeoUvR0tj4OdoUThQ",
-	3691: "+ojVPkmzA0+nk8ys4TJH8+KRddD8k0rkW7ytBRUnEvk",
-	1891: "M4w19GHNgaN0wsWYQqD7Hb3AalJY/OjF2idjRxPOBUs",
-	2265: "pLuD/3N8gGFqYzvxwt960Q8GrOaXJIGSAHyfHM5sz7A",
-	1950: "Q0eO5tkbFkECGR02Lvk45ToMtRT3u6UilTh0P/PwgLw",
-	2505: "stNfudDmH9EQ3yWW378nGLUTf6QgtKFEOkByuJQGkUQ",
-	240:  "toObFcomk0niK8rDqAv2A3VtP9RPhxfOyjo9jkuyf+k",
-	1482: "2zAsU8r/6dXPB/SiJ/AiCxBWSwaDtd3POCK7PQOXpbQ",
-	1213: "9jVuEHl7VitSoaTTE/CAYvHkQ/iBNgZjoTBghp08hdY",
-	3205: "RZRBsIEvAnCg87h9AksGvZbyCefWS97TLVVsRydsQTQ",
-	3089: "LxfpGQcIaFUtwQmycAgmgCiEqN+sMPO4Z0Ma3wSJwws",
-	878:  "VmYSEWtaW1C4/n2g3rgFl1v/FDdSfjMfNJ+XPSyHQ1w",
-	1688: "OhZVi3r2I9pAxogpIER8IpvX3lXnPurrjkNCw9Zwn+s",
-	3159: "ZsYbyAtrdUi7CwfosZlJyEb3M3uBE9a9Ab/732ifDNw",
-	3410: "JTR52WyLrUMpoka91VGK5Rf3fEr9gHrO65YCmNDZfsc",
-	207:  "kP+K6AJ98tgP93UKNg8BzJHznvGW7ZdYZ3lvdsanuJo",
-	3875: "JJzHwrCxZu+SHHC/dKfAIbsQtXlvtXcP83o6VMR1xyQ",
-	3145: "jsUXaRlg0wSrTV9+v+qt1Q8pv6sX4NxyeiFwZ1xFJs8",
-	579:  "OHGD1/zdFCTw9iuKGQoEoKyLIt7y+CRTsSp+ri7AM0I",
-	2632: "7kyMGyM+YT+PEKKKZzRNxov2GKR2ndnPcPRXCQuI47o",
-	552:  "4I3Nxiint9ipHoCPZquHbr8QtbllocKA2DKQLaHNumY",
-	2453: "GDK9241IZ3ijA88SIgECNkMDEWDjOU4SheV9D5w+K90",
-	883:  "vB73cJ5t4516UEElWCQY7uK+v4NVpBdhsI1rBqh8Oxc",
-	1812: "Yr6Tnj2zLyYWUjr+fC1W/1IYAPHfIQ48fvcr0ZRiG1o",
-	1898: "g3cmbMpZqH2kOYZ53I2VN+AK8iERiQf4Ma7AjGYjeP0",
-	501:  "EFDe/tjc7u5PM5qlmZC470+upUJjSd7esyDS17XQv0U",
-	567:  "wcmVecPs9zJ0D5YBwmem6fYfNHDywx6lf7qMozntHWM",
-	2402: "FnMfN0ETkJfRP5JVjV+c+uQPjCZL1j6434j1gyMKKHc",
-	2891: "T8YBEHqB/D3S+byYJArWetjJI+ISaR6q9KgDiA8vI6c",
-	1335: "0T+FFtjsCqvgTOxN1sf6+CFT8U3hH2CDZzpynfF9yg0",
-	2750: "ibp4DkY7iczTGzd7PhyWMrk3CK2hq9/OD82LVNVufjo",
-	378:  "0hUrrI+51qRBUgMB0elPzfGlI3xGxGQ/iYRDGHnMWrs",
-	1480: "/qRcQLRSptUE83Q8pd3ypPcPMxwdOCQIfK/HQ0wehto",
-	3905: "ioD2LMgL/K5/OY+ilF3mq943B+D/HhplD4o2Pe7nKwY",
-	1668: "iggQw/vnG6175AQJdkEafVFcrpywvtBBFZfya7labCE",
-	3099: "R//ADMouJ84Ec4f0PiadfHAsVIjFd46bTEQo7ceWMKc",
-	1809: "hS7aSpX3iBRrqme0u5Vm4vv7oNZsn+BErXQYPDBT4TQ",
-	1771: "LbsOEc8tpJgs3X4Vdx7IsypiKS3p78eDjad0zdnHjNA",
-	1242: "TjFJyDQ5UlnaR7IX1Zph4LE4VPFnAmEnPtDpAYV9LQw",
-	3071: "rUGtIS/OrH+HAnHc8l4s19lwCBadRHhjEhdepicMM5U",
-	3840: "BbWDCH8n1g3sgYO9Ha+R24qcuBiX782vOaPXzujtKt4",
-	3533: "tycZGL+ZoAD0vcnZlsgpoTbgVrmirWvDo0+1tIc7NqE",
-	3825: "PQMfXLEIphZpeyUTzy6gW6ZW3fvw4aJgxUiEP6wDw48",
-	2568: "jEf5qu7C4QaBnpZGX1m3Bheb60BP0itu/ELK14RmbvE",
-	3393: "/5okx5L0/bPiN1H+/I58NPJiPU0BZCuuVCtQjU2MdUQ",
-	333:  "Yd5GL3kxHNJ/md2Q13ivW94KeMwwU7c4szAa4LRaR+Y",
-	2260: "V7Tn/d34/NlG9J/z+MWiLYrMyZBlNkMottcAv1eDoD4",
-	1622: "GhI/LFIw3uczTQbrV33CXW/zsU7cn244no8ckjgqPgE",
-	1563: "JCJ6zVTuvp+9Ppuz7Yd4p8H0oXK6CZvOBLCa2RfT4jI",
-	998:  "R2UpNbTyGl8U84kcb56qoxAerwkuPtMCi0Cb33YmJvA",
-	3957: "FWiA25RgRMEknxp7oNU60hsOj1re/q++DrS9wQbRzqM",
-	3933: "C3LRqrqlLj+r3fskKn1rZYVl4XCjoCQfXZmv2y6kpp4",
-	3556: "G5uQnpZs3Zd4aUzsSPRD2oKxcR1hswGlcJ4kSTw7dBw",
-	1328: "8KF4NbV3FW91v6ZoLYih+z/qmYvjJ2Fhm8ycH7K0guY",
-	3730: "8OGBZv4ufpJmWUI3ELQ5888FN4o4CGLNOUhy52a5MX4",
-	566:  "UcfddWBEGMav7MvWv97QaJIHTCpvZXqHM1e3qpMdwbw",
-	1039: "Ax2L3L644rEfWepCi6VKbtQ8rHypYDkPO55v5QVAaV0",
-	781:  "oExsD7wGbPLPi1JCImSnNXCw1u/pajL70CEQEwAsxyQ",
-	2483: "NZyGbt7PdFY2YX7tzYToaBA2W3Fy3zhgt6NB86hREWI",
-	1691: "vyMlN/WVmRJTzHgQkmizk+9ITe962dOnDrNMoDTlPig",
-	2976: "rZQHzzEJ1SkbxwANNADvs54HQRBtd5/b75FNPboaWLk",
-	243:  "R67iNE9QPIZJGdnq9H7qkYT+Xb6rfo+Lp2h8YvWSEWs",
-	3915: "PezR3YUG+AzKrPKAbJIZ7oqL5IVrJwS/SkvX4WqkQS8",
-	2628: "tRHXRxQBT3ZJgy+q+tNej8lu1yTst3a4Ugbhaub9SBo",
-	3015: "bwNz3JtX0wPb2ghvLwps6Hr/eZq8nnsf6CjqYBDewuc",
-	1239: "ECmHW0MBeaLrwuOaZs+5Gd/zyHxsxET8Lqg9ysZfhjk",
-	741:  "v/0JtHUdfR4uJVR2wHTzSLU3y8gdZNWUds64VswZClA",
-	543:  "3IXn/Cf5B/AH0zJfi/Uxgjxm3cgsTLyzOcqjDLNmG9I",
-	1601: "ccQZ7kHjtpNSYtbCEg1DIHajFMfQz3ztLyUi5LvIzr0",
-	1597: "16pJ0NsHF7XJSF/zTOBypKLDro9jtpktkOvFzpiaqA4",
-	1021: "funJxh8uJGJoq7xOs7C2NqIOYjfF0qO91oB7XJLxF3w",
-	3062: "A3RHhrPFVGzCgLwr5NQ+uv7nswVIaJvgKD0f/n4wP1I",
-	2491: "mGKJcMBFLew3culGdHP9YTup2hvQX8hiWKV7QmGgGQ0",
-	1860: "EeSLQuVcj0xoAiwa5+xMEfbiUQdtgNn3YG0fq7Yx7ps",
-	455:  "a1D9fYNq54ywM8GougaPzSE5K5hu1QOO+DGU1djMA8M",
-	302:  "b2VC1iUP8NPyu0Q94ZoookoxQjzw6DB3tlHHhFFRN/s",
-	3577: "6iqYaYi8LkSqZ6+EMYlHIow0lm8ANfMmSumTRAnueM4",
-	3123: "E7KliUDTCYFh1Jut84Uo50rSsCoKM7bUbw1/t8YQ3Vg",
-	86:   "AgjiyoHWt8rdF2PR27+oUyg7qJZyTJJ5nWhSumwKJqs",
-	2887: "OiBmH2Z8DrYrWul4NDV3BFQu6jBj/wuhQ5JswgwnGHQ",
-	1602: "5NX5dyBsxGBhElqJ41ltKlBQ7BYQyeoMDHKb+rkYFM8",
-	529:  "5lQkrqtDtpByIFLYZi90l94gFIUuHCO88MQSO20FtrE",
-	349:  "HrnF/q9wNn9lpv5IsY+IUYVLaF+wT0n2W1N8pAH/nf8",
-	1638: "ctAmHs9TN17cyF47GvSTeWXS6tBU5lpURROUBzGJZl4",
-	674:  "M6k8I0ntBKLp69dtB+/B9pXEig+BHWp7/Ami8lqdeoo",
-	1648: "CittN8W0FDDs8iaJSaiZSXHEqDeCU796MFbA6bKUKcQ",
-	816:  "6qs8Yhbg1F24RfylXh/36VDLBhTBi7mtcbnV2AJAfT0",
-	2084: "F+dB1wPyPg3ty6GGHweRco+16Guu6TBgxwNiCftZ7z0",
-	2420: "+418qF4alXILt/5/6iwiA6NYUEGuaen4yvwfjJefA0k",
-	1873: "6hO0U05WsSJ6Hj8DaOTY1Ml7wtNouRueuLqU9k7tuEU",
-	2165: "Y5h/6nA629VFMCJ0eP8wtEuzeMIIyaf1CZreoB8Q3b4",
-	239:  "IbVOAPF9rXjpn8bV7eJwgstc3vsW0jhwdSFlKjlMRm8",
-	1061: "d5K9zJHeGoPCF+1q1tZycNKNc10PFD0eZgDaH4XyHZ0",
-	2475: "/HJeRz5p3TKV03cbuwhnlsCbE4J/tspb0aIHyFx0FMg",
-	2836: "Vh4IhtVxvEVDETrwFmCru8iau94wGtIEKt4nksbsapA",
-	2247: "FpNUvcmz3kkPGG+QnjHxY1pN6MxRdvfb6fzUOsmzxUI",
-	2803: "ZbjEeG7Iwh5hlzQq5YJNK5luKoEC7ExS52IuRVMXW5k",
-	1584: "JYD8HyBdB2mUDAU5Y5OIwUU8gZLu3MLK0o8jFoKd+II",
-	2496: "rF1JGgdINV5aJhIAZocI41KmLB77KPbWcPqg/4FwhNU",
-	3252: "aAmnPc8/lcWv+GCgoaTJ55nt6ncCT5JfnWP2xkmKDZY",
-	1835: "qssMhbpFXNWAMAGyY5NaucftBXyji0cWB1fT0uFvGA8",
-	2840: "gWEUnfczkHIxOazo7+TI9v+UwU2mewwMtvzDvjqRd8E",
-	3351: "svysp4Bd0MAKCJFO+GMQbhdQHD6sy4CFADGscnI8wfE",
-	1441: "UzfNIPpPyTqJn9MTAicTvLi7GiobtRHpv1xnIHDfQi8",
-	1988: "8bxoKF+FLTI0zWYssjFn6RcTiI1xnjjP+gs/NjvWMkM",
-	538:  "RDOZLgsJFzbutJEhfY+h/9yvcOwXddOok1Lu4saLF/U",
-	2248: "Oj7fs/8FUrc3/0wMv7Fpgj5RrJqkAnAwu9q4yXaFIFk",
-	1967: "h9o0/eIPuVfdm/+Yz2vlhjQmVADaRLn+mI7ntm5MwcQ",
-	3698: "mff0hjogkoXjObtQ5sXPyhx3koZaP8+3Rqxb9FwUNsk",
-	2312: "HisHuxlUuQEq1R7AdDKtO+E4zyPwvdOuRl/YOY5tdaQ",
-	274:  "lPH0QqOde0CG+9NssV/TDIoPtMNC3gS5H9q7LdAeIx0",
-	3308: "CcVWI6JlxGT0uESVl2Vuwx/P3Z71ilQyvWNR1K7yyqc",
-	3243: "aMYxeZKdf3/KCoEGGSyreAsmjgYm7RpZ1FN3q3GPASs",
-	2583: "OjCNMPg7MtDKZ+1N3gUwMA2CuMdJDaEEyh0T8FXnyKs",
-	1412: "euf+EElH/3cvLxWBNcS8wkkBzzhob4pIQoGGbD+AdC0",
-	887:  "bYHdI0LDc56Bn+Sqg0Y5iJgI5AwvwCuR7bl7FaLsIuI",
-	696:  "HF2VlujrpCZbtzWUj+MzT8KlaePhi/AvCnncB8N9f+A",
-	3463: "GXnpTKQ6Pc1ugfVplrLeWtWhfvOjr302JXV/BI3abiM",
-	776:  "khitX/7h8NZ7C94nv2VR6ZKQcaGc1S+K0y1bfiC63Ww",
-	3770: "Q/6FTe3tyMhWiRVh4GFONugjx7kLiObbF0pWP0GUARk",
-	782:  "PI0+x8qMiVjOnjGaFPtjtoVbpgLobRA3PTODS9mFWiI",
-	3179: "TQdTae3YuPCKcKAHgcipW+l7NaB/7za6GVi/tYZ59Fk",
-	2656: "QO+PZvkq+GasY4O1P8e+8M+OorQuztK28ouPYiizsoo",
-	2929: "Vvetdiqze6XSyOGIIb4qaY50kdkADhyLgOBRwC9Rf2g",
-	2202: "/OIOT9U74cSAm+AtpdgQntKQmhJlAKY1e2jFLiLwZG0",
-	173:  "k+Ij6EvHT/NKiKvW42OdzHy6DQH5ltrTrby6BRtPUCE",
-	1928: "OsejFhH7bcmHawJ8nhTUAA+Wrw1voAteA6OIUa9/ep0",
-	1393: "1hNgU8rf6fXOiwlE1r60suALo9ralBzwPhvkrd08gk0",
-	1180: "WmCGWLd4eddbyI3yQgDmHR0SgRTCAGJiUvunht+/EL0",
-	3320: "HfLlTY9c5S2eTy9TCX9F1IxQY1I8SpYngwy6uHHKWVc",
-	3848: "rYZgnX4CECthB4jcydjjhzNqRGMh+Tt5KUBP9/bSlbo",
-	1605: "ANUX5jBoMhzlUqTG4asIGNkiaNNasf06Tffx/tIWwHs",
-	2470: "oDZb4yhHtAXCSvcxy7KlNElyIU5fbeavL8/TJeqBpDc",
-	481:  "m9Y4T1aVc0207JLQf9nWZpz2Eo3QAMeFSF3BNvWW2YQ",
-	1103: "5BaCI2aM+eIbRFLTc/9u8GAbAgjPE25zOLkmBmkTvf0",
-	532:  "cANqwCwAecKVvdCAhkJHG7OMCyUd0ugMzykItsGsuss",
-	1657: "FvnRvsxQI6Ap1PsRpuXlrdKebuzPSOjPOzPe7wnQI5s",
-	3107: "9pkgH7Rso6mp2sQPQdIfHdXZFX4EaJuvnFUCh5kFGMs",
-	3017: "N7boPyhOv6lJq72J9LfYUZrePB8BBgMWdoTMGebtkcQ",
-	3186: "iM3klHjH19q/uk0QZ/OXOsBQ5ztDlngTfNSaB8k5BQs",
-	2199: "76s2Cy4YCERlchjIZ21kaVHOYYXlj0DL0tXbQYn6vU8",
-	2389: "1dW9G0M/cG/ycItH9FHpdye/lO7X6cMaLDdCMAfNZJE",
-	3212: "JjdD1I81SF3AkQPGohWAKUUeYWxqSZGO9v0a7sxPmi0",
-	2961: "/5rWL2+N2Q3Pyv5wf4FqYeJ/yOT96BvAmYsntG4t0dM",
-	890:  "0cOzoazoSU8JjlPh1Hbt0GckJuPSuH4xtMuNCwbngOs",
-	786:  "qvXB8DHh5OD62HE7V9K8TL7+asutNfRfHgUrSBuIDkY",
-	3296: "KJOMQs4iwLQ6jg5d/WvS1UWaRALxYxAMsINqkOShN64",
-	298:  "f7gzqNiPz3rhgHQL08bhY0nGHBGejLwA9Sl6+7FnR3M",
-	1889: "jvjewXZpSChgD0225l0e3tS1OiY6HhWo5KtUz6FxzN0",
-	1008: "EW+G4Y34ao8g+7A1KSv4L1ZkcajCMU5/evU6g1pJnCA",
-	3259: "G6S3N5dK5pv/OGHfQcz4tFonmo+hE+DkvyQUqxrl68I",
-	208:  "xgQkO6uwH2DpV2+va0rQFjWQfvuzcUhhD5CfaEP6yy8",
-	3621: "He+734Y2n72hzF88JjampX5DPNZ67tLJm3w+Uug4uuQ",
-	2964: "Ge5cfst2/YxpNLN9pA5ZKMIOEhrDuRxh0IFC8K9VUt0",
-	1149: "0SALJNNm7Xji2kkeJayT8r3SlfcfRUAOqXH4kGTquxQ",
-	1022: "M8bpIskk1GN3+uoMffcQfQDKn75TWwtbzwhi5X5b5TQ",
-	991:  "NBF5EzVEtev7evhzdB47+A68HQhuT5RCJZvGbRGixHw",
-	1917: "EC1WwvYkLqfqE0g8HCg4d1+kb9vJ+3Y2PfFZo6NmkjU",
-	428:  "bwxpmr91nuvg1cNKicX/RNMGjg1hddtLv+OTbZscYtA",
-	1151: "42zzi9lTV6SJ9a3S7airuS5ELRZiKjvQsb3i27GADQ4",
-	750:  "KcNHDiJ1GobWZ1Sl1qa0qfrxx/k8YM1pcW6dKOrd9OA",
-	127:  "D2eouX1A7kuwlGWxzs049/ASzXWXY9iDR3j41YOyD10",
-	2801: "rvknV9P7GEmO1H96rO/Ng2aasvComGtlR2bwfORzJHs",
-	3923: "wcHcmjzdjFw0+Q3eb/PGvE2VLGKM0w3gWA/+eFnhvMs",
-	1145: "81n3nWblaE1b9gyoi3ygc29t/HsfvCoWnFH4JNXBYoI",
-	2979: "piMP7A2kM2719zneWBr+evz7JQFc/pSXHQj2a4qyUqI",
-	3417: "5S5l/fETO/Pk0hUYvXEAfe8KSh2WBD+nlKqxfydVeWs",
-	2087: "vdLorB5c8yy3p/YW4CSmGLhVFRy5s066oEnLCf8TRrM",
-	500:  "TT6UI7bAoY+dlSkvQkB+WNcBmWZlgZ92R4idxyalF6g",
-	1952: "938yNYlCfYS0pex9GMo0IdVjDIUtoTq60nyz4NdIupk",
-	3644: "xGO4jDw7fzbudjRypXvXZ6HhNU1ZyAUI2pFjUTCSw1w",
-	3804: "kR6ulAWNQl2DqRevwq4PE7rzqy5azojDsIEQK9FyHuc",
-	2382: "gzgmnhYJnvy4z9MP1lKHCV7JSkpDzHpkVihKZPRe8rA",
-	3297: "kO8tbq/5qS8ScVQIw41stN1/rEJBxlLHjn98utDX/ZI",
-	405:  "MiVmxrCQvK1iE1dE+cyywL8zhREBUvTzp39qQZNkQsw",
-	1259: "LM5pICNPC4CpL8TtnOU5s+GzuCwinC4fGg6ifkvkKfI",
-	677:  "DswzSINz+4JjOLMk2AUWALf3jD64/btm0He64sAfxWc",
-	69:   "Xl2gDkluS+035IhRykyJ4ag6SyMZPb20+uJgzIpTZRs",
-	3977: "jztDAYm8gdgkIZYkPCq63vTL/HtM/d4q+PsqRnjZSfQ",
-	3603: "doRAL/ISj2xlkyge4wtQpEr7Y65DpMIutWlFCD7bEmU",
-	2804: "5h/7jHMguTgIx+91MZnXTLn9R5R3+LzJ4YxQ7ETerrY",
-	480:  "YJUtu7Ai+MLc+JmqtL+UULpyso7GR4jLVUC9zncTlW8",
-	314:  "TXNTsIEehc3QanQllp5SGGNJ3+Zo4Jv13kLHP5d+g8s",
-	1813: "JAh5Rx9XI56egEDINggjrve5QaxqxROflB3etvZ9Xvg",
-	257:  "mL3m7Dji616Pe0Y5ir6oOP7bs/kgqiTlvrNriEVHVDk",
-	3816: "vaR+DuZKWEyJD5RTBNwuPerE/m1Ret04NhKti8Kf8MI",
-	2896: "tgyEPTvNN7Q+YncVTU8wdiCSrhwHhO02Ea06w04Bc6s",
-	369:  "in8cG14oSVqTuedDsgkAsUVb+ZNWI2r0ycGq66kDyTA",
-	2902: "4hNUPD3hGu9mWvx8WcB62S5FCc4/wxgrPm3SmnUN248",
-	1195: "NV7tIp3o9l4VkhDNnLqBcUOO6abN3sGGNm7uDaTPA1c",
-	3813: "4sHzoF3+SQTRp12PaWPMk6X1M2ndtEPWm1dAORNNx20",
-	1896: "NbMNZqGOG+MQWW1dO7hYCkQR2Cgg7Vv/HimOlGkUge4",
-	238:  "5mlVHfmKtV2sydiW5GQtNE5YDbu9FwU83TKdDCneaKQ",
-	3347: "doLRqsLOe9HJKYsLegCR0PPXmOAmLIAI2vJ6tcX5xAI",
-	2645: "yVxg3T7Qto1t0xyzNjNgGmclymL/p4ZrP1IprtAa6lQ",
-	1152: "Mp4ZGeUl7FMcKVBD2M7XgEx+N1FuzcfrxIVos5rvAfg",
-	613:  "mW6xYoIuGgO7SCPdVseva0Ce+hN6Dw7e6P8veQN5muA",
-	3471: "Gk3W6mvmwX+OwT28/85CdxSGHRQ2+Oi8RCP7F3ydeD0",
-	364:  "yip3WjhBBxIxS887WkwOPvzXUPEczK+yvW9kKojaOXA",
-	3458: "EIHyMP6401f8eXwi+F3KNXd/Zq2uj4QuTWDMlnd6CRU",
-	2143: "xmjva83Y7ALYsZRXUqwNbGQPn1uk5dVoYx6qmce5tbo",
-	1890: "eXvsyHlEicwt1N56FurMXFKPYV29tMsivi8fO3ZWvF8",
-	374:  "8Ov267Aco9e5abaxT+VM+blBYxYXzBLEekX0oBRpRgI",
-	368:  "hv0tJ16SiG+ZpGF2yIyhem0w6mWwY0DG7zVRsFwnYOU",
-	3812: "y52HDAceUv+tDx9/j86LrgAWUS2lD3W6cSetmbK6OFs",
-	156:  "g8Pu8VYY9dCXvvw9ZblEUjzqMXRVTLkiAydsXrgxhzY",
-	3882: "ZZhcRk0bYvImfgTWdXq+2yKPeqAys8oZSEYzpU/ciSM",
-	984:  "SxODWxG9sUWdF2i/nOCz7Gy4C7xk6mkGigQOtIXQfQA",
-	564:  "wEA3cwPDWVsZnpUkCP1R7aotKK4rxgeb1JTVQ0ZpetE",
-	2737: "KasRDcubb6FhiUVafz5IB8TPIV5ow1/sJuacsP6l5hU",
-	734:  "HIgogEepSRL0yMGiepvdbHeVWhapO/2WyOmog0+8b4s",
-	2507: "jmrGMrLRKfwYjWuduMX1EU81u2Kgg8eONDBNMmvR9sg",
-	145:  "lZaAFj4NwGz/C1sH9XBdDiH8C4x2wTpm88ut79gw9fc",
-	1780: "egPJz/QII38ysmu+HFQdBKGeKmRUKJF40qkRMIyyG4o",
-	3721: "dbj3GrU0iuvuvBkHaK8jdme6byGDBPZd7s1PiVMfwZA",
-	2812: "RaELboLUSRetftKl/UkKJ6+xAsl8ercsf7uzlIo+2Sg",
-	1088: "E8QecPuqM0aTvBZktnHMX8q/CCiHSelhegwXkW4az/E",
-	490:  "Ft8A6pSqPEd3Os6jck6b2WlXOLN3TFxGZRKD+hHqPp0",
-	3248: "0d5SI+zSEkAvNxT8ViDQg29tV5S4XAMZQahueolKOe8",
-	3531: "WXjnKtuZSvXb2uxOA8DWSOY0XsrlF6ysKGKhogn8sxo",
-	1940: "Vt+cjZ/qH60nuNCkBLZ/d/7nKx8qP9fXjyT7axRA56g",
-	3518: "aSfBH3jqiriA+D41LQl0SvpNsuvdOOrkVoN/uNx9Uxc",
-	2939: "nyhoZSDrQ09giPa9XS5IDkwzWl4M0cuf4s0d8tcQI+Y",
-	763:  "+Xx7TH6e3WMbFxITG0RTDbgL7DFQBEfEq+yh+/Kq0kk",
-	2343: "WCEy2K3jejeWa6x/lBxr/SK/ttP7XUC9YHiMq6HpHPU",
-	279:  "86syk+SeOYdPXkmZufmHmGOoGXZCYBdSo5mHiVIJOYo",
-	2923: "bDdYdXL798vaFJ8vS/N/IMePlixC9wlTndznan9zcUM",
-	3394: "aIg3Cq+bqZJ/W9c6grbgUCV8z4jLeoUBC7VHvJh97Us",
-	2948: "ME7wyUK4gdMvcYj12Yl7fnqTrhC8SuMOo2XQR7rK9bc",
-	2552: "eTQIZv2bcajE4k2yuOG6yQdMo30F0UU86NhB4t2DwIU",
-	2164: "susQLWM9S27+lEsDZif1IcbhZhc+VnRI32Hn0mjT5eg",
-	3834: "YDP0JaxeE5uEc1EpVa2ds1lXhbg92eWWuEHbTx6SXvg",
-	65:   "FGnCRZO0KPjsqzJuvD3x4lyjRHrSAt6Csy8G9dZGmFw",
-	270:  "1WDCA5+EOpP2Mnh35ePH1kdO/Q007ltwZv7g+MDd8l8",
-	2770: "dAMcVLt56kXJyWea59rzAGCHiPvaZ53KpI4ow8XOKHQ",
-	1442: "Kgpf4qLMchGZStW1L7+2VmkxCDQsX1z+AR20vqazUAg",
-	2160: "URugTHpFt/+bxAtqkzgEiL9Gr+rdhUL2okaUMClisUc",
-	523:  "ahLxrVxQwP5GRiqb5/wSxj/GumbG6Gb+Pyc1gf8ksWM",
-	1987: "gEA9yLfokadnSXzAP+BVSMXmSHcCRhlD75nwa9bzzM0",
-	1343: "iQi0edeKbZScq1PIOe9zKxg2CzLcwBa/yTLgNVt2ots",
-	3524: "1KDzB53ZdU0ScTVOQdYm+JopLUeP9blwJ3euxHcSSBE",
-	1486: "1P7oX5v4xf/2gi6Glq5S6zKZhJRB9uaEaqwfqjty4Ik",
-	2931: "m0sSe6qkb8L4O0hANNT9MYDU5X34QFA6n31K480//EQ",
-	2152: "EqPpgnGVrTIo+tFFI3Uc/okoNupDENYICWe30SpP9BM",
-	266:  "NoT5xxcn9RX6pbRNjy4XiksV43zun9z10Fd/658UxQo",
-	2461: "G/m/MvSM3AkdggJaww7onpN98nCO5TVjdnuv0A3zacY",
-	372:  "ox8QPVfnJoYf5qIIIZqu69dK5TMrLvPbR+i6ubDWw2Y",
-	1670: "HIp7dEOKTB8uXhZ3yrBoU4FCe2qmAHaDtwvIUcIM2h0",
-	1501: "M2h3fDF8B8E04N6Sn64GlONruYrxXOl9ZVutATdLVDY",
-	251:  "xscd1BfLOA1Xr2kS8cEphQyhjzt7lCWxuTgt7K32gbc",
-	2235: "ZGjjta0TVUp13vQIoEE05ag5BdzaMYmLJVZrGXYX0O8",
-	1476: "LpwYRP6bP67u0vt637iGLjiAd/9ufJP/9EFK6f7k/ec",
-	2078: "XJnZGEIT80sWivdP7LQ0YrLka1YQ/kjFNK7896q9rrY",
-	3072: "omUM9VKaYeZeWk7Uge6X1YcDnY/uyJuw66q6Mb2fmc4",
-	3356: "MImB58KNokS4ZVYPBcRseyMfMHqe3BKEbn2rFoxIrgw",
-	167:  "P6pHJiaqpAK94iuqqmc9qtjUzCwuoBQaB/eEjm6b+LY",
-	787:  "tu6HiwzngVNKAMocmv4ODrLLb7VBM1kqS3NlGn5lamI",
-	2480: "ThL20qpvG2FccregBXlIbCYb1Dgpg3JK8+dI6DcNVCU",
-	1129: "F/Fep8ZggjFqGUC6MCBjDyyxCHvTdjp+CBQWM98Qmu4",
-	1997: "7vbhSZuT70ye/KKQCdMy4AERMyH0H3jJ4S+9EQmxe8Y",
-	893:  "5+eBtQXM3lg+ymJ9GOsaoXssD04R5QFUcl4CsY8bsZU",
-	181:  "kLLz812New9xwWYXNZ46D1nRKKEc+S0GLGU1k4GSvmE",
-	1844: "1JqhZTTGObQEZLsOu3mNNMP6s02CTVRXgx8gDCdFrc8",
-	509:  "qaey8tuzdEBtDWBn7FIF6cQnJF/YT7gxV5aL1sU697U",
-	2226: "z4VyoPlgMtUOzvTA5yoqZeRhpaofyCluOgEkg78Op4o",
-	2692: "XM1VWaHZt2IPhPb5tP2BBGFRv9RaNwDQQj6FXh+kiPs",
-	1907: "JiH0oNJR9e5P3im4SSoeXoWRjPoa03Ph4g1x2Z8J6rs",
-	3657: "Ije0Srn81Cv/TgZwEmHd2iWiS9uumGjwV1TXXtbRukc",
-	3789: "Z2WxbsuAxEq1JI+ucAzXXM3RmOzSl8etA5PCiuj/xrM",
-	652:  "9h2HYxlERSR8Pvv7IsREOtBldzjVgQaz4/sa/Nt6Up8",
-	1415: "j2axxbIkUcrAe5kARs3dA0a6lm1fwEzZW6TPSlCOKeA",
-	3562: "q6juo5fExFlAuvunwRWtno3uzoM51C2iWKawfKsieCU",
-	2697: "1rqiUeV2Z47/m8gJ5JQVkN+GaJxkrkWcMI167tge/00",
-	623:  "mqXqYN/Wv3RvM0+QyClDydnDnF5OC4fwbnJdjVKVbok",
-	2108: "ry2j28d5tDbXzslvW8rlFAMeLt0UvqSB01j/0vkimDQ",
-	280:  "NJz/fwnmqdt3Z1lei9NpmLRZT6oIzI2DuF6eWmbk4XI",
-	420:  "4FJeaHCJUiwcwGZqcyr5wq8Qq+BjB7sWtKRZtwkX8ZE",
-	1167: "/YysNsHvkeq4UQ7bx2I/xztytN/P4WYp4x3GBo4PUYk",
-	1430: "kvmrQonaN3fTLkNtQhTsSc4KsPmS9eX+93DFo1kCkeI",
-	2667: "dB44ERzqaowOt4UZKXmc4ildZrCNqrazAVHgH96TgVw",
-	2983: "fnynCENOIqec8mDCnKWfkSuop8DeBvpUIREPVxPmu5w",
-	907:  "jyPAwb+HiGbvJv3CevsRy2hnvZJrQA4ezThN+AJcc9E",
-	950:  "d1ufiT4oRw0UrBz7DIy70SYGu+gwgajCf+aPJ0eHV50",
-	964:  "ah4GaAGOxCWa3gJOAP2oYP9x+hqZibxDpTefZjEMdmg",
-	1732: "kRA9AU3VRYC0l2oXkTsdCl2RiHm49OJHHei/aZTld4o",
-	2340: "VVvolQ5irT3Gt2PJklXShZOVMmcrB7EiKAJMkw3/TBQ",
-	3500: "pA1lbucJjJ3OKnVh7FgBCf8KwqW4blI2WQqCDkvMjK0",
-	2718: "0TcZBJ4JJFLFPAGvnjefSy451xOwnQbISfVTsRp+wjg",
-	1726: "M31ZcX0TDejElEwe6JcIt2qIkzyJ9xkVwRqiWxosFwA",
-	1927: "gtOelJRLCvt/XraLnWSqW3d6io5IvGvie2TFazS0Tsk",
-	2829: "x2iAVHQuaRedWGnd54zgyEyde4ul3+dm4ZkA7YDH5u4",
-	2772: "VANdisUQIBWdvpaI+T9Ey1wJZSgbCKPbX62tJ+UmrWY",
-	2729: "Xgs0ZT/OjjtH2FA9TfizcFel4VSlMltzPHJiitglq/c",
-	2122: "7dn32TpoeEUunVUTFiHFCKu/IrsMACcVK5/Aal92Vqg",
-	3063: "qsd4hgj7jR9o1qKXDQgjkYXzZBtnJ+PAZPNQgw0S+Pc",
-	3545: "M5h1NhNUi5EXGyTnkj/Gw6YkFJQPQwKuTM1B0n7K1bc",
-	895:  "JXNkadEDGqXwZ+7vV3o1SGeFOVzY/dGLWRt3QbJ+hI8",
-	3722: "RzX4rhQ0rQs29cgQ3bmu1dHzy+7Oh3LhfKDIRQcx7Fs",
-	3245: "PgYM86G3+VAc6kUqRZZttKzI+fuS7/j9fMZnHWh7l5I",
-	2227: "Q4/giJTICOUchB7iJg7kfxwyRBsT3/3nyMyGb30WEFs",
-	650:  "cfk1ujsdXzGqr2Ii3RMST+imIsZM/aApb00HMmKcknA",
-	692:  "XZil/L/nsRF8HfMt6LbyI8Q4qFQc3cF23pnKfKU8AaU",
-	2755: "cdyrSoCjsHqJqwH6GbmMaoMwbB74rYg0yK9ozMgck8k",
-	2387: "jNXOfGycBDsFuVLtTGZVUW7cyckownSZrOLmZMrsF8Q",
-	1073: "AeIGs7XqaQML84wMUpUm2PjKDXEXlZpQehM7uYynQgI",
-	411:  "0YGzMHjqS1uSb7GhltVdAoYYvHGMqMrf1yONXltr1Rg",
-	1235: "GYganNYTOzd0lbSfDeM7uFsl0+MG9eJMfWEKjvB2+nM",
-	2471: "gxvnr4QYoVBHw23zxoLz0a0vbeCTtD0zbbysa953aFA",
-	2011: "UowsQTc+iECLB41N+WNkoJGvZ6yJRJWNxCXCB/SmLTs",
-	3781: "achuUCeaCE+OjUi1IgaoCxoE/BmHVxuMBscw9ED70Bg",
-	1790: "aIRv1BqPsFYamIDct6xtgkV/FUHDJOkxyrlzwyedask",
-	3819: "KNaAyEpnmE10jfnIAUi7grjhs7XgwlFerHx88PhSk1Q",
-	2254: "WwVSgsMTsUeK5nNjMMih6G8/3DztYuIL62JXMg+V8eU",
-	703:  "VbX8YbRcU7OlWHjduoMkMIRn/HkDm30L77WYn069xjM",
-	343:  "qK5Y8zMqpyfr81l2RsmTJK84pUlXJfGyDSokclCs+2U",
-	3925: "35ms3XenyrRN8PSh4cWEOtZ9lW1yzLJvYiHEucdlFVw",
-	3166: "CucP/kQBrIXRz1gWLOxyA/TYZlHc0QkWaAe96Li1Gxo",
-	3050: "YqviyhaZRl8a7ZMcR4kuDLWv+VyOtNeKCKanNw/630s",
-	995:  "o3eGXBX9JoZwdNSDSzPWew+/0xD+Le1au21NgDG2Ji0",
-	73:   "EofW+kyNrsmOQ8qsyq1pAXyBTUVBHH6XR7RBFT9/J1Y",
-	1398: "ONv00gz6JdHn4ZYd/hQls2LpVJhovTAEnOUappQnheo",
-	2190: "PDZCE9JL/FwD6Ym8CphZNnv59lo8CGSUAQVtava1CPk",
-	598:  "iATMTKQ52QxcVEd2ked7yGvbihIpl5xh+mArj+6NyrY",
-	1148: "hd6cz0hhduoLmNV+uTyqngD+BGWH1Pzr2fBuuPFoe/k",
-	2335: "h7a3gjpV/nLOM29bxM61CSavCWCUZE1doiVT0ajhMaU",
-	3203: "j1ILguhe2sKB4e0adesYtPL0vB7Lih3ML9G5Elfjmjk",
-	645:  "3iurETfGHvM4IjxPvyz0B7GMaQsvVybk9N0QG5Bagd4",
-	1608: "d8E5DFbqswyd2GMVNd6G2uakdZN7yigteWyAAnR8K3w",
-	3404: "wGluHFbEo/3WiKUHftqs0v6opgVgTZZDysDkEFb+sPk",
-	1390: "ZOUyc5nV/oA53pjjKUtCMk6qcyLOQZ6tvGaN7BPAOVM",
-	2007: "wZutFOWnKTCfG5pQ3NQ3KspQRwmpZbjOoB2TAtOD7Gc",
-	2665: "RVAwqcji78jQhXH57Q5tPJKMEKxyfhL5fN+1mvTC2zk",
-	414:  "jeB0nx2Pb5+lCxcUWnwzXqSkd27hQUvpkEAQEokrXyw",
-	3865: "5FBpx3V7FT2vbrYnDlfZ/DEJyMnyqk2u++J/hMhHdBM",
-	691:  "wGsbYb4OqY0qfl8H2IcUPFVBMmGDt53/IEev/QUh27Q",
-	3220: "loMoaS4dBw+qlcEpyvWmP4y8ztei+AvHBl0TrT59PGQ",
-	1086: "EMaqCbEP1d+AwVWobYNc9hUYB6Oxd8fNVUbbaORMtbY",
-	431:  "EAhMU9O7DXV8ZlLKqDAmpQmwUXEM8F1UH2nsg/2pPYw",
-	3759: "/3nyLi/Ae5I7Zpygq1QWqdcR16+G2/4ivgOlrgMKLYs",
-	3026: "y/MoCHOsg1p6Flm1CDVU1umMOeGLmCpYEop0NT7gI18",
-	3522: "U7Gl8ULhSOGmjFxaujFYlgHXWp8sYKxRtMwdSrMnf7U",
-	3507: "n/b62/ng/e99ZUjrx7G6Vv6z7NRje436RkkJ2yO35XA",
-	2558: "rxiinxJLbK49GNdQLP4jnYwzDVb5Z2EVALmA0la8ESQ",
-	413:  "1mQuEvPBrr8EIBkWtvXUZ/8C3QdelxvqTkSTONdWK2A",
-	686:  "gmUC56v9f+faXmWm+bPeh4lFmwGnSsVQXeQ6zZTW0M4",
-	3769: "zibHw4c49KAUOlthua8Wwwrd3hm0wmzCz1zE5APjKds",
-	3741: "2PEF9SHLitMRskt+Y+ypGLDR6UYRa6Qqb+sjaheMpAk",
-	2830: "nFQU2S8PewTFOVfbg9kB1zORR8Trye1WUIuc4GYlwtA",
-	2524: "iZoD8amxjfxQ3WfNy5Bc58vB1ZWBscG0Mf2sO+le34U",
-	1919: "wSFG8C5j7G32MfUmoCVekpfWhFiaixFAa5fKiewqOTg",
-	1761: "E5/OkEXkTu8kqtex1BfteSTPUWnFQpuXukjO4a8LB40",
-	3116: "98wFpuvAwCJYWYuy/EsiAFiUwKo/wGXDonrz/HDo2TQ",
-	2006: "ibVUoFBRoDAneZ1ezpDfS+FG5s/faVTCCjijaxvdnEM",
-	2754: "fTA+bOvEhJRWjO/dUbY3h5DBUhXtX82LEaCAxN3fE3g",
-	3707: "bbcf1TMvlbvzR5qZAv3d+2dswwhyLbBGM3HAwZ4tqdM",
-	1630: "Wp3ZFwE2neZLi0j1z0bsLIEMJHoe4hD/4wy6+8FR3cc",
-	3103: "CXcw1IsW50pk0Zba2RsbsM4HDYAbal/ZMzuJKdRDoxs",
-	512:  "LivcOEn5q/RBtdfyl4C0csdR9zCP65vgEOLO4o87/bE",
-	48:   "DHrDi8gLf0COUjaLsKfUDZ8ikRcChIi3suXmDIZN82I",
-	9:    "KksAKFECvzYR60a3GCkzga2yjVrCAnI7edwVW70Ec60",
-	1805: "w+kvu7P9nW/TOEdDZeE/9mxD8FKiMxKAdRqmpbeLlyc",
-	3836: "TxRVBgq+ZzVU/VLbAFI2Z8A8x+L0XdMwR3ydbc/js2Q",
-	100:  "YVPo4WJkfiUybjlAcnyYjWczNbyOfak1gXH6O4+r6Jo",
-	341:  "FJsNCtAuNMa9Kqjg5REiTRYu48aplKw57dFuo4qkuvM",
-	1702: "puatVflfWJRp2iCNn9hRvAVYD4IoaRM5WdNg485XXHE",
-	2609: "WXV7FNB3MKkYCl4FeqHHvgPICqohSyyOCV1aMK5d6BQ",
-	3415: "qGfUeI0O/F7rFcTztCYqmHwjWrSA8TdTYlDsahEfw9Q",
-	403:  "xTnHIc6n7F2ngk2nruT332977sjAPxLHJ6l5xdnXrGg",
-	1473: "kO3EwYpKhCu/7P8DWkQLr48osZ6WpZIQ5S+jwwD1GCQ",
-	1136: "1aVKlrCNeN/qdXWgzpsEhRt9SqtCvNk8h1WBZqgTUMo",
-	1253: "nhOsGO5ErFzTSMGTLb2A4iwaIvzFpWyvGRPGFwdZltM",
-	2756: "YjEkeEtD4M5pmgUkftA6RavimSUbHVf1ENtVYQInU0Q",
-	124:  "USbzSyqh/ywjyl5F2PgINMEXAxWlQ+bMCcWh/t3fUfE",
-	3992: "DEbjGG65AoaoOB4Z44zS7YzlevOoQfqaGwPlutuASBs",
-	1124: "3nI/wrNCBG7DISdOV4iJZa9DH/Kb5JqqlSAAe6E0qrY",
-	361:  "u1VHSJ0bIblgg4bMsdBWLIxkxBYVoG0nV6DbKBB6vNQ",
-	2208: "yJitSNrZGvWlBnTQkC4FDy7SKEa22/DjT3KcqsF0pYQ",
-	1297: "PwhTM3ACnldotPYjtTIhk2+oQ3TQfl41Pp4pZfvCLjE",
-	117:  "AdSPFrqqX9glHvHom4H8JpmP5Y4POJMRABna+jK4tnQ",
-	1233: "rItwk75PoQ+MxqSKTkoAlHtKvzml+29ZJXl9ft29kH4",
-	3914: "PvLrGgMuVLI1UUb9yFReZ8Mi9Qt5UaWoZiADMwvVJbY",
-	227:  "n54Qndq49Y9V3YtxQzAw9aoDIQrrc9iNNtxiduoehUw",
-	3051: "WZ/Jep6FNgp69tD+/qnpLwr4NTxXwUJvTLLrbPYa8nc",
-	2930: "uKcK2d2vqu/wXxAt9VJQTf4gbzsNP7/KvXVx5Ds/b+0",
-	2222: "WdXTgd04hv5k6WqkHUv1Nzmt6mipi1mjXn7LWNqT9S0",
-	2575: "EKiF50smaCqnJb43yLI4hhqDIr5THbB7ByR88AX2cLc",
-	2428: "aXgYUdxvyv7FtXiKfx7OgmcQtWRSNvw0wrPebVnOTFM",
-	3270: "N5qlpxhJMD/sq0SktKtKLFyE24avRhtXJ43DY1dLptA",
-	120:  "IzZduAaiZBctcG/mQMaw2G1qXbkRvz87dDFG/+OUXG0",
-	1420: "7r5OYUMNosFWDa95S/wwz3kkzmm7QiT0gbtqE3wAzFw",
-	2675: "qZ+9El6A/G6zV756lsPda7Q27j6nNqArKgEf55vj02I",
-	1082: "S4+zKxQ7LhD+Ud6mGeZlPe/AH1F4hE1NVAO5c97DTs0",
-	3523: "6HR/79GZ7FOpxboBt/05WMUEDmfmRLgwEHoaOVQK8TQ",
-	1908: "qFQN93MHmFqaKv8X7siQA4/+qKcdPtHJ9POXoes14XI",
-	2914: "uKalKyb0XxJgrqklCJucyH2s4aWYAOz4S8IptVJFBuk",
-	1910: "9FRuPvhwxZFnHoy4ji+uurLqqjWNJyVRNT3PxXlcgt8",
-	729:  "ZfLzegR64lzJG8QRrn5E+JiOeLJLLoH0a9Bh2XO/0ic",
-	892:  "6WLuMS0xh/2vBUxKB4OT6QvYju2afMYf3O264girHR8",
-	126:  "VCQ2I8EsXp4cHVUZsfRUPEBTyFwDE9zJIi/ITKqfIW0",
-	476:  "pzmdy6KKpX1Pl8QektjxfhWhX37Ls2Azbekx9xsPB0g",
-	2280: "1HYWzNULL43QLMvIc535+tN5DSNqetM5Cy3z1U0doFM",
-	799:  "xwWaysKqFQMmQNCnNXwl6ZZTmufJ98lCHvMCkortGo0",
-	976:  "CLyjfXoECR2lCnGD18/YrecBs2H6qDNRakTe7roKqfQ",
-	3679: "w6R9Mwh+J9xVuKyRymnarKO+ebi6TydBkGeN428ZanI",
-	600:  "SSjHBPgIM2zS9BA7y8aBkPY+U6nmxeFJyiMnybOFfMM",
-	3221: "j+HZFbIYgMvAd3djvBpGTnPxbSQW9io7HVSj3Vi5kz0",
-	966:  "LQHFPj3HhqHyb95PSlqn2S24k6tUNoXQtCQa9zQUSCk",
-	935:  "n4sKh+q9JgQdLmHSZJinGi/ewZ0jmNgjMH0gKbCoyHM",
-	2044: "aU+e2kYwl8HvtZyeEphy1wnZF+I4KhedRReh5QNLo5o",
-	3121: "hYjuUSpncHrH/IDTnNBbHrsR85yoLPg+KTvVqw+hkSc",
-	3909: "YdawrkSNuIdxrCQT3kSZfdM0AT+JXj4lxZ46b0L2OyQ",
-	2901: "ye9MQgJrTq1AsF/YmeAE0kkhWBPLH+Sf0GPYkrvNXcA",
-	698:  "5md/kGlE/b0a8umKhETIyoX0qohJQR1WAEgUpe5O4Pk",
-	3447: "j7HEDc4+FPMuDgI0lE0XP56KAamAEwWtdpQesCu9eVc",
-	37:   "tHSPRTyGpmtsNNCjUfOSBYGh4vOmfSohAokEV9vp1Fk",
-	463:  "YYgU8ji7eSVekcAHH7htdPsVEPFQgrWy5oiNy3Oy/Ic",
-	137:  "MZD6OJtydqiB+M7S8f785rW93XnzXd5Djvm0NKNUIYY",
-	452:  "eLendh5g82l4m/aYcBO/OrLNqcAMmG8gliutXfLQk/E",
-	1361: "oRt4Sp9d8rBWDhQ7jaJV+lhrwaW1kSTK78Q9ERTm09g",
-	639:  "KIY537aoW5Lm9YWFdCln9t9qUH/3PIz1NVF0QoKT+ys",
-	3428: "lr56iy5N3awN0pUW9zq22MC3l6GKPgYEmkzEElOcnIQ",
-	3614: "3uaQ9EMDCUJalQu17aHppe+CROLvp7+QK7UbYGL9J84",
-	2508: "vlFBn9d2+5wb32MDvD4aVXMM+8vqFqPmg/w4bkt5KS8",
-	2345: "sAQafnFMWWnKhRqFAGfDYIxwCGW5P/eHiDQKrT/eSwc",
-	2094: "U8MG/kcLvLORafb/0XRbPmiQRuv5SYEHXxix4yH780s",
-	3316: "q+rPik3n2/Wnw4vxYW5VBmvg/TKd8u46DpPx+8FQGhs",
-	3299: "7RRpvk/yw1f1s3yaoWeCgawDTH7EsZwhu7krh15nM4w",
-	433:  "S22MKt091JNsXoDBN5Kf3FU+Mf6aM10D+AEqBpTnDvE",
-	769:  "0aojSbBWABure3LBwuZ3LCB6H6MyfMrWMZTyQLeKBgU",
-	3339: "ua7wWjhXnBTYXfGdLeFJ3/hfQ+uSqSEjUgkaZvu4xEM",
-	3889: "3Q1uaLzshf8iGl8XMjsDGOlZp8oOpuigOa1S4lQOE3g",
-	2333: "DMxs5Jj/7szD70X85uftLjqUkEquOoWsLFEHaiwBgU0",
-	3175: "34U8tKEYrQzmqvp8duH7w3JyB6hOzggbwKz0PioquWw",
-	1071: "o/POG2t/JJrEe0kAofH4b56NDdplf5vm6RXEV7fsRe0",
-	822:  "g/BFTrgy/jE+o9U2LZqfhVLoAYt2knGP40l5Mkywitk",
-	3170: "PaP6NtGl1Ilsw/XKFAT1RCVhiT41jF5NqvS3BVA8TRU",
-	626:  "JAzVGVMW+fktJgog52aYoucWH7+BDz1XGUuZn+GePGQ",
-	2297: "c2+/NPbF3tGCm2apfoI2XipEoNOYYoFsIZBAzg1YaAg",
-	2975: "ENdI59PAGqBH3Cp8JtqRo0731biX7w9kJ6EIe1w3RZE",
-	321:  "KVuc9CHaFQ5WGsXQcFeDViI/+grROtyJ/FV6Z/w726w",
-	3546: "ufVeEvUfXsfQQv2HFZ0PuWFo43YM5FEaAv5RdfbgsVw",
-	1694: "QEHh5Rl6V+oKpMXB+mpgAixrJ7DAa/O6Dj7c9P9fxoc",
-	589:  "8t5AVHnjvxp9PiKxP5kIZhkEplGwPWHSZ3HFda8Ut5g",
-	576:  "wJoewQVVvCgQW7gt4qcOzEnUc6Gh4K1heG5QSF+bi8Y",
-	621:  "w9Vk0Gn+me2+fCM8gVhSx1sVwGWqmb0jax0vSh7jQUk",
-	929:  "Ua5UHERkS6Cu487K3yjoTBuZOUnca5IHSZ2Y4dB8WP0",
-	2443: "nSOtgzrZ9yQx8bTUgKc6rJZT0U/k4Ic4I5OVde8RKF4",
-	32:   "10UHaqteyIbXKCENN+4xzkAHEDUh62Np2DYMOdsAMXE",
-	3857: "iQxgtwZbybAUF+qIxcIbl2F+Go+/LXM9kRj8eGl2v58",
-	56:   "GPy71O8aWB2FZhAVCnp4IieBLRPx098vWXWC1y4hMLQ",
-	679:  "WQPJcThS5K7E69HugxWW+LrWfwLjPWqKHtvrdTAWUT0",
-	2672: "gNzxfKP0TosZVr7Vs4zyQqxCIInsWZsCq5DHDggyudU",
-	571:  "kOSAlktFcB8GcDQr/+3gJQmsnrRZaruvrjOeN9aX6Do",
-	3027: "Lkso6IqW8vO9gOpHAmjeJyzhl4MApWZLdcls7MVxIxY",
-	3849: "cYOT2o6vB7gtih70Mw4ar6uiI/mcQy7dOcnnWbDMVQU",
-	447:  "GCZwti60L5aCXtYMb3uTkVHEMQh7SisGHOy0aKDgD5Q",
-	3363: "ZdSCeUwPixQxAnlSbnljtOby1Zjj/PqVn6d61s/PQqY",
-	401:  "15MIjbQS7gxIqYulBsKiuXjZ1v2m8Lo2gukPHs2fDOE",
-	3213: "YzmGYAqu4FsejjN7UrrM5lz2sMUK7egYMSHINC0Bh/w",
-	3362: "Fjy34f29dYFNfKOB3YnGOfQ1EXLBILov6EOlMOISUUk",
-	3498: "eMcLrm3BRRDT2RltyQKx07+NfEQfXfq7tS7W53Pckdo",
-	745:  "OY/bo4x/dt6AzN4C3+HEQA5daot/Z9gi0zWQpkW+AtM",
-	1874: "xfpHPTRNohCfFGeUFyk+779KBWX1hQrqFwsvN3PDP24",
-	2915: "s1wy5TPsil01BiRrCvvKt0Y6MTn4ynfyTqoW5tzKKRg",
-	2035: "Woe5LV/ivBvrgNxmBQZBwsZboTatPWyCRTnC2jolcC0",
-	408:  "7bLIZkqGCNtCUAaUE/YHWODAm0NZ5FjSj+SqfPVMwho",
-	1053: "+WuJobZHee4wRo8T1YKa56jtEoumDUOg4DsyCU5mIG0",
-	2643: "2a+dN7ZCEHR6cmgbudKL9od1bxeMvm4VKu4w1fpsJ1Q",
-	2797: "VMEDQZdNBNZGAmfvZIrsWC1z7R0uuzHhnKVSsosyT8U",
-	53:   "y/ZqV2TxeEzxIPbrvEPrumk4ZL+0ZsFL0IYaWJOsHQE",
-	2168: "uyiKOPFt8DRcOhoeNTQStRzFee6yH+84/sspZYZ8pLU",
-	3475: "x74Fa3HdjGkmAz232Mi5k5UPEoTQfohPMG9kWRcBBw4",
-	3991: "H8xrzxKyJqXrxzb+/XUydJprnYn6masY1PIN8xrQxvc",
-	1126: "W2YLyPAczchuL5ATJq+emvNYPwOgt/aA7ufXOx8zkdE",
-	1985: "QVBRDevKugQBQ8L2BlvOGzBzA7UumaUHjtbAz+nvUEI",
-	3253: "9O5Zvlprj+A+zpA5ePH7/JfNCA0WyijH/ZM7mds9GKE",
-	2068: "uI6DcItBXSqhd2zI/J+XWR1y6dbyzx3UWs1mNmQwj0c",
-	582:  "SSAKT0MELGCNNl/Fy7s0ZF2lCR6n08qFpICjJOG9JXc",
-	3096: "41Ry7y/FlBQszQ+wDDnArfW059qGuXxU/qHGlI8qcVc",
-	3104: "NERok/ay6/FlRyyxQYW9HFDRzwHyNDuf4AyvIquhlRA",
-	2111: "MliN025eNfWlBZQim8tGU9H9+An1/obSSHmIC/uERt0",
-	2695: "OrgqBWKInxUcPZMQFVuGUvNixQ0/pkQid9JK08lQYlA",
-	985:  "fA5gKxSrlw/HCP8v9MUKGNljf+Tv7nVxQ3GcR9r6eNk",
-	979:  "ScwUgr6jZUsNQH8oFY8nc5TQ8EfHBXZegqJIsrPu6WE",
-	2938: "dogK1/tKVN/SM04GXzMh1haIJxHN6orlxFbmin+Xwc8",
-	1843: "UXTepi/eCPB7JE/WLbNllEQ+Ep4mecgEGu+SNarBQhQ",
-	1711: "mg0fQx9V4da/ibzf4YOADgaHlwE0NF4Ti7QuMbrcFTQ",
-	2473: "XmWPwsVQQ0yXl8zb9gZxBdzKFtHvood+nsLQqxA973g",
-	2062: "t2NHAr9ar4xaN337yF35lBCQVgdJj4xF9dBnAOB0v4M",
-	1252: "ErLn9D7Gra5PVqAggJuqFMMVKLy/pLvR6H/UnDiv/dk",
-	3485: "2zQEYztlWLE8/dyTYULnTOPyGS1K90VvgNfIifhW47c",
-	999:  "PlRulc5PoQvIw+cNVM01/bgPLuXTO16Kt454ySL3FZU",
-	2621: "7JBIElkEST8+EjMlg4pGkSWRNjSS9r30Rx3YnLSvbDE",
-	59:   "UgQVq4jPE3FbhpHgQ1F0iixKPbAlLOJ9q3iIRsUxJ4o",
-	384:  "hVLDcay6Eq42MdF1Wz0XdqDFe5HxlAEQbAdWSaHGUsY",
-	2451: "pG2W9+EpxMof4PhRzG4zVDlL+n8B9mS6AJlGzlDeE4E",
-	2313: "245VFva7dWzbaMNagb3rj5mPqr/iCI27g1etcEGm+uU",
-	917:  "wGHyg+bMsSnRIrp6n93im6dTqWhZtzF462v+5KcCmmk",
-	2958: "rQ5ADOzmwMwCI7QPaKlw/SOEa2Cg9V3YRmWFRF9HR/c",
-	2228: "ptvYqgUrpU73QK+YMqzCVqxdH3k6dAdKrfj0vBkJhag",
-	2818: "3MLd1zfG0XaDyI5mTBCLxz467TAGnueC4Nc4J47A5jg",
-	63:   "VFPysqK3ctxELC3MMsp0VUkck1Bu3zmxtrPCa0ExdsU",
-	3890: "EVr7Pue2Py9q9bXfZ2O1vG0mx0VxuQPRFJ7iYZs3O3M",
-	3405: "e8DD/U3uMkBHgxmGHfK23dwRT2mjITo/2XLVzDBmBKw",
-	2051: "mwR/BrSmc1qAH+ASJZkIwJTmY7hzJeVKViF6YGdRLhI",
-	1193: "3a+/HcFHm6WFfMYLgIdXRdzcXswIPPdd+7aCawwq/OA",
-	2286: "Rialzt2UmxtnT8BT1a/A37c0v6YgMDPPZY6iwaS1qGc",
-	689:  "r4NHH57yY7TXsEwfTY67P2op2Xduh7OVtcYS0FASLnI",
-	3961: "+fz6GU3zuvrjWmVTdvl7QGnfvuQA/Vqq6LGFyF4FIbY",
-	3973: "caaKOL12bS9zQmVe6JaYd10vWpcLOKFP77cj3Leb/Lw",
-	902:  "Gy3IloJP4i/Rh77dMZVefipa5q9XlpZbhy+838uGwAo",
-	3868: "/3hqTeQn1JDnbpLh64HwXpojVN8apVQxS0UoR1e50qM",
-	2119: "/lRn64JSDuNWyFRPvHFUnK4q0BsJBX33zg6nhhEoPMI",
-	642:  "DLhKEihP4JMOOwwkeD0Li3fdQGwP7nwTrS3jKXbE3so",
-	172:  "r7hXprlc14R/mvkg2+njOYRPvs8K6UF7qQ96i63WpR0",
-	3683: "G+0ahQQGlC82u0oKjQFlVBoV5ywdzyd94tny+2HqCRM",
-	2596: "H2u0D2WO7XxbQtifklgSAl9zwTPx1Qmwqapl3zmLQOQ",
-	1837: "Foair0utTz4jld2BckF64UrH+GmYV+cqFV0TRddDHnI",
-	1139: "Rh0qhIc0ZGEALDa6DCnHVAwpwADUTxa6CnGgJVZ3N1Y",
-	2472: "Uxc1yZWOpz1kOHsPfQFuF4VK7REQKmfpEhW/1uzo9IM",
-	363:  "9zDV+jZWEj1OByTw+g/1vKIcrv38Iw++eAqs/CRY1PU",
-	262:  "STxJLAP3f0Yhw+ev6+7PEiA1WhocnABHT61CMYr/ti4",
-	2954: "oLkQiahA7BpIB3YuU1Cf7Gi26HdA8FQ+OktvyT78C6k",
-	1965: "2B9KX6Q1Ti6yMIeTfew+/yllfjFL3UkqqR39GDYnu10",
-	294:  "9lV0OLiy+tvPp6rlMM/IaaqPXEu0iu2IMtwBoyAPMzM",
-	1644: "PJxK2VkroevFgDgfVQCDfKOHPno4G0CuQ9SDKKuThsw",
-	972:  "2l4W9Hc+BiNkYFlK3j79eeakZsj7f+G+rIh7PEu8o/o",
-	2395: "CyQOl8XG6irJA4PQ9708n2p5vC9Geesxjqt6OgEqIj0",
-	3583: "+LnMHGLZCsRH7LYEmq7IaLTaZ9qFirRwMlKGQjzUEHE",
-	3439: "rJcB5V8elvW1F/wFR5jCeK/YqQTTqUgwje+NDBsshP0",
-	3398: "crlYIb5TGNDJ1AlA51rhVhAHzQfhMrlML2z8ZJeAoLc",
-	218:  "6qcFUshZMrcPDufNCGgzlLrNoG9jEbBJQiGPqH4ePXo",
-	370:  "0zclbG4ujyX+uosK9iq78yeof4peAlIrsaHXwYvzh3A",
-	3156: "kVHljY7fDS8kfarOowAQ2Rj6GXnXqNn1t9Gl+lJR5Tg",
-	2318: "DcEyHMgPogV+CltS/AwHsU/QFhib1eQnk6NAuDC6B5E",
-	3298: "KG35zI7rUCJi7Lxo7VbjwsmtV8mTcGnK+m2Xeav4d6k",
-	2720: "lModMbvk5HZ1D4yoAoGH+/BmNBV6L4CESNvEoe8UC+U",
-	1818: "diZPSWLPtl6zAOr38r/+vecaPuf90cMjtW3uNEOHsEs",
-	470:  "8YW42uOT2/00t8K0m/tg/bsQ1dcAi1PtAxSPlXmDiro",
-	2700: "2bVpr0FAj4ihmv3mFP31MXGQTaoQteew2fUct2j0VGc",
-	385:  "DCx16yoHUldkZ+yjlPMMCFoy9rPdDIgY7MtYWStOYwk",
-	1074: "MyxrugcK73p4aDfsALEWV8u7XWrXylMWGByCVvbeydU",
-	687:  "NxeA6j64XYc6clXwCjn20RKq1PcuNnPyy/It/P53Zqk",
-	916:  "QqIsXwhVaN0P0yuyu/0zuR6zEFU0AA0GmSrtlm5xyi4",
-	1723: "KmVXsr0dpco4zOfTilp0VpbWVCB2lNFGs4/KKYdp1og",
-	1616: "WhMBblJrhT1U2alnqo5KXMg/Fe2uQf0mO3KePo6OtWI",
-	2985: "qwdEdVUfl5PERbK38KQkOguPcUeWZYdC+vgbPjbDx3M",
-	1520: "InUdy1x/4N8aXIoxpELOGtyFTB/b0oOFAAPCYMi/S+k",
-	1573: "WNAPAFPAx8c5B3oUnNt4XYjEwTScbYKpPVH7mZJVEzU",
-	3615: "RhYqdW1W3m2/Nlijg1fj6DvNFgFLJchL6TY0ZBZG+zQ",
-	3535: "Fm7c6T5PNvwOJ4viQYeqQ5aqKJ+iVeaLglPnBT8fJj0",
-	3380: "Cnrx7tFU5EfleL8veHiNQhTuHxKuyDTm2Z4wzIlqNH0",
-	3367: "rAo2ymvwjUWjRzj33dV+MjO3Y6KI/2KAqjVNiDCpCq4",
-	1839: "4ldxoyEwzgnI5SxC0rTQlV77CYI2BD0FVPnYb4UdhrY",
-	1532: "WDux8pLSdn3pr9iEnRun6YzytmUDLyqyoQYULHPlxac",
-	2638: "6pvEkfDhI1RnMCu2tpyIPba6HYdbZ2W8UsKn/NvrHMI",
-	3702: "+DmdXEaUJjNMUTH2S7YDa3x2+qTZig+2MPtiltH6SMo",
-	2077: "No+JnLDEti5RzFNT1lbtzpNR6sA7arZck/mIuHI/nCk",
-	977:  "3S0JQLlCv5CRkToPFMukMUx80y/ka0KFMbuMiZ53Fgw",
-	2136: "5ixj5ctsyBPb7SOS9uYWuk2a/EPOplG6gynmHmbAnUY",
-	75:   "N7Fp5PegTmjtGIARL4HU8bSf6pGn3h1gRAR+mNe9Pug",
-	965:  "Dm4Y+iX8mefTXiCJvOnu/wbblqQN1YTqeqwa0yuXoWs",
-	3:    "isudemo3",
-	2582: "pmI8KB3Zb7vO4nY5D9Z/5pHK1fJWhbdjaC7gEzkNHFQ",
-	2316: "AM6PPPM2KyjOSO1xEpC0zk5HWHmndYcZZt9XB5kKwCA",
-	3656: "YKmQDIqgMzVKF7ilUDGhpgaSFLmUQUEb3n+9y5CtldE",
-	3669: "4NrPRjLSYLECLVBotq61VHXgXDvUfDdsbXpomcg95kw",
-	2:    "isudemo2",
-	843:  "xJfSLSV42WevS04LrLSCQF4XJUnoOIbr1U4k/p8v2rQ",
-	3271: "EUzYD36SvtnxyY0JusR35Z+e2xF7IOJis96O/YtUcoU",
-	1880: "GF8CviZf5QOVsJIfYlA0uTIs7dx2atjY4ih4+NGiN4k",
-	2509: "U0OwkWRObFrILtPvcFWFyNpKigHL+OIGDWu/e5QEGz8",
-	2646: "LP/6OLFGv2X9AsTfSmgtSxwEo1jQxHffjgjue58z0F4",
-	2323: "f05bm3iY8eXjI8PFJ/KMXu4ZEbobWsbEmC5ka/sg7z4",
-	3239: "gwMZlDtc7w9xkPs6QOHbTw5Jl0l8Ctl1zDx8Usml5RY",
-	1273: "lzujFrqrmSyIYPViT+97TjheQAz2ypqOEodRhfBdHMI",
-	2392: "56nJa7kHk1/V4Zs0rhGN+7s1ZwHQKsSjf2/xwFosvp8",
-	506:  "7M2MnzKmid3gE5eW0QzA2O5d0+PFMzUZgmOcuDXdo34",
-	1519: "Zxyf9Or0U8NQwWdiTUxZt0UfBUYzJyo26RSzWLJN1Ck",
-	3444: "LbymyE7Qrp50nYP5HvOiw8VofcaJHqPTYWQSmWjiV6U",
-	2166: "LWHUwuHbTFqWp98sZCVP1RPrM0UTX7KLIxxpXUq407U",
-	101:  "LjiNmRl3yWg13IOSa5c7ztsBlK/+mYhC9OR602Iy45s",
-	722:  "KY8lYbiGuPCpQLrnDAziJDtsU0VvJwsoTseJ6ZJrlsQ",
-	3151: "F2+mz5TIMtrFshJj4XOm/w1uViNafhVlsrLsb05G+Ug",
-	531:  "kIMpZZeeYVERS8M6snMpFava8uGV55Kq4MIUvbR80PQ",
-	35:   "tcqhjBdkShZEBwmK4MgNArzJam5Cp5mJez+ZE+VfXbc",
-	1005: "anIVBXPNXECWZlYav3ViZzrt/fUxLc2hMw81RBx1MMA",
-	780:  "XviJehG3QY4hnEmVVS8SAWXaSoqfzh6+9zYPMfFTPOs",
-	2689: "stKJ5PjwhEJ4/Cz0yb6M62Awun8cztRvHw4/Wm5Pl+c",
-	886:  "jdWqCLS/IXDHDDzcXCXHtSQgL1C8JrXC359MEGvb8Qw",
-	3893: "SE7fntPC9GvEPbamHJ34gIj6oGV4FcfW9sgyWSE2jLs",
-	871:  "uO/OEfSwoSN0uC66z2PT6m3u/AgGlbtbzvKnl3MJ7Vk",
-	3249: "Wf2FR6PKVejRFfbk+PH8YggMN9ibjYKo7v+OR8VS2MU",
-	27:   "fiCfVoSw5SlOGNbbt1gdQrAS1TxWvZ14+u6eyhhAq3c",
-	289:  "RDjLw2MBC77U+Gr8LFWGKudg5WuDlV0QJUQ74fTnt9o",
-	1358: "atJux59OktmcJs90QpDzNigFD6/WmRlXSGZE1ZeUJKQ",
-	1800: "VSrYNLNL2RURoE6jUbvlYKGzVhe+FD5AXl4pAk+nCdk",
-	2565: "r5hGhQcpOzwvZMeItoAA6GkEE8eQCBd6o7HwBGVOMUA",
-	2373: "OjeJeRmWjMobyNKQ/u+9fyQEQuU8mw7Zu8YEtzhwEWg",
-	1754: "/QUi8CNbmRTLuJ3W0L1OGpn86EM6hxsS3NqsSaRVy7c",
-	3643: "2DxU3HYSe+GOKAG7l/k1FhwK32A0j2AWB91D0SpKD/U",
-	3499: "T2sx7UvQikx6ZDghUOlZViUM0B3hq3vFUA7Y0JzuVYc",
-	2146: "cCH8Rb0stOmVyuER/yLPrio84OanzjzTDnEJU6jDn54",
-	700:  "XQgv1Rw9NF+9XPVTK6BWmnvZpToltZBlVnkRyCb6xA4",
-	2358: "yqeznQySdUgIQWLvxkw5Cr7niYV+SQdQ5q+Ismc34go",
-	1305: "gExgVCb0VOee5qsO55OOC0jAcU+qAt3Hw4SAVW5BiPE",
-	3543: "kUSB24TXIHrYy7kRxVTvlxixMj2panKlsTalXjY7aHc",
-	2988: "fzw40wgvCD2oZwSC9uazbbKfM7x4zeLrKLfBXgcnMGU",
-	2162: "rDQ0SB1E1eM2eVsTkftfIFh27cswXWhw7YoHctuc5bE",
-	2499: "+z5b3kNs+QmEInTtQgpXDrheDuDM+ptWx4MGF0N8fJA",
-	572:  "hmKxNYEoe5li0dbd73wIVkBATKES48TMD4x5Hc3dveE",
-	988:  "9d4aLKjmzWrJpy6KDCappewb3CgVNKrCltwgGr14sXY",
-	2287: "Xufp7KZ/ukxsGcIkPubp8Wl8pcNBfnlgfXRxrkMbxEM",
-	93:   "RIpdr9FnMCyUeMVX8UAnn8eTHNbsT6Ca2WVvv3NIjPc",
-	138:  "SzUFSbjnRfQifleXpbJ5halG4QEkZzL5xHMY3M7/s8o",
-	2236: "DaHdMBOGcEEgCxDmWhD9WdDBo16hNQ+Qkd5kQUMEZOE",
-	3331: "NnGnp/vZBSpbeM4lw8mmoj861cWYKOXcqrgVmvmYW2Q",
-	228:  "DGzwDqAmt84atuFnOTqWci5/yJ57Ctw5VYUyBKOTx9g",
-	1465: "zFCwkqUcmTT8OwnJo9YFVP7WXYuHdRdIpxndmc3W3c8",
-	155:  "ayG94r6gCXvjh/HK8R7lG7N+M29U5N1hV7qn6svmK5Q",
-	545:  "OIPOVtj0VUp20TTz0uzTTR8dPRi9rcrtewegsj6RT/s",
-	3743: "cINSfKP3GWzpfIIrBxDn+CHui+Wr7Rsd14oUXejYmlM",
-	1:    "isudemo1",
-	358:  "UpRu2L1OtuOZoC+44fTCDdaN1XAK52EXhCPbFZhB6oQ",
-	1488: "ILHbuFabHVhcgMl4oMjw52d5TFj6R1CDEjZ1vQH0jL0",
-	1364: "XDmdSTDXibwRdQ5GfJ4l89WjBfGBqJhEKNTmJX15F60",
-	2256: "2i/bXkdtalkA3+I8yx8TuwSfyJm94cj3F2Eqk+NxNvY",
-	2034: "cunNEVoteph/k1TpNftipOZ8R9aLFgrRadIT7RYx19k",
-	852:  "BfJ6gWpvR+InjCKAw1hu9+D0SM8POJwvy/bTG/hNE4E",
-	719:  "eWIrAo8Lhn3YT3ocbzCUKNo7c7iW2cuFdUiboWuE4fg",
-	499:  "h87yIcA2Wp29QiliMWuqovCDGJvZZREPpk+6Ww8tTVE",
-	2184: "GkBtgJrbWplbR92LhLiF5kuZhilKrmlpLVs45XoWCRQ",
-	3455: "aejKBlKRSLGtDmFApnXD5DtoJkYQd0xJ6gdWhWYrLJg",
-	738:  "VdRkFFA4cDV9dsCxZpafjBPLL56cPh+Qp7I9YPe7K5A",
-	109:  "niY/MEsfbMP+5+6mD2DzA3VtdG9kGK0IiOKFiY2KEbM",
-	2527: "ffqtt/B0eWUOZmWItIgGxh3lTo0Y0kqV/4HIjZbVDp4",
-	1006: "ndgLGNmIz9JGSyVPxtRSKWTchSR4Ed1RHNwDWfWh9zE",
-	2881: "mlwXeFaEUOob5RVYuS/953cJAMl6JhWIh3twvcJmBNc",
-	1561: "pz2pglSqudrDbTBH4Ja2Vn++trlcqaUO7KHt2DDrnjs",
-	1386: "CJ0FpuLo+8HoxL0lce9rb15/uwtf8jMXV8pw4t+VBYM",
-	106:  "7JWFoHnA1B73aSxLiHVvJt9aIbuGT/WB4IBdE3Z7N9A",
-	1244: "l6HMhyNhZazBlonsBrtsCXmL/EbffGUFqLzj0uu8D+U",
-	2490: "3c2+IqJEtL2NnV9x5CaL55oqkDXAN1JNH7YaCGwmkGs",
-	2112: "s7gC2/ahc7s+vsBlTAR6inEWKYBo3fGYzZ5gXbIgc6g",
-	1791: "GG3NTsd7i5nEfX9wLFXc/Mle1B0roeCgywqZ+sj2vf8",
-	316:  "1yrGqo1KTdnP1WbC5AM3bhbfkq0IjzUkidqG7Gm2B2c",
-	3574: "DKsn9lchIYZT327RnQKuoYnZ+WGAv7czDh1L2RsZTRw",
-	1858: "sHRMz0vX+tnS+lbJaoh1E0fUdZb/udSwaMdD7hQePbk",
-	569:  "dTSE8qdMM3YQHvFqOt5O1hEPO+kb+l/f0jCk/xNkyHE",
-	424:  "DklhLmREcL/NfTex4qyKpoNogKo/jzUX0wpytkf9bnk",
-	281:  "5do875R63m2SvCRbns+6I7N3SsqRQFOXCmsIgOSFMrM",
-	190:  "TEH7wy2x5+Cvy51JGzjjf9MLx3JZTLMWrSLEPbcuEao",
-	1296: "GINTKnSdyv5CoMFmbFSNLXSz+Q6uCJ8VzRPwr5u3g0s",
-	3575: "uLNcaT/1fTyUzC/jO1W4xFjB+TBIxDxWG/l+WI8fsXs",
-	1882: "bpgo2SlwrBUwI+Qh+D8jznJhlaM07zH65YdSEDMRMEc",
-	1557: "ZtWx52AOc7reRGnJgIIYE1/vh1m3BAm/g/xBzfAC5FM",
-	3225: "gTH1+AUvL08qHKae1Y/UOKHYghFKWKJqSrzKOF7gk+Q",
-	1949: "14Tv7fRykbgbOsTNMIywf2wDQs7RkbPxPyTv/JjTjUE",
-	3728: "fBPXLhkmMvhdw3H4nBoCU1ICsjhrGndqqS3j29Ma0cM",
-	844:  "AM5UrCfm3MBzSC3gEtDc+HWxsfoKpg1y8qLQRttHRr0",
-	1507: "JLI5YnstnZyigLopZF99dePs3jTj6OtR4yvelwrm/ko",
-	3830: "sN7QHlg4WxpylbWnR07W4fubUM/HdtYw1Cpd4QsBo6k",
-	768:  "DiTtC62FKcFYZUANhz7CFChvF9cNeCsrblG8P9h0qjM",
-	3385: "xYPqUhg5aEt97M5aozi5EkeQe6x5qHWgIE70AjKSTaU",
-	3549: "kju+Yuu2xrzpSXMnOfAWdhR5lf6ewE/t7DWMFWeEyaA",
-	3283: "eYlD/W+lw9PfwWvk/4ua/jV7wKGwe209rgiXqwlq8ag",
-	2725: "2jEUPJVvVQb+bqfOlfgC/idBaXIBzcSqDLHEBM7FoN4",
-	3697: "8MzIB1b4dGsCW5f0vVFV2wN86Byd4QYlgXsaWL+ue0E",
-	477:  "p2pQPO5NsPheTQNwgM3Z5kZdlpEmpS8xCK0I6+MSTeA",
-	2435: "o1L5WoYSapm8nsQ2dOsu9/bw0uuST8yV/W/C7re86Pk",
-	2739: "F5S/I11a82K+7yhnSUU4BBctZv3RP8IzI/dD7dbA94Y",
-	825:  "yUicG1nPjqt9n3LXDWdUow9MZVzEPTi0VQjY/zvjIKY",
-	503:  "7hneGm0voxQYmxbYv5gZ9gHbzsxb31yF/RBsIFEyWLs",
-	3623: "7jpTe3aOY0Xw9Qc5zMrMnikc2XLrQWbCbAw7qH/tqcw",
-	2916: "YnhpvteFKXhGJOrDQKNjvz+cuQZnhe1rEEGtqJ2OHlQ",
-	3016: "srr4QNGVOHoBYs9yb5K5B3iNJ10Wcc9cr3B4bh/XTBw",
-	336:  "GQKaUnHNzEU7cKFvckqge105pAhFuvDP3N/eMbOB3Ng",
-	798:  "Vye3aZcbbc2ZVSKeOeLVrPbdp2g/ulpqlzF/zPJ3Nmc",
-	2275: "oph2wSppYAQ9m0gCXr9eTfzzYQ/BndVmfT7TPR8kDOU",
-	932:  "4wESlNflbWxpdBvmTpoXdhlTzDCfZSpLtkgaIbIQhI4",
-	3058: "ZVLZKA84G9L1jgWrCF4m6IkwHe2RdaDcmaFcR5Elmos",
-	2214: "72wmmvtAw3cYUUOqYiBvGYENd6nqBKD++Vf9XBlX0iw",
-	1106: "FH9bk34oWawFvSwxB6GJdbVOdrcD6pom4l7E0zCEe5A",
-	52:   "8fQpycNHsBeogw0jaMVAqZ1uJrJF7+iQI0LUNaPlheY",
-	850:  "SdBOinr8QMt5RBDrEIbC9xPDGVVf7bLH6Bv2/HG+tkw",
-	95:   "OEN/+i6Pw6FyFc4weKnKQ5BbHhnPXfL4EyKI9i+EKNc",
-	442:  "b+q9Pt+KVZ7XBK7G67ZLhKjr1mZm/MLsgzE7xhnP3Zs",
-	3576: "70bXgbF7b6ibzNknfKUpt2LUGsXnGNH2GZLbDovmr/8",
-	2805: "pJ7rkBcdAVmgBAuVLU//9PELL5+9R5tYgQTbuPVZf6w",
-	3409: "U9WUX/kz7KEsT1SsEEtkHlisbC77P1uVz1Xay4tQQuE",
-	1696: "X+fMkGGu/tcT4oJO7lkyTtScrLqEIlUWvGxx5/9lTdg",
-	541:  "7xs/AxcFt+9paLRzMaP5Mc2pxXzZfDFn4l/E9/UwEIs",
-	2427: "uMfFezyPvHL5JhDKu99Jc/6PTz4ejCHHYhUsSsVYsCY",
-	1941: "nG4ENqkM+C8eRhHx8E7hl+MtfRrKYLAhZE/XyLSqtAk",
-	1027: "JWSa8gYgX01HpaFCUgY7T/i3+/jWNLfE9pGtCXHywK0",
-	3028: "pXKOSg6p58Zm9P6PJnHCFuAXWGY/1bFNbUCeeYGJjow",
-	3773: "+75FSjvcqRl5hhE37Fl/ckU77rBSUpeWQ0GUdUVuDwA",
-	3479: "lBqkRgdevkuFO12Fhgrs116hHp1L5Ms2NIKAArkZtNo",
-	740:  "1eln9X+AT2apN2qdxPkxasepzALVMhzdOqBwiHNDDCg",
-	141:  "/FbwKzi+JFNoglfTslFAvAnMA8ggM/xTnuUJrQ0S0g8",
-	1272: "6GNFnpvM70YYXHn0M0o1C3cyic4SndWDlncHaRj96Vc",
-	2261: "tzMXhnAZa6mjTrvQW+TnrW0Jnw3cs6KUkDdFS5wMENM",
-	2523: "uR5/MNktIhGTNg3N2YhawkDneADVtUwQrtgwsPvUkrE",
-	3155: "DgA+EZITOw27IeymWZyTYgrGPUB+w1ouwAVHr9noZ+Q",
-	3304: "6Po7/etjrz0uDq5bhW/2/7KXo2f9UuvuZ4gMxyr/usA",
-	159:  "axthrm/g3UqisdLJWi1WG5hVP5We3ZDBCeDDlB2j6Vc",
-	547:  "wZyo/G/lgGjzwdBbxY+zwH1JPsqbvrZt/LqEeyapTmI",
-	2048: "p2NUjkNCkJXoqAjdlaOIHzQHDcrdYBJXdD+EgUpBdwc",
-	2252: "6bTfOlX3Mh1qwQwXMMbWKkA6H5A97dHac3Hw9f56l10",
-	2576: "1znKWzuHUoZqaqcnX1yF/8mC2Lr3BXD/oTp6osHWVpA",
-	1669: "RkawvOml258yvF94PcJZ6LlpcyzaDMGwca/HnvVITSs",
-	450:  "4reMeD84hTNHxktsMtpE+WSgPVU/w3I+u0L3Zdq3Ce4",
-	1500: "Yq06BL3BIt5jZM4BDQhp9tuzhWdgSmYf3sgR6qaoLfY",
-	77:   "PC6cFU1Fv07u8lQs3U+G3Cl52wLHh0lWI3zvHRIaBEc",
-	980:  "QVTAa7FIvLwhre4cInDvAF8hTAutE8VvOzNzywNYCvk",
-	2242: "ZzJc64xqktqsZ0LblID47NGd4vpjJ7GKp1ZyINf1myQ",
-	3591: "GWOyqknbIYFSHuDBND5ZGpoSkZJfSEos4mHITqp12DM",
-	1210: "GZCzAMP6lmhEVxhxsK3Z7BxkmJKrJGRvy17UgxjxJrg",
-	673:  "w9g7OYGpJCqTyhkMAVbhMsVlKtgg+mJwUF2veMnl/3s",
-	1766: "1Wtg0ZYxMqg0iPWiHoD2oZqjEAayDyl9cTSXD3EwbxM",
-	205:  "CcRPe7cnfs/DnKvRTKd66byNBtEGGkufuX1I9OR7TWo",
-	1038: "5mcHhdEpS2Y4xZ+4kBXmVMGZSEL1eK+gj7uZ98/VWM8",
-	45:   "Gx82mCKFvQrD+NX2zRC+zaHXc6pFDDjiJ+M2DMhp+tA",
-	2848: "QUc0KYqX79gNNUxEWxMBbuxLGsbkCU5zJBLp3gLSvgs",
-	3040: "ZOOA+0qMz59Fu6PgGwCS2PqNEDPCST0zVPqrVUiddyU",
-	1706: "5dhzmB5OyNtSzuyVhJuGDctK68ItmrRA6byWB8oSUjk",
-	1447: "TKffzuhA72E3rdk4cNeCa6kX+aN/MBtweuu0zwYbp24",
-	2845: "yY9nW434tQhO5H47/gi/wXNG+7AsUpP51DdbYVgKSv4",
-	3381: "d7BzmsnvE0LLpKG0mEH0mdVhK9z7GcENdUNee0100ik",
-	2383: "SJ1Ex8cKMey7y6AJxgfLdb6JFppyIWeuQF2nVmFJtGw",
-	2662: "QZBlLGxixiI4ehL2DdIgmUoSb8tDUe6BLImbqW9oeVU",
-	2559: "LEDSgTYCQoSWFBHqTvL46U89vMXg9OxB1dAvjuqa9NE",
-	1681: "W806J5abmxAVXeVXqCg5cvTKqGEkKLOg2rvlYq9b5Fw",
-	373:  "1fJmO99Xf1THb7oiNwWQh2uaUCPJFQcOzJmvJzBW2o8",
-	3424: "+nz/X6TK2+zjJEykD13rkpjym7DIPaUPkAZMVUBHUPg",
-	1513: "MKE5bbpY/7xg2WfofJ5CLHEZsd2+6xQb3mzjjIsi8Y8",
-	1089: "vu0+t7w+NgZRURETmhyDsL494ZQNSWzp/pC6MTjJ+Jc",
-	925:  "zaWxxFKe5tR/9Fb4ztob7ZFJanBZWc3d7YPf32v1GpQ",
-	1701: "IfCx94HV62uu3kGr0qWwdMwNvI1FROZXvFxVVUBkU1I",
-	1913: "e7oEadXsxlYDWtIiklIx2B7e1mVnExSwSgXKGcrWHtQ",
-	2589: "9EJVUfaRppGTcj1Q9QUN+s5OyGuoSO9jGzGyF1iS0bk",
-	1387: "oIrWzsKxEllgPh+OKM4X6kvB4wSZ2joE5HIHuDIzttA",
-	2585: "JnCk9ADP2zlxHnxtxxpi6oCjF+Q59omWyy8zTh+g5L0",
-	3106: "B7bHuJgm3/of3H0VkSzNl+HRVhNSMu2EuiWWfyYl2P8",
-	3233: "/w8v3yMGaK4O5X/qYZW/jXNSOsC60/e/1pktAL/Nqgc",
-	2781: "ZjbaqRYO10LM9qiXL/ooKCVbuj92E1+9L8XbdI95dY4",
-	2380: "tfuZA/kDLK4mSKBIIYXQ6BWVE7xaDegF9rkmrVe9fQs",
-	707:  "k/ZbYmWKPRGAClr91Hj/wOq8mHj661n3b8+DsXglWjs",
-	76:   "0k8G6zu6ypxSQKk+cM+JojHe28njsT9kNBwWOPUJEjk",
-	1822: "u+we8zTiZlsNmcco2p94Nwz2f1p+oFNSY+zdigqujr4",
-	1231: "wWPqumcPvQrYuKbfxI8mS6pU3WZlhYu7nmNQxwMqloQ",
-	1357: "xmv2+y6TD3joVnKidwLCMkZKDvnYgW9n6ZeaJg12mvc",
-	1986: "y3jyE6oOZob7hBzAyWex2du/qhfusM6sqmHs+0UyUS8",
-	2775: "Oi5plGerPXuJhy5taI5wdNbsrrdcXgrX7BMbu9dNPEY",
-	1674: "AWWx+kNLZU5eCshKM2zkntORbOSKqLUDiWLqM/Fkysc",
-	2789: "Pgfko6b4xH1XKCF/5jBfb6d2QtDX/7bXg1V8qJJVE1Q",
-	1645: "FHBsYtccsxPkAC0P7HlBMWGo/jVpNHKuYxchJGZlkow",
-	74:   "VbJH4UJtV2j9lbp+7Qjq/KzU6OlIOJI+Wyq5/+QR0ms",
-	3540: "2hvlh3azLScwcKtpfx4M6nJ+sKMtsOS6N49GUFB24RA",
-	3118: "l5aTmSmAzSk2FP3F0Nu+rghrmzKJeK6ol0khpZXxGR8",
-	390:  "69z2/B6LZT41rHiPnV6jjKfP90Yx2q/G0svvVeMPCwM",
-	2070: "nEbaZcqll946DMqWDOAtcUTQ/t1g87McVkSzmcz+zr4",
-	859:  "pACm/8DWXflckYlaUX171J1h5Pijt3BSpdj/1UxXZRQ",
-	936:  "jJ7ZZ93BBaxxI1vdk/n4LQnYnUPJ87YoSkhAsnR1+fc",
-	2018: "e2ULi65g7UEhT17iiVvH/DRi24edbFp8bcxWHz73v/4",
-	3904: "eVsQpP9rr1VHnaldPTIkBoNn6gyWAB1zRAHOs35y5eI",
-	1185: "0jrtqZetHrFWryuVaGl0GTxiln5mXuV+C4ekErlj44Q",
-	1371: "yh/Z5TVvWGWaqInS59A27S8BKAm/aSX0T/9s9S0egNs",
-	3478: "vrynqOz4uu5lD9oz2keJb19E+bh5zIzwl8AMHkhBiHA",
-	2659: "qlXfmKSDBay+aFctT6Rt4YlZHwDvigprLIFKpFVVhBM",
-	3687: "bqpe/8Bt6RotW5XShvkeUT6yZRRoMpMKJk9LQV5+lkc",
-	632:  "wXQaGkv8xFTXk31wZjLa9fVJouv0L82pHfuPQNF/r44",
-	1146: "YZTiaORtZQbjAWlQjzEKUOARfVM+PHzs4k11d9SfblU",
-	630:  "nhmjEEpS6Le3I4XtKoAm6SusKJnIjGp3C/f5m6ijnwQ",
-	1157: "j4eerex89fJJ5VEvFy5DayFknSYzTbxjN2OJZBjsIvs",
-	881:  "dxVDuMT7bogGd1dkytmPyxUwTndkZWeIKGrJfIRj2ig",
-	612:  "0DiRj0J3IHgmH087xPwdI02qPkSKo4NZZeelPcrfAlU",
-	2212: "mvNAWLM3tBZEyVQaoSzKFEj70m8pOxa0F/QXQpD/APY",
-	1394: "rnl8K89+2vgXSGCvkchyxAGMM49zX8cK10sCLFUsgkQ",
-	3070: "Dyo09ctDui/5ZyP4NfZ1xsk6qVSqVX2pVVoXBfFIk0I",
-	810:  "w3UScA5h+DdCDuwkiZkN0b4qeOQcFEzx4gK0W5skyMk",
-	1820: "G7sGyYgGFciN2o7K1p8g3R6c+PrskUsrQf0MlbwMJpM",
-	1599: "wg30zrdhu7cmLXwqqSn41chOsN02jOF9FiB8mdRa9GA",
-	2537: "drhDAeA/Exa9Okwjyq/bnfAGxEUocWDq4lIvxEOkArc",
-	1256: "51TXrCjJB3noN/9vivpTu9sIUcrIIH90vkOogiYBA7Y",
-	522:  "F+3Tq2atSRQXU6xtXx/Le7QBHbOX19YU+PaPFrhDIDA",
-	2962: "NUJMRz3nVGX7T6jqFYrg80PEFb9Ypz9qnihDEfns0jE",
-	3244: "rXa4EKFiGKFTbE8RitY6s4GtMr763wHKxvy00YIa/CI",
-	3266: "l04N0a37OBkKY+jQ/liTSjYe2M8PPdYSNDygxBu6pL4",
-	1199: "GUs1v/yifpn3ynFzC3d7UINT8pJtUUqXrXlErjFLrLI",
-	3874: "MLpm7wINiubAxyy7rBgphGoFOeSjo0VnrIxuzQ41zW8",
-	1257: "ZO0f/sMh+LZ5X1pR0uIs3OOkobH7BUjdKOGUKH0z5Qs",
-	2047: "7EBkPCOPisMKtNmrAHbWoRNFeaEDAjLesVkGO2CYUMg",
-	993:  "Sce1BoeE125SiAvMpcfJlQ4qNPO0WBevmgr2G/vZFLI",
-	3963: "G9GtYuYyn5DVkfls6LfkHm8I9B9BVYizuZ59izw00jI",
-	565:  "i3QOJ3mZ3xQeuj8Uy8O//DmSofMfB+FJlrfPdF0uQXo",
-	221:  "l8ATWHwvk18Ivwf8irIw0r+jw3W463cM1XDPxghbG8o",
-	36:   "8fjhkYE7nKkTRKlNutb+faj73PcLgF/vTXJlDKR6q+w",
-	2865: "h1y7AVX0EbyHwxndhF9Ett4nNv58qCpjAYElLe5sXss",
-	911:  "vQ6fJ5Yj+4Pd2w+U/rGVeEfSXLbnIorHuI3oDiKc7K8",
-	1581: "HWUm0rWkWO7uqsjp5CWzRHfJ/Yrlg9kfCrXz8eHuzdE",
-	285:  "aXkODNC/Z5D9SEATL92McROlBnF5QSxIBI8kDCDknLk",
-	49:   "a2nf5dsm4RcA8rFmMhrSZu6mdCXTzaAHRHLxzzNIS5o",
-	1851: "RxoDDArGwr6j5t8/6avtWtlLRern4H7UKgVVYZrs/YM",
-	2547: "/1a+3wEGVBzkFEDWMbl5/NmwMsIRpmLMAGhmktqoDA4",
-	2213: "W+2CVauMtm/TwOuTFYe3jczqK4sxuLE0x+H3HtGI1yU",
-	773:  "Sg8IxVjfbI/ZFfnJ5xUZzB0dp8isy1xaG8RTPmnfQew",
-	2888: "hrI3fGUs4BtW09kN/eYSR4hSPQHeLSQcSDL5t2Eb6vk",
-	1040: "ESKwyoqZSiO/UEdEy7dz7luOcdy1O4RcdY+sbUfVbLY",
-	1046: "7fBj9sTzFb+LMOPDw4ehPEFyt7NTu+7r6cKGDPS0Pc8",
-	2370: "Hw9Ac9CTQ/GElW6yxADW5eJ9euc+pWPH8yfggKogo2w",
-	3661: "q0IB3fdvFe3no48FohQIj4PcmPE6n4jc2FD0jx0kDy8",
-	3226: "Jo9MywHAMvnKrJSXufbzSDUEYev3/3D/+95lwVQbBDI",
-	880:  "RdpOHlployzYtgMvWSB4mH4lUTGe7dfyc6QK7K2dDeM",
-	914:  "cYND1Ox6d202wwNuym4IRgqCarIsoOV5t+vh5NrW770",
-	1140: "YX+pEBUAMySVPynMY14lmxLloLWohI0T6CJDXqIQ2Vw",
-	2633: "OVu2NR50q2AHTiMnhp4mqnnjkDA/OqwIdSf/T/2PzZc",
-	3547: "w2xwxw3VEYFX1Aj4x0XlwCvPHe/Fr7MKmrxJjt8NXZY",
-	417:  "thKdWt1/2heb/L7zvgCtlWaH1YcYwOKrIwjDEcUbgac",
-	900:  "r2RH6UfttehQH5k5epPVWnPez9/yCgw6WzjnVpEPMoM",
-	3956: "q4h2Nkn8p10JKZ9NhkxOcpJF5lPELCcDrIxvGPeeJ8M",
-	3894: "lFY3/hxNGWC1Q2NCJjqNVseZxzvxnM8Co/4oHay9R3s",
-	1881: "MVGvrZdWZrRFZt7M75G6kUNUays3114Dec1otBUQ25w",
-	1309: "zS/K3VCxEBccrOJ/u279l2Q0Htba796et54YMwthiYk",
-	3310: "36G6oJ4GSWbE9eJZahKxW+DM4ESte70mp2nvGxLSYuc",
-	275:  "IYhq50Ssn9qGdXqeTlMUuoW1I+Gz7sHsJtBRPFlnsuU",
-	2683: "/yrqgCsv/h8ITh11VJpimZqfIOAAd/BuzUwKAUF6984",
-	1423: "TB8NaV/k6hN61VMMNq2fR/akKKD3R3JcHIRDwRxnAE4",
-	3401: "0meOYpJGh705+FHCOBd5asoo9PQ+5KLtmDRWABZoKSI",
-	2379: "k/cPEb8qynMD4uca7YpWy2F8ZvxzXxVvHI/2Yti74jw",
-	946:  "sGwX83y38bY6pTAgn5Ni/NXxyiCc8QLnlrP3EvdOSdU",
-	3856: "m7pEvhF4GG6cOrTe+x+1r+1g69bkkV1ao7AHLC7Kk48",
-	322:  "5e8ZFnktaAOroknCFxhpDs/f9u5GIkNXZ0HtJuowKzc",
-	3753: "1YsiMYLpWiGSeC1WGj/gvC9/Frr2iv/4fspUs6ovwYw",
-	2401: "eUiFZGcUNc5CgDKTmFM1eMr200/csyNcM873L9UyPlE",
-	2159: "7Lni/mKlw28KSUijMbC66/Q12bwq67xLEIfxJri/Xxo",
-	2085: "9SPFXYnPAan3RVOgMkSM++tYv5WNFihP7SnCPjx+KMw",
-	3512: "uGyoY0ZW02ipLyKJoQOLlzJ4Flza8L42m1YtQ13zln8",
-	2058: "4T8PkHeTqQ08l0idEU47o+FIRjDQbVnrdk9q7ITWmuc",
-	2300: "XIqFAaC/Govp3E3Ic9Q9fQqL/OaZunGrk0XaPIxrWp4",
-	1042: "IMhECjsIlf8SkluEYVZVVnPznqGEZ4PnujqP+8VjELA",
-	1179: "qi6ZJYIy7A4e/3v5rPoFT/lsTKJ9b2qLM614WJMDprw",
-	1939: "9jmuSkJWjYevfFGmHSi9opvs3dfV1LyIeGQUH39Fcg4",
-	2080: "wyi2y50H9WOzchShkQB66ie+pqxt1KSlkgfNjsMpYwA",
-	2944: "ysrSQ4pER1Xr05LE1TsCBR34hA68+9Csf2K6RjDuE4k",
-	1756: "+QldhhFausAwsZW81CNIY2dlxm4YmX4/sPbU/5pj378",
-	21:   "S9zl+k62TBDAtYUUB08cVXtzlTMYk4RltjeJyONXTRQ",
-	3319: "JDAv5xqVltz8JU4xS5cu8je3oCxUMW9IwH9Igkew0TI",
-	2145: "oWtxfbBlQvUX3UBp17m4PZBvYZkKJbPedL4/flQEAHw",
-	1166: "iDbFLmRf1aP7NOl8lF2TpbWWmHpYxRhQygM0lh4WpZk",
-	2599: "D98daEjwrnqToH1/qLldphZsvXLZ7a3Kck9T/tOkmOM",
-	273:  "rD8+FPpYE1SwwgDMYtS57We3URwKctBCf+cSADElH80",
-	1612: "33hO/2MyDYk74y5vDpX0+QopwX6swbRASmrZ3/SH0/s",
-	3810: "v2V5VFTFav6tPtxoSZWjjaQQeb7qWPsiJDbW6zLC1jg",
-	50:   "44WgrQd5o/+nDFnYsAxNvnD1j3sOhmcdlpTNCRZgpNI",
-	360:  "HC6UN+7r30l6V/inBbzHVmDyNxPqcIj3xGuK4JzAfZs",
-	3895: "seYRzZWHWQPSaMtHnujwROG1m0wWDW1OZ9w0n9watoI",
-	2342: "8SA7rJpGvAwPtqRaTwV1D5ksKoAvTIoolPpJZBSJnjg",
-	2733: "NvMiP4q67c69dflh05Upt1wGmvu+9SiRgEVy4hXk5+c",
-	2604: "lzbbrqGPPLF7yYdMj1u4m58N7Wbnuky2alu00hpuFPE",
-	3360: "PxD1hV+W0RSNPEVTqYZOqJ8Gz7krXKekTUI9q4mxPEw",
-	2826: "KCx8SyIuUVaCizsuTGkp3ZwmFNNr9qai02ZJBe28ARs",
-	1153: "AFdrYZbDlfwf+/wvbRSgR3wrhar3Wt6ArF/34lO7ArI",
-	1469: "QghcGNY6O6pmtyWIbIJt7Dq0DAttll0AJtYUUP+VOY0",
-	3998: "rYKSqNhGcUvzQ9smiLBMoaCIQg9hvjUHvHXNWMQIHuw",
-	1871: "F6wqWwvXN2iKVMocGn3zeyZQG7rtpPYWQduMHgjNvA8",
-	1536: "2aD1BeST/sCOoU/HUu2zWKP8Wp2JOqbvRaEts1q7r4Y",
-	1553: "z64/E4HDugDxhybfP7UMVoYpP2gmunOdNvq3tvu8l7g",
-	3365: "2tGtHmcUzK9tOlAiHsxMiUgJwoKShZLxOd7scerMIWY",
-	1862: "tXIbHDgr5aWQ+27XW3u937n3rtIgRpE0NWTqen+3BsE",
-	121:  "e0uuTTgjj05FjTLNzgtU09mq3Uq87D+idhx9P2M+KgA",
-	3685: "8iwKVfBduqxD8CXq+B2iJA4YexEEZDw/VlSxTn0olqE",
-	2758: "Zgi2lyWNcvvS+wNg7rqveUXyrdNtOGw7GqDoDPwZTfU",
-	1904: "p6ZvWAnh6Z0m7nxpGEvetFgeB9fQPg9CE8jg7xzST/w",
-	2685: "VPtgj5d3YhCdjf4+41BUEAAhyKS7RKnW3OhNOTFlA/g",
-	563:  "qu3bT32xnh2GJGgqiIer8zkDru7QgMpUNw4CICii9r0",
-	1059: "Pd3jELgq7jy32u6DDiHAPqBKmfVWEIP5R4A0Sx0x7f0",
-	2590: "AQATQQqtGOVE04D3wC7qGWxyRkgHJKawTdULvSZab/0",
-	334:  "urTLJFXa5KXdi+rsZse97NHGdEh1PQovQciAHhD7+BU",
-	1676: "4GRpDM8layyYeY0wWvhtmOadGxqGlYwJrQaVSJjXooY",
-	3582: "IfUuQFW9iNHo2G4zksmQphZHHJyB+dgKV3VjXvieGKo",
-	1173: "9M/7NrpQf9JGzWjv/G1GzbZ+UFZkz3u6N/PwPevFG4Y",
-	1382: "wkWvJAgLF8rc8+833L/3w5eDveQXifP30foUJpOBd/g",
-	1935: "BGki3CarXJyhD7K/bugp6GNIxv03OHKLFfwVdieUowM",
-	2880: "8DKNewksz3V+072vqFYiYDpPXXLvbHveZp3eLCF0FCY",
-	2540: "TqheIElYmOYgS0r6vITVWpWNhLAoufXzKfbi8cMiG9w",
-	2033: "mVnDMw1dZSIW8/52scCVVQqWAFtLi6LSpSmEJncbXxc",
-	2397: "0usav63JD+iLoUoyip8Yn5vGkro4wBiY307MoSYplZY",
-	3860: "Okn/XpDRRG8cW5QmB8UuM9ITjjknG1Oq365llCTJdQ4",
-	1379: "MOGlZEpyQ/+JWAivVKd0+QT1ByNp8Jm7p80U5UAd5T4",
-	399:  "AYzeCvDURgGsz7H2rdSaoP3749B5pKV1VDhALtJ5uvs",
-	2003: "fjxTUijmDG9TKGjkFY9KIZRVuhL9l0gdaWjqIGLlX3Y",
-	2952: "sicXXGvWfFtGVGdyj/Bd3lY9PNJqvQY1NQ4d1zAsVyw",
-	1826: "LUag+p5DFjwMQCeR/Dq9A5bS6PCTZ2OqMul1j8dLvwk",
-	1354: "nM7cgVLAauChsSC7Q2+N6HlcIuPvyoUENqshdizSmik",
-	2298: "JumX/i9f1aU1V/8T0S4Oe4y8YXDj7WAgEUeIUkN6yQE",
-	846:  "J7bl4JF3n3t00J02NPiRSatZpnuqCAKabd4sdgljbt4",
-	2619: "Rcc+UdZJoBQUtGxnqkQq/FuvuOHCjIKljYhLBPOir3Y",
-	110:  "apcbesW4tYjpVlkH6lp3CsZdvRdZzm8AYh7O9rvmyL8",
-	2569: "IihcQ1Sa0WR7MKp0SaSLm/A+6IDPV2qov2fjynPQydw",
-	2802: "CgXbE0GhXgRob/kpiOcRN5shtMz8B+2+5ota91US8nI",
-	3495: "7cpQpJJNNxffkazYUtB7rvZv//fJI6iadiwEJeF1lPw",
-	3330: "PyRR3JccMOyZ6vHByYICvnxxuEMzA/D9t6LFDpzJFcY",
-	1306: "N11pW1+hiiZtmj8gCrvc+OJFafBpJzpFRuBPcnRDrPA",
-	3457: "42Gui9SVre1MjQyKlGcWK0vtTOC+xgA86PscIl9PjV0",
-	1693: "k70pDG2M9ukiHPvzfw1aG3+zbBNd1QOFF+qau4r5Y0g",
-	217:  "fHbkCU9xn1Uo7c5X9Az+NoVL/qkY8tClkyRszpoDzO4",
-	432:  "ccYkd1pwXuO89NhAxol8eiHQYARa5DMVz+TfyPfe+pM",
-	714:  "DgUXWmv0nkOOzBJrxBTOh7sApfdoj/PmsfCO7HNtJ2U",
-	143:  "P7KUmwXhDzqnr2BrrSlwZoXnEGri6Ar+ZH4yb0Llutk",
-	30:   "q66ExeHLR4Xr9yDixnSEG/gtR3deduvevP3M/JA2ZgQ",
-	2103: "HSw9UFr9W9pVurlOY+vR2TWq9DEyVUuAi27WL1+Z53E",
-	896:  "NRabuyu8qKxw6aA/32WZGuMdDkb8MAyE8gaKWV1yVAo",
-	2501: "82wDH6YovTmRyJ1zdWPgeSd6sXyZ586gHdhKsfgecjo",
-	2907: "gCfumx5Ezp5Zqq1EqdChcmKZX19phPot/5Q/EUIvaSo",
-	3662: "6uM6VYsNO90DzqdT8fz7h53hU3ZSSSEu9BpsWkaT9Ug",
-	3113: "lDEElxXsT5QCA+jGoQfwfnl1dw2IYCr9zjKxWCkjXZg",
-	904:  "cSX8Ksn98qZh1CQatkFHFtE3X+Ah7bQ6ORRMJXdMkQk",
-	1099: "MUd/CCcRGx/wgMqVRgYXExBun3WmlIIAWf53xoPRHvw",
-	767:  "ATGUE8gMmlYLj2iFN89FwQ8L1sxy7OMEE04wcwekEbU",
-	1276: "N5BtiNLakzWgcoMf1zK/t7/dYWC+3oQLpj+6Q7bzbH0",
-	3511: "Tub3fOVfF1VbzhVsE62OGPI/qsS3CgE1OFMdvDSDL0Q",
-	1538: "qED4bWf2l9o72u6tXNq3VGxuqZV3qK+lpkgUuQkePl4",
-	2839: "GfIF49XdNpVUU7P1j6hhP/kHJrYU3s4Vjd/n4WkgVfM",
-	2102: "qBYBooUQD4uj9kVEb0tyVWPfToQcZ/yk7nXlKPFQFAE",
-	3910: "xFZzv6HTSJkUhrP5YGUk5nPy+Iqnb2QcX7PZp1kMApg",
-	393:  "Z41qRG058jbuoO3Kye3qklEZzk9cLqDhdeU3dkyTrVc",
-	3456: "q0DZ42+EzNE6Z+UWSpm6f4a/N3yo3+10UV/PetIkAUU",
-	3473: "wFN6yrAOtokY2AxPLVHY5sGyJ0TXIpJOUiF58BsCTyk",
-	163:  "wxDLHBNLx2ciEivpzeogTKrl7Y/aCaxfxVXr4HA78uE",
-	3919: "9M6b0Xc6aBGXZ0HL5+lryVM5vaD+hoPUA2ANeZf6Urw",
-	453:  "fUQ3b6/9sVGtByYlaErgEygcWjzdzksipqXEIWmzXlM",
-	389:  "PbueN1+81Syo+Gt6BeUFG8IWyHayQVq3EzY2ZJlOMqA",
-	3001: "nlN29o7uSOnsWfQ0jZBUGmAfa6xIN5qqzMWpC/Ozc6w",
-	1739: "m+l7wb4By+7E1cR+FazcdAh7l7yAx2tps62jHxZR1YE",
-	2966: "pYP2nzSdQILsZZ50BSGcju36RWBNDS8FtgVYa0Rctl0",
-	3938: "24LI82tE6ioz7BatIQmoJr1Y6fDpMh1FjrdqhhKAT8I",
-	3110: "KqNpuliOzA2zWQBtq3bOg29MtUlb00CK6wIPJ5UgYOU",
-	2368: "0MjOeXfYUxH739sSzPdp/2Gd1BIpmPUXhCA99FXrQio",
-	3181: "OuRh/iJfAly2ZRXFJA7FTHd3fGteY/oiowF5eFYhsO4",
-	2706: "uwUs90fTvNyohP5u+REIGah3UtTssXGYuUb5DfOTHT8",
-	2686: "S/I5TPskUly4THF6KAEl165OzHcn2yrZXS/WtCOBx7o",
-	4000: "qF5KCskE8nkywZ3o4sQOgvyvtySQZ2akh/MxO4EOBZE",
-	3303: "mhtEOGj3awXdRZ3D5ffxEGA6RUh3/Hyva4H8cECwYYs",
-	1667: "t0d8ntc5I6Z1wGCvWgEaIvejsaLKc59jvCIc1WkRAeQ",
-	386:  "f0uVHJ4hUiUR06IIJt4hkgKXTUMGmTrEEHPg7MoaWfE",
-	1510: "MD46v2llvcy8o2IE0nc/SU8KHavkTfTCOPytbNFpYeU",
-	3821: "z83Kzt5KCmf9vVprhfZNDGlMCQi8zxeIYqJoI+pK7H0",
-	982:  "CZ91W9ST5/r1c6VRmK9RV5BpAVPd/T3U5Fc63X9TBI4",
-	2591: "vexoht+B/8o5VKrgMc2mwATDMxrNNtwDbYJKFOB2Xf0",
-	2038: "4I5YhYNYiNQ/y6Q/1e5NcsE56GU37Lz0pA80+hIEuj4",
-	2016: "P+FzzDIEQU6CAnhu1zzXGM5lncgxm/hTcpMTtBfvFWc",
-	3800: "1YSymUy1UnOTuwpUoaVGAmD3Ithga4rsbYEIX15r5J0",
-	2997: "iJy4ywknCoh8kIr044KAAqO4BP/Up/HhjLS2LAdC/Y4",
-	2492: "wVxsnXvyVLuBmBqbJt3k4qvA5ErDyPnpPk8AgiWBoGU",
-	2180: "BLq4wCQrDwht0+KaxSdt48WK+G2rYvpe99s9STOTQnE",
-	3144: "gcuC3m/kIDKdC5mmNVtQdfpjyfseelMwUbaEVeIygp0",
-	2734: "uOxsVCIuW3sYzow/1RN9BoIrVuudBsxDZgz4IlQI4Gg",
-	2778: "wdSo+aGTQf+rSqhhc6Xwh4v8QPVXNCCA3IJosTPd2M4",
-	1013: "6gA0849AYk7EnTXtCquhocltt5MgJJkRjEYISLybr7c",
-	971:  "Ef375Sgmklk/w4CWc3DnErnyBPVua4ad2lqUQzPkUCM",
-	1751: "xpSYh8io/1t377O4+Kzm2leMntfI8U9yCV3ZAjnBDtU",
-	3805: "tCnZvRTSL/mTQLxuYdib2swHcapVEJgvWocmYfUdnVQ",
-	1995: "MLK8kaBEG7q6QFKcnhjTAlV2gn+Nxpm9HZMybc8aSec",
-	1892: "SfABwD5Ww/YQ8C1BZPVXllln+jfPa2EvNyO5BC0uBLs",
-	3389: "h0U9zXslbLoab6tua3LOhcE0oF5sT68ErH4ohe8Liig",
-	1665: "lN49IDWGmVYVmCz00YQYGHuf+VCdVnBEdux2bM/M/uI",
-	1698: "NDxm3FI3UkuzYGWep2PpUbZCZe5M30AfoQZOpbwH2Gc",
-	2133: "BI5XkME+S/NXOfaw/IDCYJuQEqhVmzquRfMWwZPKKZY",
-	2043: "RGk8S3sIN5Qs/qZgbeN1duHqKkGlz7thzyt7S/1veGA",
-	618:  "VhBEu4BehONwDoFgs8CpQ1mDSP4vYJcvY/9i/TaG04E",
-	647:  "hRgspJGSl6AH7bPbNEREqIqrfFHOcyhdbHokOKU4o9c",
-	2855: "Eh7fmtMFqewmGJlc2iJpM6nADeQE+CG+yG39b/1OeJk",
-	2607: "m87xjyWEu1m1meP5cgFer0qGaGedyKCpCGB1Tgm+a0o",
-	3342: "WUFcOsz+9QhLQ9lUagIRcpbYWaNPU7ItltEGZzQ41Ik",
-	3327: "ud8fHbVQ6zRRYfDQ9EqeucGg0EDQfFJPbIz92MX0kHc",
-	1747: "K/alVCBT11Shw/Du9+eZ6O/8f0C6CrPf31hV2I+8toM",
-	3302: "CRHjG2N8a8mKY0nqljUik0N0BDDWdSy1FFBEWh8jAK4",
-	2274: "5I3PjzGPEGPVbma5Rlabwbe9Cr++o8nZK/6Ly4/VHUI",
-	1900: "t0Qgc+vn9BFfCgBWX+yIAnmIy7df2/J4zS6Bo/pwR2U",
-	1564: "ZEAn/a/Gc7g9XvBL1AYkXItW2qOctKSKS9GsKi9JfNQ",
-	1171: "XzOpF/FBPx1zs4YtiY2GTqibTEWdfLx+k+9jzEb6sSk",
-	514:  "yZjb1cQfgBB+vWbCF2dAWA7BLX/wZ7sCvxdEORKu1b0",
-	3255: "wILI3mPKbaXzeVD2bbTPm1P3jAEvuGmmul86s8fhyYs",
-	1720: "tCsMmGGgzyf9CoC/Z4hcMwsL0REPlE56jw4ATa9OLdo",
-	640:  "LP0Szy0Yf7MYpOARFV6oJ1iL/aemx+y+RkmGAgXAj+s",
-	2760: "WdythFtCVX1qk5uwlfl8HJTtS8BgDas8lR05FX2JvGs",
-	3474: "icQwQ1RZvr1OMqFo/bY50L8q26coepih7A6a2tv7FnA",
-	3964: "44Cc35JVuM1HyNvlZGk5z10EkMU6Xc2STcbL+DCBBnU",
-	2521: "vKLRjLycqywXalsZSN/wR6yhEoxnkimo77oUWOQRbB0",
-	3025: "71Y1B3R+JEXf7vfRTPtJiMwV4j6Q+EpcYjg7G4eLQ9E",
-	3579: "vPkxu+pBxhOcAzgM3ByZRh7vDjV204KOOiPkm2nnCDM",
-	2736: "QqyM0Vr3AVc+m3sSHKeV/8ka88Yb/u5PE/vXEDwuPl0",
-	113:  "PN7BV2yD7rLkuhEoFMkkmRjuj5DX2okmFHtknXO5Nl4",
-	171:  "3JqRs7vuyDGZDnBfVtHgk6n2HpSp32AxFttKwrOd9g4",
-	2025: "2KX/p51fuhV2b+orxFHGXbsBQN6KHoP5EwrINBD0M+A",
-	994:  "V9p1TeSBZhglvaTSo7NzEsYXdezSVr6lTAlKxg3nTSU",
-	495:  "gLDanGA0yN96WJNDhcVGJ/G6JlUNMT6e+oBU1rlH6/g",
-	795:  "Cegrw8E3Jp7r+dd8AGHxzL3PfN0katWv+r665llUTC8",
-	1872: "E+pvhkSLNP/Zh+TzH2I1N7mXBtIRyapS3i4CWbSBSb8",
-	2541: "a1je+rw1JgOX1cT7um9mW9ffmrR0za/sbH8yWPo7Yp8",
-	1023: "t0/ghCTuxhXR+HNlyQlzHcB3UagAMN5lsWwD66lZi9Y",
-	3920: "YzYxAAjlZkLmedCNZOS67x4AL1JHPbNhL7bCI/4LyF0",
-	3236: "eTfTmX7TM6F8mz9TCX/9+UvkY9qLbIXvv4Yrcf3mCuI",
-	3030: "7YyiqRY26DH1nA9AeCoIecemt/yXcotNFbP1JrcIGX8",
-	3431: "FyIdxg+uksX/IXHse6uuMTwXsU4zXfIN41Qlj/B4tT8",
-	3521: "vQS2/P4WGUP0jQ3FVZGH108s+2+g6GwqI6Q+RhAshVo",
-	2169: "M/YrkQ7Ze1Gg5f5vDZVCe0v5M4QoFKBocTh565IAlds",
-	3864: "6uQeBzwMmNo1zXzLmBJ6ofSaXbXhKAMXb1b951UcV74",
-	3187: "gjz7iFw6MhhOT4bLKPWFNLHotlsm9sB1Pi8O6KMCuig",
-	3082: "8rF/0iBGayZJt4nDtEL0HwpL3B+TawDEQ0bAFxCipfg",
-	2386: "fn50LI6scjdupHnhbJ4vW6gKHxKmOvJfFgNnxNGWFD8",
-	3629: "RgMiM31u51A42uyKOomxKouGvYbpRjnC8CHHmXsrTY0",
-	3131: "1cPVqKAi0D7FpxbJoW7lSnqr2heluGOF4CJc6q+IF8Q",
-	2436: "TmfhCieoCXJixtI4d44Yp7kUkhRg9ZfdF+FUlzTacbU",
-	3630: "k1ZGrNs5PR1NEftfjfmkanjhqCXGoIDVJAuwGsEg0w0",
-	774:  "EFLM+ysJ9bPlq8avjcDWaEEA1FcJdnbVxeA/00l/3rA",
-	670:  "QdEsJq7iRhOEbO6NyAB10OOsnUnt0mj0mWqhoLW3BtE",
-	3434: "eqsdsJ3aGYoGI1yTuYzfMt2nLQgKMz6rXnVtv7JFhaE",
-	3336: "eo5aQ95vBHAv7xHDgJTdJChCO6WFH+e+prOuq5lsPRA",
-	3055: "FHVawDmsXvyiNk/TA3iMoHtWPdUwjeqd2pVw2CdsV9Y",
-	2502: "OKd+Gs53MNKtErouaN+IjYaw2vYRqwUCuwJtiQmZMss",
-	1966: "9haJzWCP8GQmyfCUPZHnUgEupVT0TU7BseWoJu8kouM",
-	3637: "TSxcWUYjgVTN6TBEUpC19VfsgZRLQktPU+NP9+hx4ZA",
-	3142: "NLyo8lX1eIblkKy5LNCuGHU+9A04zarLBerjuRacDpE",
-	2546: "k1rLLFAV5RLUEe0nZL9d8rKKKehwHyivrLpL+HEVdNI",
-	3102: "snggTO9PAJ30m3BX9q5SgI0ITA5QgqZAnshCyY23rIs",
-	898:  "pqrP19kpHjxoTOtAF4MXBBTXqSYwVZdfV8yWLhJrjqk",
-	520:  "MRFXHwfoBCsTckMaVgLvOQ3A+LZlc1bf4sSKNsw+4RY",
-	1979: "FE5b7q5xUqAsv0KnDlLwGhebBSrbZj7DEHTsqvQ0q38",
-	1942: "Uq0zbSd+zl4QKQtV3t2gzTBnWQspm1vqd+B2OM+ENDM",
-	1453: "0VxBnVhajF6xMwxyfn3lpFrzGLhl5WuTa+ORS1GR6ak",
-	746:  "WqekW8V52hgACM8tKMcFcMoElBapxZXaMlBZXMt0LOA",
-	157:  "ge35DOlVyW/scF60X9PAHV0z7ySZbHho2viaydnSjR4",
-	3433: "IGfAzbfhxJmPkgsQMmM4O4Z0edyy49wR/gsHhQVOph4",
-	748:  "yq42SUIWjG49WKD7P6fqOoH65dwE9eE6Bl3L5X8ZdsU",
-	178:  "RNIO9FVkwZ8jq1zGQd/g1vHuRTX2+7zQIn9Qt8olqIs",
-	1083: "RXEq8LlN6uA6qtaFeIcTNiKEWe/QJXMpi0nc0veRxkA",
-	332:  "wtH2AXhq4bHM6Y8a6CH91HanlNnqsNyt9cRfmE9nJRI",
-	2917: "wHaiuagCPptDqUsHEs5kop8yXf4lQ5bLeLV/sWK4yKw",
-	2140: "MG1Uoc3rFgbnq4sVw69+lEn1A7HyvnhHiDi/nhZui7c",
-	2450: "Dd6U72uKgKTw4KSWgw9cznw6ViqowZWIcpXpJov/l38",
-	1263: "TFEP25pOtAFOHjvN0WS/9xbrfd7DikPEWXQpNP19vng",
-	839:  "MWJG4nZVFUyxrYDcilyxsBLEh+zmgUpx+ZYIVqMbEVo",
-	3814: "rCxvw/UkXmNFBiOt6MVSyYpViuhb3vCnnHzKVvGZNxo",
-	511:  "XB+XAmnYLsXUvkULwmPKCeFgu6o3Bf69JtWQV1TdC18",
-	2434: "JrbjyEVzw2NKBJIn6fBCSRAQuwTZbbo4i3qmH1V36W8",
-	1775: "bs0YjzmmjGv+7D4hnnwn6YqunEChCT824evcmcl+p/c",
-	3858: "0rL4+reue5KS3H9Jm1Vl675xc2jN6hlv/zRk9MR3HMc",
-	29:   "c0sQ8V5A/yHSKv5EPKRxBUnMcaNUOKRLcjSMJrj3yNE",
-	2258: "FbcHoi+JBdmYLlgGMbbTJjmLy00PwoLMZIhxcwq5knI",
-	1653: "w7oFveoHmFHdSxM74VIXKJtZCJlRouXvRKNReihzdHo",
-	2398: "CVMu/VrO7W+D+5mGsvTiDL8MLHT0Qfmwtz67CwFo+28",
-	3655: "NTaaHY8k3zv9Ui+9abmluQn19ExGLyCZ91nqJrcIGjc",
-	451:  "FtTUH6suDyjWYc2+eYguz2SO0j2pQUVsJgSGU9clflQ",
-	297:  "1R0gQJGa/y+mWm08tuAKeSQdh7F8Ht0fyi9pKVLn2rE",
-	3440: "6i6nBGiImwZ8+bPi3aL40qtStCycMP5nTNLszY85tkU",
-	1552: "ksTKd1pdvQMEiL9ZfR72hOQBUuSLAz6AZb4sLfVPqsE",
-	3978: "DsZ97a8Ne8C1PgX4BJdwkp6uSiK+avKWSUkAMo0vgfU",
-	3261: "3HIV4ffOL3mocYK5PjIa9hBgQ8cDMyNwpdB+Ht5d9rY",
-	345:  "TDvJrEcMZZUTb3hgG7kO+OaS9LbCuOtXW6zbeCgyhUg",
-	3752: "7U9FAe9y6EwnU6Uw1l278Obe8n6kTfyXFTcjgJ91vlA",
-	619:  "wG3HUDD7O1ha7jQ6sXNOe2SebvJTlc40r9ZRe4Y+4to",
-	299:  "vwj+j9Tk4zHEFDnajjoc5TitPRPIgOgn2b0HV4YpW/s",
-	3709: "+gK67SQW15QXkQ3h89U0VXxYWsVKKZnq7iuUMmX6tbU",
-	3286: "mL07czD9+xH837/YPaN4vNdSIHIFP0OqopKafvdXeX0",
-	2351: "M+x6349RCSq0nySDqpzXum6fpzASe/8YA+HiHemZ9yM",
-	2430: "TumL9zuIPvLLhsx9LJJUda3I5qQxe2zkfrRSWpNByrQ",
-	809:  "LzAzjfvfSWubn2SXfnNso4xcCBdw+mCSMR6m0JuyxLU",
-	631:  "bpvcQjtT5Oup0FpAirrdWgmQu/4i2sTMaf4nL5xmFSE",
-	404:  "V7PnPeKdtbGkC11gCcmyp76WF0CN/rQhl8l3OUCC4Io",
-	1353: "qLyCoV1Iw5nFvsqHr3V8Uh8wuH4ZWVRy9D3TwD0ud3o",
-	3134: "QKDAFit9EdO2rRyWvKyHOFFxwo8QkD/cVwGcwsd7Upw",
-	3673: "l4xGe2PuNdUkwAlQ6i2IZ3jfTVLAIE2xhlEb+wzuGWI",
-	1208: "BqyPdH3vurJJVIJFBGYGexj+tA8QPKukY6zt331hmHI",
-	131:  "EMmAHgfUVe+M3jn3XqDsb7YiayNK2B2wwmtt86amelI",
-	747:  "H/ByqSX1iJnL3UaYjjJb8P/VoJgo/DTcnC/rpikuU6g",
-	1483: "M1ZRVEQHT3Cw+9/YzfrZOCtCJLrkraCz5zSWwWEjSI8",
-	2951: "aUCbbUL/U7QA1NZQtz55RkkKd0AnNNzXezDbPVsZYJ0",
-	3839: "q8wyBBNPtfP6JJBHMlcu9GorcMOcJjqtYrIRA3UFoMY",
-	802:  "/8iSF1EIlolDHmBsUVjwdoJt1U7D6tYqsM1yCczXfd0",
-	760:  "kAGdWxtTizfFyDCFM+TGSlMJwoCurdO7V9dzfIyvZ1Q",
-	1704: "1RqwHJq65M/GfLh5KR4V5HB6W1iOfwEc/K4t9sjHBlE",
-	2255: "cBy5Mv2VlaE65TUYKb+/ci6nO68nf87APLJo+NLoZqE",
-	3612: "S4TzPPimrj2Hm5A1sWRzOprt3q/XmCmig1McoheCs2w",
-	885:  "MIgx37hIzy4AGteugvJ6YiOvAeTYGwrX3eg9CxBhONg",
-	1687: "Q2A76WhiqCbiKf1Y55FULuw1C64uBXKCMfZhc+EeFXU",
-	1524: "qNA/5slsO+TxP89vI/tB6oqha/xWm/6jVFbLopxc24g",
-	11:   "pa+ISxPESBMk2GxAWHOeEKcOIi8/GdcFZa50iwnn9hs",
-	3598: "xEJxNYiu/kSQEgJUlyH5TtkuQbpGFl/TZ1ffTHMJID0",
-	2971: "U0GLN2bgAAntxgCDZ7NzcVk8qioMrYCXZ49gyQjrXnA",
-	1429: "J7+yTaKGvqzcTc+rGjPLrDiWHIiGBot84B/KCTS1pBk",
-	849:  "aShJiIM1luTobEDljPtdp7AyGOh6AkhHIqVFwkz2lco",
-	2600: "Y0i8UQMz4HSLVTdmkZi3OzwZYFZnfe8MIpdVp7yEBB0",
-	3238: "6EwTZPzvXvspHD0aiVtHFIo1GMGf5GLMl2H/EGEzvDI",
-	248:  "D8NQWDjssQx+V2Zxx4M5l/6UBh0/7HHrt7gnQNsOglU",
-	1424: "ZkeNW3nmp3tI1MRCtaCwC5NehwemN1LFQS4V6aTeGTs",
-	1647: "MQcgKJ1jb2nRpqZGZS+LSxidHO7QAEckn/X9Mcj4tKM",
-	3491: "CUQd3KChS20wr3ySVrmp34NtMvrxSNC6ETFbPw10aks",
-	2780: "cGLV1uuVVFHwzxj6mOUoQH0HriJy4mhKMkzuTmlLOCY",
-	2712: "/jBLReT4f9nKKfnVdQemL+z6SB0SmTy1ypyFs02J6m0",
-	2281: "gUU5DwwK887UhXo4AoBeGdBRfQiXxPGTcDp/Dk3Ug2c",
-	3287: "o8WqBor9FsXvqzVw4i64t4S/9qS8Ip5b8ED/LuSFIT4",
-	3369: "bjFssSL82+tnyGEh+JupXYl0YWgMrgsA805NJu0n/+4",
-	3307: "Msd3fhexR2X7zIyxR5FzF10cQaKjxPL2fbqyhgQvidU",
-	1169: "236Lgfh7UBS/A2dD7kPtvddGHQDVzcsgVZwpFsX7UmI",
-	2873: "jXhlzjC6vZNUXLgHziFDYtoSWDZ20hQ6EG6ueYcMxaM",
-	2905: "V4SarbzjnkkXiOHCCE+NiXgNQ+eHtN1boFWiaz0uj5A",
-	2438: "98PmU/6mUeGNwpeuEJw8aTImYeqehleI4uxAFMfQPt4",
-	3341: "vNU2wnNCGbZZfgPlBeobcXIrAjDASpyi/djdAxpY6Lc",
-	3877: "J4aLuc+mIJdAhkiVS6ZbxLRQvQZ8b6PGl+gdkGMSgm0",
-	794:  "CtLijHtozQA/fytbKpiuZlqzmNFkYqyikunjagttdaM",
-	3566: "aixWf4UlL2eGyWEnYra47hkuAFkQjv4cMWAszlppR80",
-	1391: "kko8VpKB1bs1MM8YAhKem9jTBVLkAe7DeqfALcOqRBQ",
-	58:   "4pxohU8f0t5u1M2y7Bl+1VpLT2KYB8eFe5fWUioRyCY",
-	2635: "HbLdfquBtZkT6h47Aty+uQMFmwc9/EyOXQSNkM/Rg0g",
-	3354: "b0qhGDGAFM9KhQT8V8NF/XqHIL2i8PeoIEnRR46fVYs",
-	1692: "TBRD6bzWc2lpHVvgK1+2UEUFZWGnj5hgvlG63TdsDh0",
-	1015: "8RWU/LjEO3rkOCvWXppumDzROzbo6PKBh3EaxW/F/yI",
-	2040: "xlFBMkO3Wi3x99GuGXCbjoMpidQW+yCVCXegxSIMXrk",
-	3487: "5DHAka6D4wIxiJxG4TW6ZLwBi9qEGKrXo5FK6sto1/k",
-	504:  "Kp5eBX9O3fTRh4Hd9N3KN7PkRkf6cepk5M1juBlazBc",
-	2904: "3cyvy6jLvkNtEumrkX/2qErsOt73O9n/x1HAG6OahCA",
-	195:  "ejFAt+UzEuKGI/pLyM+XmbulbGE0GY3UdTB/rJu9V40",
-	2863: "IuRyLIhc8c7BPcLXmMEzDHRm3Ze/g0SbTd5bvi01pMM",
-	2533: "Qm7Ef0MJdcd+UPGi5nhfFGRlV5nIp9gi73EOBbdy9nI",
-	521:  "/JhQYWz7b0DcIozCipLQA2XeHmNKTZIX/z+MiBuZp/M",
-	3153: "n2SetcY5AOe5qToQfowWtiMfy5cfD36Reh7p/zheN38",
-	471:  "E7CpEUrshipc/hYdWa9ntZJUbB2Wf6nAntwFYxTUpTA",
-	151:  "p27W1SlXQYexql4AzON2/Z2X7XRFG4t/ONyDusq9zlM",
-	2308: "hs8C9ScuNVq880X8rdcoBBXk989976pVs2F2Fyerp7o",
-	3516: "ffFKpsx1flfu9Qt1GH1JjsmRDenU9MEr4ExiRiZIQ0o",
-	16:   "/Zg9HL4NQx7kMwP27FKZ1Yici4ng7LcKB0K5rJbHUyo",
-	236:  "wA1hks0NbZcE9b1siLJAcNaOEut1BVwspYaRt6GawlI",
-	3464: "7lOMLf6G0MlxfcquyDUIxHuAFluA+Og4B0TIbrW6Zf0",
-	933:  "wKtYzCBKZT5BAELtg7Qr8H6wUSJAUAKOqnhnlFDnQZA",
-	739:  "h6IYEzRgB9KRdGJsOvo+zDKaiJ3ZW3mng/LWd2iuWRE",
-	1300: "A8QGL/Ne0aOPx4ZQXfJ6OieCR83fkfBmuCtDmwrsZsU",
-	3609: "J9xJ3QTZDRDNCwAJlXcimQG3HNO9ye6U5fpAqp10g8Y",
-	462:  "Jxt4pSpKcc7hs7oS0BwmhrXjT9ZYvwweq4J6K8mIV5w",
-	1290: "nZYsslVLS4YvHtscORCO7KHjeC/OQo/P6L9bPxDS0GY",
-	3059: "aaTvrvDw7R5JUwgWfgGAMCStELxnfgNNeIERa4pl/7M",
-	3505: "YUH7z6Uo7H4Llsv+2J3dTz5lQNlhA3Q2GLIN4zeFHy4",
-	2878: "c+deZHpy9FkkihZoO97PCuxBdpdsDh9TLPa0ReyWc6k",
-	2809: "B0I+XruSyZY32DcgsbKOC5sZRFmftdwI7KJnNyLWUeU",
-	1494: "JZ8dx+eSlEUS0ARp/IfZMaaSKI/WQ26tr59vTw9L8hM",
-	837:  "3yJrtpa6WZ9vtZpnRabw6GcCRr5fQSeQgOdDsLzzL7c",
-	1787: "kD0DyLLyRHLr5fc3l/g28cJWGO5JpI7DkjZh4r3ccko",
-	3731: "r2IL+j7SC5i2ZdHmBNkm/GNVYXO3kMxwTpx0GQlpF8w",
-	325:  "Tz0Rz+ts6pqx25e2AuQoFDl6VIRwTtLOW9l/oblWP9I",
-	685:  "Ufkiibvfw+whrH3516jtC9MjUXOxsAhJQ54AKhuZKD0",
-	2895: "OuiWhkBoVyDCnB2J5k97NQhnXuHZ4z2OGNZCd6TXm4A",
-	2918: "Kg7afCOEaSqfHn4uls4IeXXeLL011QMCvBt/x8eBdQY",
-	2551: "OPtzy2ZVOoymfkOzbdQGC1tQ8VPjpiS5RLbuyDwFEoo",
-	2561: "nAaADQW1tyws9Ya3Ncln2yxf3VOn8AwXSHN+hvq1WeM",
-	3674: "qEHjcxZdU9IDrlNsjnW+s6Re7NPCLEwJc8HZK2j8dgI",
-	2752: "SCNEYRm3cOCoSKgkIzdG5y4kbhxcIWWzztYv1H6v6u0",
-	3477: "mE3ENVuZ8yEc8S/19m9KZEa8mUkrKAHw6gzxz3/EEc4",
-	2822: "t3cuCqgEb1+c9vbgknD6XYQ0LsZXHnRYnkisTS4/KwQ",
-	708:  "HxJt+ex9oxcumvQ0kOvRZvEysP72Vyn0rohr+07sEb4",
-	3247: "yVGaVVxcyYxqQwVFJQt+sSAQTE9pobw7rdbnaVnHT14",
-	1366: "ZYumRaI6+5tbCBhiSadBwM6ghiZkEWWrHTELFczXu4E",
-	1503: "zmJ1J3rVpy7MRB+WcX8vglIelKIsE4WQDkoW+lmqykY",
-	1613: "77VxSGTAsLyqyyTNTPZyhgzI3SkoUq6hWtpaqb5P7ws",
-	436:  "R6DXw/wyX14KSLdwk8E28ZsnCrS5a4HU47O2KLEqjbs",
-	2411: "7mKv3p89fnNGwXkPWOYw/ruaOUfUxvbfhMOMH5IsxOE",
-	3273: "Ki7yD09YYRbOyo8iYdDf49aTFN5/NOFlf3LmB4v8gW4",
-	3345: "jODb6me8s/hAo2zCNTv3+TjH9CGNMU1wjOe5JPpYlN4",
-	473:  "LyKSNMVrrtF3DdAg32hl4tuip0EJIyXkq4j3xfy4lY0",
-	804:  "p/8hMnNl69tTWifknF1xpfp47Kz94+MkctnsOaKlu+w",
-	3344: "/Wrz/XUVP/53GWiLjxV/J4wxBaaeP452Z45OUsr15+E",
-	1418: "oPe/FKSZVcvGd2PsoZgGMDMwEAoyQ+EwCKSjeDo2QiA",
-	3775: "8+0yzi8MzF8C3o3cn/K8ZKcFdun+IvE7CPSyX74qohE",
-	1743: "m/6EFT/U6fxo8wnxhun263H9O8nFOQI8aiCEBOX9M1k",
-	3197: "KWf6XT2Z8lGuaKCYU366FnagnaTe0Xkc4vBsU2XejKs",
-	3635: "jsmRokq3zfbfZQfWUv5uNg9CuSdt8ro3WMV6tTsZDFg",
-	2354: "OR98shVtohmbj4nGAogZLBFPzo9bIXsSYWS6ffJNArA",
-	2234: "H+8Bfe3bYsIEbloMyUK3O2/vEg7oTMafEkI2U1C9A9Y",
-	2595: "PgpxESVq7+YUoYsVSoYu01B+7iLWCfQz+Wf07v9u9gU",
-	3900: "m0TOLWUmIQvS982IJWq+Nwpm55+BuhRm96KC+7xQVFE",
-	395:  "L9J9NSPB6c1DJDpnHhxbIpLcOUBS2aBh7xMqMhS1aPM",
-	184:  "ePdEPxUFBDOnDWYYKObEE97kZvMxeciq9XQYfFlAVNE",
-	2049: "ro3rYwyyPw5dk0kCRgxlNwREM9ZT4rxr3Ez0esxsBq0",
-	3481: "XSAXip5Ci0AYf6OZqeNjHAZKytDzuWHWFZ8e9K+DY5o",
-	2206: "f0tsWsSHRf/EYk8hMAhpDwt27J4iezVK6hKAXhM4y4M",
-	3588: "6ZzLWAP4qRQjn1OTjCYm9fbG1HkMPXkZKCqmlGXKdu4",
-	2606: "27way4t0qbeW3RwzJnKZOPXzGJ1G6FP/p7XOw+QpA2Q",
-	20:   "2oZcr2E/xAA6yAsDB5MIJtscTt35XSRyb7JZKq5Nr4M",
-	347:  "XLd0Qy/MAa8mnPIGi9u/iUonanQouRakXCrFKzcMnPI",
-	441:  "0ROGsQLr9XxEUIrnx7AtsfzaAsprdz5LZ0E59AFYT/s",
-	1115: "aqpgLBv6MbpIM82bzZd71Vb2NcKUvh4x5y0C9l7Qnjc",
-	1101: "eCQKdd14Mt2sXXO1Ju/2ShCCC4r8lbfxQdprZvgMmfY",
-	1846: "WqvWEDSxj4Mm0z+Yppqe7FIMaA70RP+EJC1LpLGzeK8",
-	1893: "D7idwtKtq+xHIhonvxL1sVacemtis7tAgKt4aQhFuvQ",
-	89:   "mpqw4YpCbLdy9hHh4oTX7rKz14tyP9eH8xaJIpnDIfo",
-	1182: "6TkAaQkmyw/X3wi1qJWtwwP2lDITBnZ74OsoBYETvgk",
-	3734: "xPqRQExqquQqUGCFv+Lp0lj8tblwgMb+LrYEJd6welo",
-	960:  "K/UHvtYr3xG9yPGYBPQLIs5DwzDcewtCt2KBcgqZ2Yw",
-	518:  "clow/Z0hZrGIwfoemxYImd3Mj4bphjt56lxA4lIwwWM",
-	478:  "h7lKk6Cb1HXAhNj79NpoAvNjdH9CAZEG2pwCoMez3EM",
-	2788: "5FOIO0ihwrlY2Qiycad8L2uCfiP1KGFPIXsDTp4O++Q",
-	2442: "mi0HAfAUPTWx0dexJSyvGMjCornIGwoGgU4cn6rSg3U",
-	1212: "EgTK2hJtCHyS8TAgIt+YtOp7y+jkyovRjUefd+soq/E",
-	3701: "SmKQRZDPY3ZWgwGQmvxj0k7CADlZBlGRPY1hNPRHcLA",
-	945:  "9EFKIrY857TKWVWWkSI+vu7Ix3xF160tMHKIKIhfdeU",
-	2060: "ds7/FMf1f0/9HZL6JtCPC27invWhpozrBU5avdmYGpk",
-	2536: "HFa1CQoVdccysErVXEc3gkMmQkPJnPO0uGmZqCswNbg",
-	3954: "Ej6D+M1ZS4A3k3Sl4alQojmiUm4JHAutEF1O1uIiQ5Q",
-	2889: "bP0543Y95XzwXDVFR2OY9H8sQBMxdnrmqJNyiu8pNKo",
-	1755: "INTO4jCoosiGgSAv3pQZ9dEzfSyKR2/DrpsOSqdrSK0",
-	3704: "RZsba9MbtWipKwVD4fgM6XXEx177ldzObl1JN5soWXI",
-	1697: "wJGNpLoHRLGyl6FsdX2Jhp2YJFGROqvCPEtTs0MHHCw",
-	2128: "L85TKywUKQmJLIiXjrjlK5/52sn9hRGH7zUqkRbMEnw",
-	3111: "JSWoOgayXlIc1Uoom2XQmYT8oMRHYFmUL+kNelzqbkY",
-	1600: "WKiN9jP1krXKkZyc03tQpMWvgInEsVmLolooqTAhgB0",
-	684:  "mFWtM7wUFXJAGJckVhGk0tOb9euPOdjDK38KvzVNtWU",
-	1609: "O1ufkBfjLm8V3vpxx52lz+zJx75q0X+pw3hJ/7vRDJA",
-	352:  "N0Ux1M5zHaG2pkQUXU4cMQDVHZswzCLYxBiKtnLYLwU",
-	941:  "ARhoWeSxW6sR7fO6WO++s1bOe/2t8Q7Qe5BTbztlbu8",
-	2008: "gmCW1gOPvm0J7SAAdRb67d6F5Z8aw+rXYvRNerIP7KA",
-	3613: "/7Ufw9GmaW8PtJg99x/0E9yn8xOZqm47JKRyCcxeCD0",
-	1205: "dq9i8egNcAFJCT6o/HSpJlrQcOoUm4jqlIj/QyVzxmc",
-	1116: "iCRkBMfCdkWt0IM+cYefB0W9bf67nDYWYOdDqPAUHbk",
-	693:  "RRCXpD/2HKXDy3yc5RTojp0jC8yP86VdvhFbnth5m3k",
-	1078: "/ZONomjS66DXwg5gZ1uuXzQJcWXwtP4+9TyBnWL3oeQ",
-	3863: "SJChrzGESREgw4PLJCPqPnuzBx3kBbHMRghqVQB4X4A",
-	3550: "ifQOaumRb8DG7bYA1nP/e0sRZzNULbiR0MVCO3xceus",
-	2356: "sWiPaOx20cv1hz5dProklqUUPCJ3cPezpo4AvPjr9aQ",
-	3350: "KgeUe5e9cd4tsISbYjbwbultjPcWnEWIrwCrtZ/n6D4",
-	1764: "42GRWs6L7D+zAXFnPQc+KybsTu5fJaBcu1i5WL5sKX4",
-	1380: "KyHcmBZMQ3a6bl2Wf7uOfuZ3z4aX3oXU6G+uRbgJj9Q",
-	789:  "RYFkAnnpt/Zyq0dEKAObH+urhoSBleLe0BmQqwlnL3E",
-	2872: "13X+LHzBWiK6iqisPK89bxtyVbuQVVatswLSL2O/Xl0",
-	1487: "qQVkndKfVezTjetX58zdycBkqjA26qPQpWlC6SVyYBI",
-	3554: "dWy6R5Rw8dDC2dTAnpGaFSYMwuPktT786B9pNi7Nt3w",
-	2079: "mg7cJuGgmMcFh4jOlxLT9FK1d0baIZxOdTAnW3UmQ+M",
-	1075: "0g0T4IQsTtpjnrwqHfIpRpnUsUKmYAJ51LulUoGTc2g",
-	3180: "cHXa2RiUOaHyB+hhwWXWQT/kAqEt1pRw+Nk1gU/tnRw",
-	1406: "49jv+/8R1XJqBqU/zmsWmQ4cv4sgowO5D22l9bnLrxo",
-	3257: "yI993dD3AFh6Q97PS0ylfBDYrjGNLh/LDoNDmEwnLyk",
-	2991: "H4I3YsBcr8up4e+fHJsAFPsbYeL9nI3HDUn7h9i4Ebk",
-	2639: "o/MkkTLVqzZNLCfOOK7ApzRUgCyBnhWh5NSV6auvJzg",
-	3891: "Z7U15paIBDBTYWVJ1BAKx2M+CFzn5Qiz46y/HvZqZ3w",
-	128:  "PffK3Kh6YXjjUDgTLUCPufUt3bKr91Xla8tJaIRZCD0",
-	315:  "HwkSez1Iypd3EoVvR0khN5ShZL+Nk+cAbYMRCqmotxE",
-	1095: "pyh1ypitZxmeyrD4oLi/5x2/i57wq2UXV2WS1FMutjo",
-	2992: "qdTslXA5mkySKMA2XWljUmZ5eD310cTLBOBxHzAeEQg",
-	2091: "jmOw9QReL1kdWJgc+LEDCWc4ff5qFAY8HhnpRvEF/OQ",
-	1840: "5DI72Y+qttTqtE9FgEl984nXTXZ9fOvqyX6i1Lqtj6g",
-	2283: "imb4gbxbsOnQ/Rho98lX2+Y3K9VIaIpRqzoCsxkJqPc",
-	457:  "vEKx/ch/Jsi8BBhd8NA8RPjQ8+PiwcLZLixWomH83Nc",
-	1748: "Ew61oduMKlyItSZsY+GvKkc1kg4HfWuAQSV4Cw8D1SU",
-	3534: "833vKD/XuZRwR0WDHiJKIB5lSP4R3bZaxlIcAVkyDLs",
-	3408: "BUYM0/m8iRW7/RiLvfRb1PmSR3/El5lVZkJNw2DuCpA",
-	3311: "XI4K6rU6OgGmPI7zZI0gyauWFUDNOP/T4M3tuBAquBI",
-	888:  "bEG09RxgjDEl1xHg/5XTP3nHVVZsjHRTMyDzGIrNeMk",
-	3601: "tq1KfVyEmMkQklzIGhkZEdSrAmRZGC3xWlTbTrH0iZo",
-	2825: "1jMymT98gxcB5YSJbko8tFUzcizsZRjlAWUc5KMzoRs",
-	1277: "Ye0FzqP+4b23sy+XLPs0xRknEb+nW/29fTVERla4LVc",
-	534:  "uhn8cLKZ+wpWKvse3/yfyJuLEn66JnABEwlxQHGLkHY",
-	903:  "FRti/7cJAWpdGtPyv4rt3XlIHT0GTiCh+aoG9kreYtc",
-	1758: "ranHuegQImOVsEvUF72IU4aLwvnIMR9jw7w/WeFxhNc",
-	3699: "qY9vNN/zKraU2dxH1ih/BQlmzsQ3XbCckMRHM2wVmis",
-	954:  "UOLyacGEBvz2o8rStrFp1dXXAnZq7Vk5fyQXfyrUki8",
-	1001: "SALwUNg2AyiCbyUIxRITwS7fb0uu3U9rx1oBIEDLrRs",
-	2526: "rzkbMKaNefR0MhkVXYQuTwrdZCu923B6EnigUbunWRE",
-	899:  "sio1V3rHrxk4ATeEW9sSal4mojWZHGz9clQsEch8OOQ",
-	1416: "R4VbD42pnJOZM5CX0aDva2fXXPiFWmCRBEQhEfufgGw",
-	1141: "sYAvXRK/Apbx4PS+uYTTHtVBJ2jOIIZd9/rfq6dzk0E",
-	2416: "EcebXAQMHzOJzAxufYg8TjsLDPcQZ8ybdz3kvmHIZHk",
-	1434: "W9Ve6e7j/Ym2hBz1VuLZmgcoLgLN3CeCi19I/nBAIYc",
-	1924: "oe1oz/Q54x3UcF1GroMzYfBr8ThtDTRnjK6XyY0OZXs",
-	1262: "76qJftnlepf/1HducLq6bAHt5UOGrkK8mBdIFQYA9WA",
-	2696: "yPp9Gz8Jr9aSb6sYryJY4ywJuYb8+kLNnQGjXe0oAbs",
-	3664: "rz16yEVovI6zAP+sY6UgS/S7AR1y7mSo13cadcuIj0k",
-	94:   "rrzn7FwTjGA842QK0mmQTFvtgAy2LzIztMILwKAdusg",
-	2135: "Yn8/YHSYWiN1Yzr/EICpmh9f49OxNcD30jDSbXtM0fk",
-	1287: "+bkOC8Kb3z3C/sl6WToe2wd0GZYIChNx2b5IVBlnelQ",
-	1978: "jnGbA07o3G8OWToCRvMtd8HjnhF7lC8DBgUvzr0bct4",
-	1454: "tN3BelMtYsjN9ss0Ii9yyfhm6r8bTWWC+O2KOeZHhmY",
-	3098: "fZeXTSpUqYdskkbgm960ZAdh8yerOdPwviT0Q5JCOoE",
-	1996: "V3xak0tDbk+lYVuABVcc5wsKLZ3I+VAUFAtO/pPUSjo",
-	2324: "kSEiUJr3cVuasdXuF31h5AushAFgMKaSiQIdSPesbYM",
-	3972: "HGdFF6uifVrt+76Mqqf2/hVtNmR/uWlofCHmkruIuAs",
-	2353: "lUh2Ul1sk9dF6ctzZI2XfSdBUZEA8LyeFxdDfaYcCCY",
-	1304: "5ueXXR0PE3cfaxWXGSqFjGSW2hdLhAxf876aTQl+RQ8",
-	296:  "jA6SVxugMbiTLQC2/BmaF35kvqAaXELMGmebGtOyP3c",
-	2362: "y1W/m+d3RCCoVN8VAzGJipQCk2oOLtGD88a7jYOmMmY",
-	3328: "0vFAOG+WujHZwlCnhEy9/UzpeKFRHNX6JxJcvXfrDus",
-	1322: "VJuWCnskHPQ3/zz/527Uz5v+weDVPW49+VvRmMUYO/o",
-	2647: "Jbf64q5ymXOUN0/WI7gVm9kslnDH12b/XLc1MLVI+Ks",
-	3076: "LeqhXaLRkAJTm9NoyQ38kcdt29Vg8an3ZoIpr54Q8qo",
-	996:  "ifAqB+NFSWhzwDXT7o9ViB8vTur/vWvyab8KVrMmnxk",
-	1719: "CBl5aobYgLlaWs0TaxjllwiDcHhXnPEu3+gUXl41BsA",
-	1508: "Pq49OoE4K+266ZguXNLC31ovrBRmKAn9ROkP1eueTk4",
-	3791: "9x+IVJ6n0TnY9B3BaDvM2Q/8e7B5C3YPOkamyc6c174",
-	1713: "cGcAJJ0QQV5Ab3aI7V1TxTjOwfW4GaCq1Jt2jWWGgpg",
-	875:  "MVe+D4mGZyzTw1ryYY3IQi++/g29p8RDcxuFCg2vsnc",
-	209:  "sm40R3+RK0+JcBLU4gjhxhsQMbasZm3fMmfWLMr64ZM",
-	1122: "YgZDfy/ewWXOABTjwOZ2xzWP4g//Mv2MrX/WMyBXDB8",
-	2076: "/coFoqk4rL6VifffdHfDVDE4GsyefcFKXgFgFqZNMPY",
-	570:  "SjNYI+sNlU0kAyRL7z8gTOELj6/k2LDSXUplmW5TuX4",
-	1254: "SW2cpIIdy1kkhwmrh3Gz93517/GcIxb3ESpYvp4hWcM",
-	2488: "lZUoukYAhSgVLDFrBAUiDuCpASAJDz53VBzmJgGP54Q",
-	3515: "P/5nrGWYvsM08D1B7ll6gcf6TDw3Zi5PwO4tr6xYKOU",
-	1870: "tcERnfQCDjJfTo/QHPdbsbXdjVOz7v1W6vNBaHHGno0",
-	3033: "1BZ3ak2U1Dla23nnDIYc3KaNAqhU6JZqFNxoOumlx9o",
-	918:  "e2i4iwnc67JSG2r1eYHMKqnylK5ifqLQIEYYfE/czEw",
-	226:  "e1PHuduGpCMV/f6GdKMpoV2wlmwkpjFhYzJmX/n5CMw",
-	2109: "jJTtW1EQ6WpbRl3sSp3owp9hq/k9isIuHO9WKcSsXsE",
-	192:  "HY5lL63BG5vQGHB1HIv0fXvLCul8BCWphJIlNhNr6ac",
-	1650: "bvYDbH3DjlNo5FCLAobtitNmZ09yf1K3zIMt18jXiQ8",
-	2766: "MG1S8EribEoLPZCqrcRNlMtrESWyHigx71gT3+0cCuo",
-	3326: "5uhriBJNxWYJWLfaWoYwV4Awg8JIXaBGPqtiIpf2zSY",
-	2650: "idK7Lm0iL0fYXapyr+yilX/i1CVc9uuvok+OFrmFV1U",
-	806:  "0Ufe1KW77tOwvniuf/vD8xRy+4M/Ejx75diJivm+5Kw",
-	3066: "FBiYY/EScWlHIEJuiZXB4s6f0ZfrMMzx5rFN4FLQ4NY",
-	2259: "2sbB1VZx9xZebJcuHKMMF22ucSNXAL7zHL82d5/y1VY",
-	1403: "C3rRdR6yEdGJ40Xk/QvqLqYa7YTJ9vsYBlp7Olb1Pfw",
-	1831: "OZnVubJ2TuddboN3g/CXK5AvNsYw21tUMzo7jNi9QkI",
-	1575: "Hns8lzAFqXTkxiHAYflI7ellUvNSJilnFGrrOfHeoY0",
-	3624: "00PDi3wt8u8CAd8W+mVC42Qg6Lqssau7JVjX7YfWi9M",
-	559:  "ft7ey4bxWCSSqzLPviLu8rVncVIwKo94vD1ZjulK5Zs",
-	111:  "RffJ8ZbKPOvBgd9Wvlgbs2C5elGp66zSMw5gWVSx+rQ",
-	2748: "jqVDLJRtZr4fLpZFbD5pM4Qe37BsvMMXmW068+MxpSQ",
-	1848: "y8WvqKZNH4Ezv5DGYLi5oo/kPyTke1ZJnbvQhom8Fck",
-	62:   "HCHBxBfVyvvqdDXvGPeWk6wS/YpyRpj31WpqbKW7O/g",
-	1493: "kzdXzB/tzQ6fE5wBq5GElBfgKcZAOtk6V6jUEj1a8RM",
-	2586: "KgkhLuANaMhlbah9Bt8heMcwwklMtLlpiYqRzfvB9kE",
-	2045: "IS0F8Z5flPGKhbbfTC4mepx/+2qL2BbIfIVm+dfqu3c",
-	1457: "590QBFPfsTAw/lEkAYPqtxvyY8PA+fxh0q9YgKY+77M",
-	3460: "UWTCwLBN/i6A5ALvqmTNzkTMlnrCBTVhb5JHIz3RS44",
-	3776: "gHL2AXwddXJntmWU05W75PYNWjcDVLSAOOk4bq7JtIM",
-	3967: "dx3laSnjIpUYJZpg54pE43X1Mqdra8JnM8tNluZhU5g",
-	2912: "1YvCH7J4CRjTPVBtMTv1Zt9js7MRkZwdBPY5Bl66yOQ",
-	1495: "nWNi14Q5F50GaG9asEQ8ysg2tVTmeyjnF/XRJgM87Zo",
-	196:  "vFmd2+N9hlKLGmq7RVl2Ku+SCbDIxn6wJ16CYIfMo5c",
-	3053: "B9ygNHEYOCvgltioDxITE18rdi+nzsAMjpz3gKSaQ74",
-	3815: "F0tGnzCBKEmLxbV4toUjus+dlLk1Qc+kUSrFM/VucWc",
-	3710: "o2sXcqsI2RZY+Cld7B+cdOEZUlC62EPANM/8bGvS2d0",
-	2674: "AIGxTbh+Rch2e41EBh0aigNU8u0yPIGjD/psDzdUiAk",
-	2456: "vLOwlefI2gkFs43C+dhF0hqphmbLs5rCltZwIoYBL+o",
-	2545: "FGOoqYslYqVc3vTdh/F25NlgfCTc465HI3A5x2NjfpY",
-	1833: "PgiDHogaGWe68Q70EH2TbID8hCnQnmKgmUa5+DsGIFQ",
-	3323: "ux3hLXHTqf0L9HS2i7wKu4BUvJ8v+UhsKAeBOHRNZ6Q",
-	2965: "J6kss0OA5BCyDx7FsUgAtfO84zfZ62E8fpyV7S1ESM4",
-	3718: "oORRPb8ue07N1vmd0vRFT4u3a/dNZVZpYY0HD5QBe7M",
-	3019: "4h652DffjTpjLq3vTBJbQ6/DR+hz62TT0EcXoTM574U",
-	2990: "prIBjiY4x4xwUM+DsnIztfYL1KJbX434sH0+SsjIUhE",
-	249:  "sKjDQocd7EVTyxp40PShTDmY5BrcmowWawIx6a2gt0I",
-	3723: "hztpbnn0YaHIHWObvvr/ywrLcgqStAOIGO9zEMIo7eU",
-	3553: "eAy0KRNQIbUiNQ/7dKJZ5sI6DBNyx4igOZSmoKrkyMY",
-	2573: "NXQIZtIrmmvP+leKLOSIJ86CI/5hDkKgCkJpjEwh9P0",
-	983:  "ZnhRxEeyHgNaJCvGOzU2Gq1Y7M81fin/ILSsTjw0KSE",
-	3780: "o9/q8INfu04svamTEHGYwWuDmRCbYc6V3KoPgKiiBzY",
-	2066: "Zn9dtXX7KRKAxgtzKzCiHX5i0vu3l3UrfBpXVzLwB3k",
-	1969: "1i+Us4zCTw8qz2iRJJr9qQZsmXlhWj/kvYwuX5h546s",
-	1560: "eYoRdls7tgFVQZHhz9xDVAoFt2RGkFVBgppjdc27iM8",
-	2273: "RUzAnnQ3TIqdS/WF/M3SnoZB0MoWd6S721393W0Pg0A",
-	1127: "Ydj+xf5CvVwJhg1D7xCX/h4Lvkph+ujB6bkuH3aUEqI",
-	2867: "QR6r0i83hl8xh+EjaxhlX6bE1OZJVSUPyWYrPE7DsBo",
-	323:  "RlI91X28EDmyOJOhJTKuH8UUvbJkADnXwbOutUrh478",
-	2224: "PkNo09/MHPdqKYLY8TelSjQwtO9DC/PTyKz19JfTWfs",
-	3041: "P/5nZyfsWdf//p7nmA2LtDBRvUYJbGMJ0YwUJTPobE4",
-	978:  "lvrSQ7kePt0CwrPd1isphCwKUMTm5YjQI3lo0HnsbKc",
-	1219: "TpfOtOAnUCtKJLJRfJd+luym5S1vf461XTyUqtKiJHE",
-	41:   "1hzPdrFxC0tJUD6snCvcr/wd0q1ljyFY0RDlZNa/qMs",
-	2732: "1Cou1ElxtKD7E867HHV4P2Fux+EiTFzn4womZ0yVX88",
-	142:  "2HFq5w865nFvcxrEC7iMXuOBVsF/t/a/OYwPqWrVrwc",
-	3211: "dFO5CZrY2mIFl5UXNNRsl8LqCZA0Xt8zfXYwRZNpDZ0",
-	206:  "XR/rHQXTOElsFkvce5S/l7oEX1iAcOEoTPQ+hq0u0Ro",
-	2424: "UBTXYq+3Lj3vudVPDze15YQDQUnDIZN5hAZT4hdXhGg",
-	2834: "QZkCPtVsmJO13T8sqCH7XLoFY98v53Wk5tBMAsVyEKw",
-	2243: "M1I8zHt7cspxF8JbYi9IkHcZImC4jILkcBEseRrexMQ",
-	2730: "fLGdQbXcl7wzzVoaVrwDQF+F3CF5UYsXIUMNypjJJZU",
-	1570: "3g2JMl6FaoaKJ52V1kL7ryI3x3oO3bgWQD+o/PCeG14",
-	1792: "qBey2znk/p80fEWkJTSEGJ6eSpFs8NnFQGBWYNvdiIU",
-	628:  "cM6+LQYikz0Z4WGaHdv+em0zC+a3gdVoiRZq1OxAV+g",
-	2065: "58U54TId6ZBqhypJGMGpdPTI8Cj4cfjHfKtvrnN0lYc",
-	1348: "5HvH4tLGx8KHtxw6vNwwwdsp93cyUxB+Bp+CtRAUTbg",
-	2155: "fQ3jw5Y5N6FV/Fv2WsqXA5C25fK6C5FUIARNVbavpvo",
-	3962: "824DHVNV9bCk5TtO497DE119VaLG+PQUHKYooVAssvk",
-	3937: "kjaENrI0rEOiKwQiJeY92Omgr47BMYBMD0XXpAXurH4",
-	882:  "tNr0i22pFP+cLP29wqoD83OjHmYxqbLBi0ec8p5+C3A",
-	258:  "T9oBU4AaD3e3xQyC9nMhRt/fJNVmTOGGvTxAU26MDU4",
-	730:  "5YafnxITYokuWOYq+qLN4iqziOzBzKuD9n8JjP4ujSY",
-	3779: "oTaJx51S7OifVMLhIMKpfJH1xkj/7ItqZCZ+1k8TReo",
-	1200: "ui3T0W0+IH41T3PQqLUxY4TnL2nfbY/hRuvLNGRQG2I",
-	327:  "gvAlbYel/w9+J+HLP85fg3X80ZnN+DtJ/RqV/+gIcG8",
-	1589: "zv3gDGBwXcmojJF8zIBeWiNOwfzvCNM7gFuuldkQkNk",
-	1567: "nO3/ICurEwsj7S1crrcxr1RWN/eaiW2u5aCgaU6cQFI",
-	2837: "tfjVlTwtS1cpbrzRzQGnoLshbC3gC6VLIj3AOi3bhak",
-	1547: "i3eg4ajU5VwsnEb9/qn+6EnCxslJjO2qrBBm9YNuz5A",
-	2517: "6A3Ulx7Ty8SOapTlEqM8KTM1KEczOdmeCmx1cwzrdpQ",
-	97:   "yCa0AN9hU8DjF0iFca42kB1AaRlTnsaRnuwgS3xDdLw",
-	3224: "gZkdW7XwsvYEb2tMYAbVj4RTcm0qiU3QVMlRlSs5vZM",
-	2626: "6Zcrgu1w11Eh7HpaLEalSiMl24tq4ZtGouYrnqvbuDQ",
-	1718: "AwcD2GJtUFwJd222sgW9pPJ3pVSERBd/uiJYx8ImJNY",
-	1372: "na5rV4/dX/b7F/FILCs/fnutEbWpVIAy/2zj3mswLBs",
-	2479: "HgST97R0h9ldYoBoMOEd3warc4T19gDtJGJwHRBkgGU",
-	1960: "W6tJj2kIRypiFEl6lqUwyJi984hY1zJOEjnbYY2KxVo",
-	2408: "JJR6D5ySuCUEZIjnQOAUSX7UOutKyiKbTtbMPS71lUU",
-	1905: "5EF0jZ6ZlBiVTF38ux/M+XD4HpfSlajGP6e2ncHY/n8",
-	1585: "XALXQea2jC/p3nyI15cSSZPJ5DvzN5+g/ai30TbCHSk",
-	3461: "LlQtEWkg4TZ58zExYXvcWud7/aodMhtv8A7gemVBhiQ",
-	1683: "bHmJYg0lSImwTj5TImJbLa/rFAP1bbHH4GtJXkVDzGk",
-	1627: "veOnB024eG4g2GM2CroFsKlc5ABiBN7I+fyokXGuQMU",
-	2310: "FNXgGoZrUs8ZBldZzCfa3/IpW5YD7qhyUzMuPxj99lM",
-	330:  "/ak47trtAnvfrM1iJKPtBrCuuZFZA9/Y7GX1TkOo/W8",
-	590:  "Dv+CEkVMSZtS3vMByDoTNJzh8WvhLeplEZonxhg+Fb8",
-	2239: "GbD6n4LyhyCypDFM0ziSvb7Fv+/sgn846iyW526Kqlk",
-	1063: "1+2o6tJ50r3LmLUetH6QGtWBc2rhsPjH6OWfqm560cc",
-	3020: "ElcFjSX8ReAhGSMG9FWUujjagqvUw7+pfVNy2y9EeuM",
-	185:  "mGWrjIyidu5E6U8KK3AR/3AajCS22AA8AStNU8BiFd4",
-	3100: "RiFMxhk3PbrPt1G3U3TFy/JE5JddlbYuXP6ESJ/EKEg",
-	2794: "eo8xSpW8zKWLYq+5EGatxIdoXo+uNkclbIl2vyWIzHE",
-	877:  "R/+zcRtJRq0sHS4FKhwbpviru9ffvDVhOdATBTK+xJg",
-	3418: "8fcPl1d3kLcKQ3igYZ0m0nDh1RSpWxlV70bZZg6rCNs",
-	934:  "pT539NdwY5O7oexr2LBxw9zDxcIJM0YiicuEhzOkgdw",
-	1069: "DkQQQ1s7nzTcTtS2QwAg6iCwQNpWqmeioP9h/if9nVs",
-	459:  "z8v6avsXNgFYU7ssJw6OpEPYIO9VwwH4p92gWWf0BHs",
-	947:  "kgx17t0GSvsZI0S18oA5DoZBFSB6VAVA906gdOQGfpc",
-	1128: "2EvLCxJm8/4A4hJPMAT652W0bVSRhQ9i5OxKLVNJe5s",
-	2838: "bcBo78swYqTJpuhex1X6k+4IBfBaZvKk+ikouW4jRtg",
-	2949: "AR5j8/yfzj/IaFnOJFlXmfOkpPdS2NMRcEDTEzwlLf0",
-	2142: "3lFnFNxctbN5/IXCGsdRMlv9GaTCGgPow77wbWCDb1M",
-	1968: "gu0zWnOfbPELf8eeZXEqjyZE2mQu0ak/dWDigDuBCGg",
-	1596: "Mb5rG6jSVyk/bVU+g+lqFlui7O/pOatA+kyQWeuRMPk",
-	3340: "Qb8hstWQsRnzD6xPntFfr/tbUzPmSZyouPVZFi4b0sA",
-	2984: "CfleYphtY3g/ap3kkMEKg5e6C1KMlrpQzrmsAYkS/Zw",
-	3846: "zw5ADlHaU9KX91CHUzOVLqxSsQhbf3JbVB7wahhFyRc",
-	3209: "GDOgRtVJMxcIZA+XXVCN11WsCk3Sg+wF5bsDJ/0RjpU",
-	2816: "GYFHm7olxLSsL6ZQNpnm4r5hLJEfphGBDFvx09sviKs",
-	2350: "afRMDIrwDr3/PBsvZEzZaoOd6IxN91fEEdebjY0WieM",
-	938:  "l8v9I2PnOPR5UfqhsBngj4vqVneBjOmbHiZxHin29Kw",
-	1435: "LitiJrHWnnloXkpWFkehNdXV6CMCPIo+S2MWm5Zv/XM",
-	278:  "sbKnAou8cj8CX2v3lL4oIoQDOOA6jssX3VxMabxKDZQ",
-	2186: "vopzYDIuZsRu2q26ed08OcK2EO3cOdm9I5N2bBDwoLM",
-	85:   "0tvEzWyEAy+WPy5AaQFi3lH1xqa1UMou5GDv04kuxAo",
-	2327: "WG7tSh0HEy7/E8+HfO+H8RaQ07VUd0Yr52WwoaEokBg",
-	64:   "UvI8SOulUaUJnaT/JTkTX6cEylFDFGzOLW6bZGMH8Gs",
-	260:  "ifKVkMU9gVRJpECVU0GsFEYtebGmhiSIa20FObWwERs",
-	2237: "SMJzMUlXyiLWL5j5WtLpwO5NnDKwPcRvt3w4J+vr2Yw",
-	2172: "l24ttWIrSM7bEQ/fa0rElrdSq5eW9p6Hb7ZA8vZ9/Pk",
-	3382: "0YfOG1RnOZJyp+Xk1uemfHPYogcPxZVK2gvmnzMHFCk",
-	2671: "EyDD0S6xxOe4snHobDaxLlQFhIPNdb13F3QRx/SSQYs",
-	1734: "mpwP0t1jMM1obYTzCt2u63Iht7pJ1e3+IpD5BeMZ1vM",
-	2061: "ENAthxNshC0pCFeqp7BgOuFnb9T2mEL1JJ7q5pCot+8",
-	3941: "QIDN5lVvlvGDjlYmLYUoOSV8AKDmItNSOi/6hRYCE8M",
-	2978: "0k5+ZIBMwycdyW1sovogi4PQZ6DELPtm+nkF053/1SE",
-	3979: "Ui9xTHqzK4HlCGgPSzFwWmhLmJUEW4gtcP0dTCQ78n8",
-	560:  "6Stgk0ny/Leb1KW8hCWxSyZO8Xk7TydmfaiWo9cHTd0",
-	398:  "dJQFWKdoK5HEdyms57DRCNrNT8OS+/qeSZSSv51gPlo",
-	1062: "V/UuEb7MVkNkpyqd3eCPZFKD+nUC5zYIAZFnfCGFTV8",
-	1346: "+kdU97b0SLi8wPhuX6BqoHxEtmXCsbtt9QZS7Ja/h9c",
-	1187: "OJEaZZW0N4C2DMSTh15JyFebUZPbzERTDE6e7GmcXjI",
-	2846: "Vi/6R9qa02OWRyCLkSsB/klUvlhXL+b2vdiJmN2g6L4",
-	1110: "mUtU4uqrnnUQbeG98q+VHKFjVojeh9abFDi1p0HUpXw",
-	2608: "mZg8NrsOQHwf+nGEY4vHS7D4k8xgc3LU5ZQKF8Xjyd8",
-	3797: "w/h2+jY1iSIgcck2CH99tRV3zpWfnYfEK5p49oEzBtE",
-	2616: "UbD4cg1RyOEyYFBDUoZhvbn3zV65rH3CACLrhzFQxCU",
-	3689: "zuIWhl26F9klJbKuv5vB3StLnmoeMPJ+wH1um10oDnE",
-	244:  "Y8HWJ/5vDA8QxOqtPbsR3b569GLn0kQyR+SttjyZtbA",
-	1318: "QNnyoNqwwuAMmdi8lYIbdrVH8UHHGq1FMpdw5BUjvDY",
-	2691: "8lSDkM4ReVSoKqvAxGP6VHQ+h2ATktVryHiTvL4Q4T4",
-	1107: "gAilCcAEFSFJ87ZKfJlPVo8x2S5Gu6xldZxVaqEtR/k",
-	1865: "bopU12KiqPNp/il0jPdEoB8kS7ZzHiLR7KX8e8Wa2e0",
-	3413: "cWSywLrDQ+PkZf1+TtIOOXPDy5mGnBG5WiFh0FhPWQk",
-	3705: "rn7Er4TpGepXRB+5qooGkuae8Q52x5+g8UWPUOWW6GU",
-	845:  "C1hhi6nTFc/p/dT29sv49JalgB8tvPdxCBNmgBpMrgc",
-	855:  "BXSdBQAMoz/SpcDkzO8zDvlGdiAXCYnqI/veqf7FnMI",
-	3147: "ZGUfk4pOiRY/u4JNT0UwUDGiKS+chCoh0zEjDrxe2mc",
-	2862: "C8rYT3tVhesb/m+MSAjqUS0beRhNd71+QBNtNttJEhU",
-	2614: "Px9L1v1lDDr6ID/NaZRp30Xr4wtWo4ni4RotVKgt1T4",
-	2924: "P4czNBnZboOUBKQD/iqCAFbIP4LbutQani6rJy6tP2A",
-	3101: "WneTTsvisqqGtEJn0MXJXc7Jk8cdny6w7Kll2pGWJnU",
-	2075: "ydQnbxazyDLbgKJaQygzUsPJ6hRUETDKUzRbvmQQyAE",
-	2099: "Gmf2I+KO8juYx9JL5Zu5qL+7uPV1M5AtvrkCDz/mqm0",
-	107:  "97It7fYUgUc3xp/AHm2wJC4PUWalEIwDz4s84Goidhg",
-	293:  "z622UBhu4/d7S2CNojitNgdPTcnT6iqMcvoGGGTmfLY",
-	2431: "ur70IJAs6zQ4VubORXVNTNQbMFd0YKnF+WHbJXat82s",
-	1808: "dM/mMpmnLVT6BIztao7uhuAn/Lg7YkDxIwDAkNZ/0h8",
-	2328: "niJ3bUOsLnkCLR32IlZALaM3rJNDtJcq2Qth7RDSbss",
-	539:  "YSiHc0F/lzqbppOGLIpydKwyZx4yTzCxqwZldZdsq4w",
-	715:  "dP9H3NwzoGq5fxsD5EIntujxsgIlmz8gVWCwkus/GME",
-	3765: "ju7Rv6czXfJXY14j+vE2fG2Pr7Fhwwu7HMx+gKTXn8w",
-	3167: "uFGsruBTSpcacQu4s8MNF7QSnKWYuux1nfod2+4CZbA",
-	392:  "2raZgek1H6n3Y76zKNB4uEn/xgcUNLix1WAzH/8Od9M",
-	1920: "0+Bg7NINVVp6kyCFlXCwrFswMG6ctOLTofUHUODrW7g",
-	3999: "t83T9+E3NMfnn6L6EIlntWcMfK8leIAZawtwT/VxWgc",
-	1797: "6mbFGjIWxhxIm3J6dg4i2xN9pF/QC0b3Ql6Sa04B5/8",
-	3898: "TBKSOVdG0uvRKH/rhbmEtzjrWcUufGgqHacqwzZLhGs",
-	3862: "c51xZ+lzTocaITnqQXWA7VDrsmYlv1Hq+pwkWBGieuk",
-	2454: "TOXbSCJ1f5pKMVCVtm0MGC842ZLa3hiD0k7Mvtu1Heo",
-	1531: "YksMe7NTgEY1C8TxHrhA/c8iqO7sZFD4BsCYF2z86BM",
-	2759: "ERsT/o594EBIMXWhQIVjEGE8+IKyYnP0NCHb2C8iv3A",
-	464:  "USj42m4+NFI/01h53F/c1hLXz/DvZncOXmEFVEw1FrY",
-	3803: "C8vOtdOhNxGlaHoHDby2/QLZ/2krxKF4tpXCQf301Kg",
-	1091: "LeQ5jd/BFF2InLrrLKNNDtdvLQPWiTSl/Xr0/X0Kp/8",
-	3038: "neg7fQKj9U+gzQ1Cr7VM/iONZ0muDjSA/9wn7fsa5dc",
-	198:  "pNUnAjnYK0KLFwKILiMyR1EC9Quy+pybYn6gG8L8uqA",
-	1484: "VH8UeBohNgaGCu6fi2NdhqXZuTeqRjS2rG/zaPW8tbM",
-	3823: "N5w19bJkNjmf/BrJlylcSKSSk9TwAC2SuWEVndy/wRM",
-	3043: "0KT+Zb7bGXAvuM+LTsTKXNkGxkO13tqEkXkqkbGA+s0",
-	3876: "3iEjKssY7HRD/lhkGHZEd4hxL4jxOb9DpujtipmWKAQ",
-	2194: "5gO3xvnWM35wSt/kbvGM1J5jU9kjacnv3s4Nl6toXDU",
-	2115: "1+TzQUmF9nxGzfpa54U5d9QrBp6Ir/eAoej9yUKDev4",
-	3223: "bcCuO6gK2jSHLkawaE0vxpTw1eZ7ZAz7Uf+6GTibKYA",
-	1056: "scNU2yYbmXT5JH87OcQskR3GCx6Iucd49zJEL+phWKI",
-	3412: "Ga235QbJX0qVCwV3kHnAG9ab/FaDUy4giN3zS2FImEQ",
-	3845: "kaeVJScSq3oJTG7V0/Hs355RaH5QlYqJaQAlBoqazjw",
-	2666: "CxmHMdgmJ4sHEeiSx7zwZs+NZkkKX9EaBXhJsGe+z3I",
-	1857: "d8MstFr+tZsxq1SJkei92l+8W7hOR2dSN2oZNqatUNU",
-	3469: "kqEYMargYUdGLnmoyIFRaNAPAx8d6xgVp8tH+Ptmlow",
-	1642: "tqugL4LT2j87Wk/89guXouLkEPQHyPzSZC7lUNZf2ms",
-	2945: "lV32zQKUQDwgWtJm03KjC5QZ/YC8NtcIEGx6pLa9lng",
-	2264: "P2Ea71VaNL4PdEcKnLYyoPoJrV0Jfbmd97x/XBuHsHQ",
-	1165: "YEjdKAaFKz7RD3DAzd88CNRrFPF1LQuZe+NGg0AgLcw",
-	578:  "DFTya5c7+jB+y5OFEyw0BBO8QNubQUERxCSnyX319U0",
-	2015: "ZbhQewjsTKqtCvbDbmvl2askLO3ZbXp5ZkcuvwDbHzA",
-	2810: "PWSr4aaDjldSV5YrNhNUCaABwhKG1HHIvPop2/oGhx0",
-	920:  "rNrqztYRZfQIu2GmCg9D9hCyH4dkLioyxeYVfvHWiT0",
-	3154: "2HU5Qz5iYBrEevaa5kEM6x4Sw9Y/7jbfK1NkRW7RztU",
-	1574: "NNhmHw44evprIxoE3vkezDeoy1kFSRZnRYuHECWQ5vE",
-	2588: "CDH9AoE/8ahmGLGGPiAdMgGtKZ9DE2x6iYd4kTcvjuQ",
-	1137: "zRNqJ+TDvvbjzwflUhyn1Q33OElSm26+beQvajJKBNY",
-	3132: "F/FE8KUOB0aT3xKPliaoW5zT76LmJfmflEn6lsx3wlI",
-	2698: "d+SYc9KXCN7147QIQRAaIq2xyMdYNedf+5nJ/5+4s9s",
-	953:  "RWDnJRVXSN63CQKBtjAdjG8gxDg5WimZpFpFm0x5E8k",
-	2415: "JyRErgI5wFrKVQB1sppd8FdEhzdCTSttuLPwoA33r8M",
-	2073: "8KUHs3NIDSW2enaXIEpoiU6ikSS0Q8lPvcJdcLTSiH4",
-	1999: "npqr+OXX33rgnaeSGufRWXP+PPHnVv6FjdrzvGb70M0",
-	148:  "BxcNh+bXTBOCpjgQIhXH7JzZaWNteLvIvzvs0x9PdSo",
-	2525: "7jnb/uhVdppSgm3Jc75gcsm5GD5dMZGYwqchLvQ6DEQ",
-	629:  "zD+/0kIFbOvpMwTUR0b7nbaLDqExiiZa8WsOlV/DIuY",
-	3207: "jkDUTN+32ImsxcGsdMR/Vber/7KXowxSOkYgaLjZAeE",
-	2921: "SZp+VQuUyNL1cQCqZZAiZwSMI4vZkiKZsxK6Yyao+3I",
-	1370: "bKnHkDIKNlIukC1oioTRL/PsigUPwhzQdtMvzP5vcpU",
-	3119: "VVpHxhGNIXFz0ZsEqNnMhVPwYVxQ4CJV0Qid2Ku4GKI",
-	3262: "Dfqlj1YA0/J08CwG5HzFsgXYiCilbO8FByB/mLcTw0c",
-	3807: "smYfzOnlkYYrYXJVHEUBGMttDkJl2xejPNvk/Mv7fes",
-	2534: "gRVHYchsyeiDGuo4+uLhpWKIxvlcdJxM40arv+4fja0",
-	2883: "2kPHmq8AVb0XIavmp8uNtBdcpWIaOy6SheHdoLxKDFs",
-	1685: "Q5wvCrGxKmiCpYHddu0L5B1rMzJ2nQ4my2o1sxGGJso",
-	1087: "JEIeUz/aynGjnCicclwFSOoV40jpquAt+inR0r6ddBU",
-	1558: "JhZxCnejgOlnQKpgwQb0eDg7/YNwtkk1WovTEYLFlJE",
-	2245: "4ChUbOWx59Rnj9bYOwZ2aXmMLW10uozGwhfyj2Yyalw",
-	1302: "RF1wY5lDKDwb0c9SZz8tz3NM9DTEcSm/xvzyR5LUURs",
-	3976: "u2XBMYkslygmSA9MBAdxWgzCimOoAoNtQ7WMLvGlxUc",
-	1765: "m69WTi1iblv1Rw/CXnxTuU0pP20UOuV+fIuC0rmty+Y",
-	1577: "/T+G62nOZgNrABgJOFGchcpIHTSWtXFLAp4c1pMuzHc",
-	2487: "y91Jzt9F/BOdmpfEQGQoEmdQagHCGfbVFjv1Ofx3aH4",
-	3690: "44JEYJyK17Rt6vSM8LFEPDaZglBDUT/y7yC24gT7bmI",
-	1861: "5VWFZ/KV2xWuT01emryTi5fDUIwr3nkF3IpwuzltXgo",
-	3246: "78y2vLO+rUdN7ddKzuAM4/0hSaZz7bzuSEdotfkTLOU",
-	3708: "6hsO1fJ56HtCWsO1e5I4s5MYr2Kt/0YUvd1RMYAtA5Q",
-	1834: "L9SiBtGKHfO5prtxnVRMy9kC9Kan6zkiF+n6V2dAFlI",
-	1512: "5cAQIs7jjelF+FdUo1Hfi0/mHFqIwBreudw0D/UvWJ0",
-	583:  "vl3u9vMFTXUvvd5qLaeqNW6tBaBRY11+7XMmAI1hZTk",
-	1715: "CUdm9PEK3mAGl17FuDq0fhrC+zxmQSps3U5iWUGVxK0",
-	3526: "HWFK7JhTomirG1Pi4J5nRPLZWvbKUEyY/hZbLhX6i+U",
-	1333: "caxsq6/SrX4jAlhZk2vZbwIiSd68ovZojitZbgWFl0o",
-	3335: "qmu0QygT1SjKT0syZn8xqTaT1i5eEzv6tYWPR/VSEz8",
-	1426: "f57v0TS0rj4kIcTselcTTQs18ReHMWVjFFjTx+qdJnA",
-	614:  "5QZAW8dC5k6dDSMnSDE4ZVNL9wQ/abnFXPwQAijwR9k",
-	754:  "BsB5n2NpE9egULVTjQE0SpTxrYAG02Hi7E2Z+fzkM6A",
-	1631: "aq8aqN1NRIu4Dq0I7IgvZ74YGjZ7d48/UTIjjgc/ZjM",
-	55:   "T/q7JQt2H9WiEutQfTvSn5qvS/i/e3vRuNQO+/tobyk",
-	3716: "shhA5BJ9UakvSJ27/dTBXELgvhlzdTJQZkspLkWMuFU",
-	3152: "iVuPcZg18p8xNRw5tJPexazxSTdD37Kmg10xD3en1Ak",
-	2503: "ujPSKgF+Xsh8E9cBXc0+Z1Lg+dbiyvBX0MqP1wzAOyA",
-	1804: "X6qC4+e3hW2ghvckYJ/KmIfFFv4TZOSzG6Flfhx86gY",
-	1878: "4UW7FQNSfsdFhuCQIc+KYy5/yIZ4Otx2H8lWDURenZE",
-	3854: "EUiFQ6YJj8o0VsjiFSPcZEtqbbq8fFrC1PpXWpIdZVs",
-	713:  "PJFsrIxX1OdACPJIAsLqlPLDa5yV8VAOjobfxQtiJFc",
-	2463: "cbs/jWl1DNq4EEc8EVJAMegXRNhIGX4ppYHzM9Bq9Y0",
-	1827: "6Y3AoG8Yc9ZBwEQWKsIod8Al6RPQd+wVp8+eldh+GNQ",
-	1544: "/crNdIhcv44VYjah0pbS0idyMe48YdE8zcY0wZTFeyw",
-	2813: "srsu/ZSK27mQvltf4b+jDNiOlZUHNBn4z8pk18WtOFk",
-	1582: "pB1+iuKxcHIWUC79PyNJi0fGKHg5gRBLV6uvw99KjEU",
-	3281: "aVC/ts13Dvc16uzNyWp5/3unsouJaTXZP8Bn7gMwcuU",
-	3235: "b4qyheaJsJqisAw+RagkxGbYptq+mtIgms2fhK9wOGI",
-	427:  "OpVNINPtDUjD8Ygx+TgnvaVBM68Jsv6lK5/Ez+ts8cM",
-	1437: "WO2w/bQBUHrhunOUIABWT+ZCrQZt62Lyut+09xHDE74",
-	1258: "DNBLbrQmhEhFhu4u8kAFFUgycFK959YaP7Y7SD9MtPI",
-	3918: "17MRFCP1b3H5QNJ1Ce30KwTktGQZQOZ86BH9dftw2/4",
-	3646: "K0DRuAEgjL2kP3o1q9GlFN3rfsd1A3S5VA5Ad0vDk+w",
-	1123: "4WbWUeWnq7MnUeu4NBiPtJ9c9+akGkvOqDXty0lEHwc",
-	2346: "sZ9Rxr0KTgitk8CxpKBhi0fZBD2u2NIRaTPxG8Tn8p4",
-	1886: "ZR2gwf4etoYv2v3efEpUmFQcsa3leZZzMoxvD4ZA6zw",
-	1060: "CS9Wjl3Apv2oGiYObCbq6w53PecSnw1eSE+RGlaIVJc",
-	1921: "cN8ImPbSkbDSXPm96mNdOhMeWWKAWuERjNOvZ447ptg",
-	1092: "poYDsxXUA+bzFO+QooDpvCT0JQbOiMxNd5fiY2UVOI0",
-	3097: "MR3Oe9R0VCiu4t0A+N5Dvghfp2NFYZC4La2285vw9ZU",
-	536:  "hyhniehE5mXkTJZp8OfuYVv8LJJAFQ+Wu3l/JFzyac4",
-	860:  "c22yLNpe0SAAvkPAup6PZRSEyRHpe1KOuv8WttIykpM",
-	339:  "dZrNs8jSKPwFPrzB/b6kTI2y7s+mJs6rZ3N6lkMq8IU",
-	3658: "9IH8PItcMiMAYI5UUJOPOpocW6+PhI87dfV7Cvw7sUk",
-	3607: "m/LI4jMkItJ1tPlIHkaR6lVvJDmQyYlHWdcSMENbb/Q",
-	853:  "CKTtxHPsMYkX574K4l7rPFMC+BiBKrcrTCjq7kWbnBs",
-	1810: "yR9GSdVfvkN2BYBR/poQYES1xUFDFOSpJkK/iypPTIo",
-	2498: "Lf/tG9rwh8ybQXXNWbNzUZdOjENiOU77yYC99TabquA",
-	513:  "SPrKDkg+ElWoZ0NVV9P/s/DWF8xTUdmKXRz3TMaGXqY",
-	1339: "3riS2G3tI2DU43g1RwDJxAdZfatuQFT4obsKtvXtMvw",
-	1251: "+xfi2tadBTUkU8caSl615rzSJbQYPnusx5acG6Tq0i8",
-	1041: "pYhurxxnEwZbrgn+1XQFqOAO/YS4e5246cA0Yb9IJdI",
-	1922: "JEzaLxU2j8htAXC9pCWHpC9a8kv1e7lCmEE6RfPPZpw",
-	494:  "pTz4WhnhMoLf6WsNEMVg+BBYMXc0XAKOfbCGXOdcaPs",
-	3021: "KcQGK0FxJAfbcWU60CSMeQm+tIcLY7ma2ihthPVTz/g",
-	3958: "NCHr3M8yX93/LfINHCivzQIdvuTVndLhfrJLZYcyBac",
-	318:  "nuSrmCod9I8Fo0BlkDkdjrdoCS9uQccO2zXzdb39zLA",
-	2587: "Ky86I2bwFoKw87w27CsxNUvf0i70+/wZQ8zFnmwmBXw",
-	546:  "dACgNeSqZ3PIZvvFUBRh5C+4JiGT7jEGSEfFCo4UnPA",
-	874:  "iOz2NfvG5URW/4oHbQdPu+OQZ4kFnZu1pKCywIEZztw",
-	3143: "iNZraSkvVdwAy2zcXMTS557zGYMwXlan2LteWxYGYsw",
-	158:  "H4kUBaV4hHMD0R5L/b1dvHkZabXLggj/5be4Ab1Sx64",
-	114:  "r4a2z8Irc3QpyOL7eaDSfsvNEyVwY1fiqw9fr+5OINg",
-	2117: "5PFVTxLJPYnEL4B3nVf/rtonIMrTA9T73UQXX3KDp/g",
-	1389: "YY4oEc/UpwWCAjRDdKnQQby5gOTxbVcPvmyQp/yz4rk",
-	1045: "WtaLchYaGBA8NAg6Shr7QciCY9RYsr//hFSUSZqLbd8",
-	3112: "e5oxqe+sS50B6d2KAWr+cuEEBAc5lsOlBHgbgOUWqlA",
-	232:  "lgrcNEOOPrEFnDLEQQ4IeetKPF0N22vOdRuzU92qU7U",
-	2871: "uwXbPZ8UgbBj2PP0Tv4deVAxSqPrDT1sizUcswUmjAc",
-	3715: "KbwPL5D7vEWMZezsgS2ZvdIsSACXxVjien6iA4oDE4Q",
-	1283: "Hy9EwncNsQZnRoFIKMrCBRwmXwgnccrDjEEZ53YslWE",
-	460:  "R5qKpQx+Q2ZVWjcyBuPd1qycHSyUxI1IzhOk2VrMAWg",
-	2321: "U+NhAJHlARUu8LAWdxVzxe/pSOIGSObRJ+dqVhupGEo",
-	2469: "9Zv/+XMe82Srw8I9BUtZ0I93AmT5KYe21MVaIKBb4QU",
-	2352: "KbalwEhHcR6p6Gc9NqwMZc81GRHWy3XKh9T7FnorGJk",
-	3189: "PGSlIopkyZT2hnU3lCitv39+Jxgda0zP+IrOqRYGGDQ",
-	3085: "Wn+EVhWprMa8++9HSR1ID+8XFCygm6QKazVdqOUZANE",
-	3174: "P/ludzsFvtjAMDBFCUtO2IH1uSd0NBI97QqOSBb5Dbc",
-	1530: "32U9ijqDMTvuFVpMbb/DM5iC8njeI3SyXg/wVpAiiOM",
-	2593: "cavLEsX6KTJm4l1U1W55mxRWjCh26rXJ2fqI233Qn/s",
-	2510: "zlykIGPvZ6I/1ZoUqR6O+1Mq0z2L2qyZy26xRRIIUBY",
-	1190: "wrjMRJLXIFOoJ2JmKv02Ox+JNtd6uh6MS4QWT48r82E",
-	3735: "wNpKmHaPBQXV7/xaBmNHwuBeZXMUuYZbpI2pHVgwImw",
-	3841: "AsA+U1SWEx29RptYPpNZjy7HRrNlZUQt+L++mDMn3WA",
-	3921: "As5nyX9qVsQPQ5CyhWfkXiWppahdtlemD6t1xy2Aoe4",
-	422:  "CsL8XmulI3gg74Kkx96aCYqPQuMVzCfnimYlGK8LTXs",
-	1295: "DVcj7JlObjoxYCRZLlu/n831DQWTwFepZI94nKaiUYE",
-	537:  "p7aW8WAyftQo2xXfx2kiz4XJ2T/j/F4i/w01S/CtDMA",
-	2669: "57RmlvWlQNli/inBk/fpOZKg4gw3RzcXVM2zWwWIb3M",
-	1914: "D9BpILwHCwT6HzGMqwxN3Com6MEo5t94rbSSWwhujh0",
-	1559: "FrODyyBaX0p+T7taDgko0+DFlQatlHOC8DT5F0Ws5ws",
-	1164: "rACvGxF+udc2qR3v4HGbltWbTih4mscypzU6gWClzo4",
-	3194: "AwfqgvegwmrtjqpMfE2mKaco03WdhNXM2yvnhP3k+Ok",
-	2969: "4R8+CShFpfwvKYtE3Z4pGINdXRjhRUi0+efIQPpPtpM",
-	229:  "E9f/ovO7mAzf2ONIEK848Zfz4tOsjd4xWQDFPub9Yps",
-	808:  "HMlmhv2IBJlLAMZjdNFZcyOIpmlHAKCSdpKRFI8s0mo",
-	2654: "g+nYi3kTmDPFwtY2EHrkv8nQ5ugQIx52IpcUodHqpmM",
-	1750: "E8XMShj0Mfa4hLJZcDwslxnjeIxkVqlqWjtwlI5WKNk",
-	588:  "P9aexWFxy51uENYSW8VSjYKvUiKw8M4Fm4+xRF3+gKQ",
-	3784: "g02UoGg5C0pka/jbVHM+eZc3t3bPNWtxHfEMeCyC6xo",
-	1114: "yvjJNT8hT7fYvWhB+YNdOFbVQ+fGDF/hyS7qAMfJOvY",
-	3219: "d2vK/cvt6MLaI5d+IN6STxKu7CnvNrRfx107fHJP6fI",
-	2107: "mzGJp8ohLZKXUKh93fGST2FEe+FiogeQWWJqpRjzAqQ",
-	2928: "fEcatKm03NhO7MMop6q8C8val0Aid03KDWFTn5x8HtU",
-	2467: "n3ENim7qOxPX3+CBS4dO9wxt1zcG4yFOac6V8iRBDL0",
-	643:  "ZRdEcSW1tcG2hhKzlYsRAgP5rlF09155iCHWm9L/6ec",
-	3274: "RJEIiXXrGwnpQRu2ED50ztPIjcyxSDo3wVyoTGrgV6s",
-	699:  "RYfwPejZivy3ervzExVX+BOSLYflGRHlbWYqUTjFUdQ",
-	824:  "nO1PU4dOmTyPxR+z0/wXyuI8nXBFpDDWdX79U0lNPto",
-	1773: "FU5m5Cq6Sp6cNEkBnuY7c2xr2SuXrflGRmK/zS/3O5U",
-	3031: "7P3gW1ypQPYJOUORyuS7vBFqqi1ZocfFWMQZcKAPu3s",
-	292:  "W8h4v/VDzZj6p1AAr0SaM7E/nch4EMydHBRzKkRR3Fw",
-	2528: "212B7FNkZ0rzKz/qFTkYPXdndmPvIQp9gXMZmeSUsnM",
-	1178: "jtn3GQKxyWLg9oGKWJnUhMokzZz/+IMUbErK69ZW1p8",
-	3965: "pbMD+YLEw1MHqD+8IYb/YY3eENm/34lv6u94CCnpvHY",
-	1825: "iVdxWafrghwwC9gJ9WpBsri7V/1bcKVf9nLy7gLdumo",
-	1684: "TuRO49hrVUvs4ZOv4E8DkSTibhvI0SyDKev8VryXiIk",
-	3449: "1sE2ERsm+52F/Ozuj/lvGf85Rd4Tz3N0ycSkW5X4GgQ",
-	2093: "8n4uXdZDxbA3DquJOD3YG9RDKucGz4ebM5XLYp8QGC4",
-	1626: "AIeYM8MaWEYkiujp04bgtwRCElKZorJLha3bICLlm0c",
-	1821: "NnG6YzJj+Q4c7oLOiCLEs6Xf0rZxES+two7Vo68af2k",
-	1551: "PnbwAOTp5anS1oVSOTeN5T3vsBbPQ66Cd7NiZMTj+Sg",
-	2209: "wL4hZV0194knoatb4pR9sKIk+++0u721SEIzLTLPVWc",
-	3378: "YJHTHvKuxC6o4RX3+rkQgaRbMqN7//zalq8vi+33gFY",
-	1203: "/0M+UavRfbcYe5DnSgCYuBDkcqHCk3Np+wNXedtr5pE",
-	1887: "G6imbDhdOFnO9iy9ym0is5Agh7sgBRTzk3aAdl8agIQ",
-	812:  "Q8sAGpRalL/ApIRsvhtONkDlg1H7m5J2b/HoEFmyrVM",
-	2088: "Futb6WD2rRIHa5TcP20ghux/a7MRdxwIZX3x9iUDDt0",
-	3740: "noNY/99usGTsd0Mc4mlJArmOy4eNvdU2NK8mAvJc6yw",
-	3047: "2gSBMzd381ShS+6Hw1CZo+ZeF98KTBsoN3qXgr3uWRQ",
-	3663: "4FZH36x4ciCxoPySZtIP2I+6hpZVypBUHANfhMdx77k",
-	3636: "aRqMcnKsbkR93cQ6kYkR1+fcxGYGU/HRgcS91WgaMhY",
-	1291: "fRuJVkVhfTSlY4LIBNNuPvS7hU0w6J2LYXnUVJGm+kg",
-	421:  "zRHJD6Jrb9WK8SRkQ+fJjSv3REz1T+F5V6pNoTJZbKk",
-	283:  "UVpUTuInJpkv60RhkHNzeR0kPHWZ6fEiV7JOtXZPrhY",
-	641:  "wSFB84uEAuupJk6VTtlayrFtmtwNKIw39F4Jbk4Lthw",
-	3508: "d3tIgE3YIr4c+ppySr6vJTW1KjZdbE2JkCWc0Sng3oA",
-	3192: "TpOFYBaD2Z4JN625u68el4YsJjRwIks452ip6KI+S6w",
-	1433: "6ROCCsmi+YNNmrI0TXOceYZqcFYP+Y4L8w/dE2+bTNg",
-	66:   "wmcx2D4YRsSYbFNHz/uCA4rpS5aaubgaRFzmM1o4+c8",
-	2783: "r9KdkPw2QGTyruz9nkXyw0UEilbRAM8Ixivl7yYwjMM",
-	1362: "l92s4UrThweU2i0cdNKBRzIjdIpFDb/yqNBIImJQNmY",
-	245:  "ud9lfCNsKmrQSU29babNzsOoGtaE813muu3wV8sU3hI",
-	1628: "AKuelcQl7ukAoQma02xfi9asT4VDiyjoIdO9TRxIT48",
-	2947: "jGPImzvntWP1ucQtnjbo9GsStMtD30H5tqWLTGEpv98",
-	3580: "ue7UPrcMQ1N2uD3Uu1L/zkTUwo0uQ8rKT8sq9SpgDys",
-	2773: "VPXzvpCFvDuoN2rqeZndN4U+1UE8Yg1ZPSmwkmL3VhM",
-	303:  "BPUKk8uOz60OQTN+4pt1Ff9aYZjzxdGLV9e9pIXyT4M",
-	1588: "fHVu7zdsTkdeItNu8umnZBsns63k1NzkaTBVNj19Ax8",
-	1018: "JZduw5l+LSdEpNo5ILvJnOrNbVYAeGmrqi3EbAtePsE",
-	2744: "4azFsxNkYWMiifvCEn7CSZUzmD2oqXmDoy3iqbF39Pc",
-	3634: "L/Nu6KJKdD7tT7gsBmphgWp5gjfnK/ZJtoidSgouA/0",
-	755:  "I0zLFL5k9OlhSRQZcouYUfRPurMzhLZXs5bGCm2Is68",
-	2357: "Y8SacEujcw6d0EF1OoliAyRYFZdcSnrUsF/IJ2CD4Jk",
-	2071: "5IkGS23pK76YNsxSbYkqTJelW5A/VFbMZUXRwZQcRYg",
-	668:  "omCmW8/9QFLff1NnAcFwhn5vqqaMqDJtyigNlU33tZw",
-	1292: "LCoUDDwDzcm22peSPyu+N5uJyGvtCTR58b/rowoHrsc",
-	1717: "YMySTwZIf4h9mL+PvaBijm+sLscggOHaqskRProO1ss",
-	340:  "7NnlDYxjZ7wEySG8d76qPayye9jLVvI0zAVTrmUqzO8",
-	3010: "c0ScB0SCUhrrGX633M9PSjrfjSVdvSxoZauAxkPq9/Y",
-	1539: "tCvpQbzC7xxiacPq08+OWywex+J/KHm0mVrWDLva5W4",
-	2715: "QncJVIpLSc5tlJbLO91Bf0yhauybDoMJxFBeVO3TQKU",
-	3639: "QaDumT9LzVWhS7SIa3EMIiM84EP7vWBFxWUaAGweq74",
-	3048: "nHSMQDEuEL9KWZW9eEZX2I2Rlc0nkg1fQkY7nQi9Quk",
-	1376: "scorrbO8KKC+CZI9Idu3W+T8ujmBGhFjZ9bUi2+YaEk",
-	1160: "sUoJhCc2to21njcKjg0Gj1OGUododX2o9H/NYbHm55A",
-	2722: "BMcN/dbpyIxKDF+VKqgbgCsunBpUT5T9/ZamDTPwp2E",
-	366:  "x7pJqFDNIkbUUDJNnOhQ05saHGWgrXNK7MitMhVX0oc",
-	2956: "J2mPudFiVCM8oOxUWuZccfKYw2ewaj6vv+JTXpdXtHQ",
-	3903: "OJ3TmZXcmLg9bROPtTzV8ffxAqTObWBNK66BzuXGyQ0",
-	1100: "TqP/Zv+52uGuqVLA8x7j/0ONwK7Xgxz75/8rFLJq2H8",
-	1168: "JdnS+kfVxFITbYIksPYEXsRh4GfBsZnPB1PdOYUfbvQ",
-	2216: "b1xn5LQVfzBEmZ61jfZ7sLgTfkUsDyOMJ6U9VG1+9Jk",
-	3927: "sPmVK6a18SnlkpIFNvdXQNL3sMJAbRA/5AkSxWthiGw",
-	770:  "kUEpxM1J9olIxz+IVkjEBbOebnOqIqV3Yq6nnBj7jso",
-	711:  "LBqYtl0IkQjImwaeGd+PJy1CPwgckCmBBVu0b4P39U0",
-	2771: "PFJ+kUCEjNWqXHSXHaJJOz5beRZxTaKaxCTHbCvoNHA",
-	1201: "D884xbutwdMyIpX4bKZJ2H3Y31sgWy4Y2xYprNRweh4",
-	2167: "h2AM9MA8VrkWSvuSJgJC90otQAJ3pzqPlU8nxtfjCV4",
-	2457: "9un/OhrUlJcda4jTNTdOlyhedLtaC+1/3y5NH9ocT6g",
-	3571: "8HiADAjZceM9YoGwTsX6ldxQk2dOWD+E2hqjmbgiyWg",
-	1568: "td9QKPVxX630l3jzax4oTMEHi7OBWjDQdF0S2Ze/3T0",
-	2482: "aCMqCKXSV4yfE0ggan99wDpfmAunQVLQjH+oINqpA3s",
-	3625: "KDr/6GW2r42Ugq4ypYCEwk1P2eLpdWwrZS+/d2D1G5U",
-	2197: "AFCz+W5KEm1maDLanVowQJYSDZWU1hHRXnGA+ciQPbU",
-	1070: "6+/WvIpVdhKmLt2ODCt7pSoLLT6D/iXmCye6GCS30Q8",
-	3337: "oJYkJHySskYB3xCGiqQ40S/Lj/KiL5jxY9mb7HdWlic",
-	842:  "J8nABgzuBWYgWTEIsxB4U8ddPSgvGiDRw41pxtpA3so",
-	2953: "TP+rOPkF1xoiLJlMfxzEQ7XcfIQ0EsGJ8Eh3NW8/obI",
-	2556: "tNHSUxfyZ+oAoc+hmK1mKyhKkN+DMqqGHbdI7OGttsU",
-	1883: "Be7PdHASVzaUGfxMWvBHW704u5t5GPHZxs+aeMTFX3s",
-	1174: "arouLFrMLElKfGERaA9SeRHb2l2fhc7znib1giS8pDs",
-	46:   "Hf0QoXJhMENtqET6oI+uVs82yPrM1cD0+MUFNVHghB0",
-	2338: "RfadMxF51NDj6/PtPjuJZ47tgBNYPG7Lk03c/M8pZ50",
-	2481: "SQH8ee3kadBzEcF3rf0Jq/8sSjrtYTUXdMDLQ6ZxqxQ",
-	1580: "ccfSjfc5sJxQXFWETIvbyrkVVSUI/toMa0psGjDZmNo",
-	909:  "xBMoo5FbAE1A6hCWubGkNV8lBet/h8iv1iCfvI9cjC4",
-	140:  "TaR6VQArkoaqWekUGL/nrMNCBIkIpn6lkCYBu4zMsmg",
-	2519: "rTUQO4knD/WS8KKsfbF9BrKkBMr74VD5hk9nV681FhM",
-	3468: "rlItFas9XbdVz9TU4n1lRMIhmqtssbSlfXUgLL/dHjg",
-	1807: "91aSRdns5Yq+cZUb6KrJOKc150cVm933SSfbk8sR3vo",
-	2934: "YDFQx1PBdY4do9JckFQ9Nyq3ZTgrexdaZ/o+ifxmlKo",
-	187:  "gm4Evsglpi7dDQwtjufelUGtra8ygTz3Ujrn8GhSci8",
-	242:  "bqBwj4rycRPPcjqREvH2wq4OOz7EzbWuOWkOXk+HQFA",
-	835:  "Y9A5KYoZ2eshB9ATCV5bRk5pk5IJcIlCCIoIzaJeQz0",
-	3462: "JCIYow9e5Silkkim2hrBp9uE0t6avfTSP3DAyPl1p+0",
-	430:  "+mGGyg9kBYwE9NWTFlvbAMyZ0rUQTDSjC14HGTj4I+I",
-	1349: "CHajjBEN/rxXrC3wkGPDyrdOc9hdyIjy3DmelHq+nt0",
-	1637: "W8YA6VoDFt1L0evG1qPb3Os95t8pDUX1ZNzflwzXgSs",
-	202:  "UER96NIZr9IcLa+9KEVIRnasCKfDM1rBrWt4fswzZQo",
-	732:  "zQlYJqwt8q+uPQYkvU+ierujOxbcrCLRqmVqktcvTYM",
-	1109: "V+rouvOB3LCvvW/fQyFiilwpVmSwY/7tXNcMiPU35A8",
-	213:  "AhssNzZOBYQAIicjZrmusEyOuCvKkIT8KyP7KS5J5is",
-	758:  "rDEiQDKaxDLaENplwvrM+jYf1Mbx9yH1hU1DOaQERGc",
-	591:  "0z96MjkXuY/mAS5X883uw9lHTp7vDzKHkVne9UhHk5E",
-	2100: "J/bSsFidO8bjMfGgyB6nq5Dpq+aENHQf4Yh4y4a65FE",
-	3552: "hNNdAiBfZM+bZ9+6VZMhKoh7C7hEOAXHf9bczlMlc+I",
-	3291: "3VSirEwTBHZAaPz5cvAezKtbeettybSnyG57LyuAgr0",
-	992:  "q1niX0yrm3I5duaZaLq0O8RFKIoyOWcZV1/fnUY2zaI",
-	2349: "TrU6gx0Zv/085EZ7yISRLRi5LTZ+yK0Eb+9Xoi53+O0",
-	3525: "3JTrxuEkitUPpzftxle0ldlJ4G+xtxaa9IdED5DgNfA",
-	2191: "/NHVpIoZPPf9FlxWWcRYEieC2fEA2hHuBSERDJxl8KQ",
-	592:  "YOBt80i9GxqvhWToZjjizhal9q/eGGFTxHVuIt4tRck",
-	731:  "Uujqdk33cz6tEG2NPnxwxJnhUdAjqBbgR8rEuIH6OKs",
-	3129: "eboTuCCUillka9HopH6gEMQmjhQLo+WIdyRaF09aUA4",
-	2670: "sDbxkZLtgofUECLRrJr7UQ5VXosMEaWSLEqUK/Ve7fA",
-	955:  "3jeXzP0OYBsAzKzjKKFpX73L9ID5xnqylIj9AJNATdc",
-	2717: "qrx5WluBDN0vWMzAXRl9EuwXxUFsredDzuScv74NDlg",
-	2489: "ODwOePw/v+nhN/29wheAB/HmJ8FhYrOD9EOuOrQjFbA",
-	1490: "Vw6OGgtZF0cXGRJfgGzj5rQZw+/CsAgkrlDRneyXlJQ",
-	357:  "Z0T1rAYXYTTkNbY9pQOAZgy0uyzWQo6t8mwSWfLy6tE",
-	2721: "02+ztmPhv7ElVCDt5hHSS0QRCw15lWJM1vFeEoSdceU",
-	2292: "2JXf4RLNJQHSSFGNiOo3QIcM/D2co/a9OjE6yGmgsoI",
-	3908: "Y86nxUf+x/EpxdxasDNedBBfFzAa7I5H83ZlKxyxYfI",
-	1946: "W6BEb1+8tDAmyFMXelt4cc3pLwf3cN/RNudMot3GjV0",
-	2057: "OQzxFyNj8NAiuvKpBCXZzsxHZ8gb5ee8ubeLKmcvNkY",
-	252:  "y5yIoLPIpYTVci/LRp3y14jC6BlYEXmq3Wg55tB6xmI",
-	2620: "5b80/yBB8HDXZIt8o/xbyleBjFbkKjABiEsMfgCZD0A",
-	1463: "UYJIag3gzpeEa7g0U0/YARvOlACV46sly/xT9+9hkJU",
-	2050: "EKUtaaChhqDU+B/OtJBEAYLXUeYblsn6t0tNzHMqTWw",
-	2086: "lA34glIVu5BvsCemaKlFGUu5sWxcCAuQpr5/g2NIiC8",
-	3355: "PqIfw/b6WlJTXNAMGOnpAapENFOaC1fELqzg7/225+8",
-	1621: "MYK6ynRdTmOHeTq/NkDZIo23Hf8oZKMNOvsFXuMuydE",
-	2041: "ZfbogP7PreUSBIjmcFqX8uWes5ulfQ237TzjDvvP2OM",
-	3724: "OeouloWpfekRByI/REla1dlpsV1u1TIGmOvXGT77CRA",
-	1459: "4iKIUutzpc+yHhubJx1/HdcSmgWKuwNlSercPuDDt50",
-	540:  "h05Eev88FfmYkcOBvJqrKxQwR4c9pNKD1jImYpdENqE",
-	901:  "U9rnKDRTWyJ7Q3cBgnAqRw/3WaCQOuDImoGJOzrs3tY",
-	1664: "e6AOkbvT1YOD8Qjn5FLrs0dVjmqHhCooc34oHLUtank",
-	2207: "E1DcBBs57MR7LGAJ4BUoUfyJ/89rwqlP9EqA3fvA228",
-	1768: "Mu9ez2+N9ywTlQe3/NhDJGER84STgvAVxinht0pJaCI",
-	1419: "QEH24ziElErK1k0Z0Y3iMI0dzIqmGSzeKMsBJspVY/s",
-	1572: "LjlukV9IuhMhoVkZNHUTqCT2xj9V65bf2Hs7vz6OHSA",
-	3555: "TTGYGd42Os4vDEyTx66ygAI1UhhK+7QFLfHRkaCfQ18",
-	2774: "bNzyeKmPQgskmjQB20qeZjy93GqxNwcDffv8oM+9PyQ",
-	319:  "SdhRCHydmcVG5hoyecHZbZPBnLAf3dzM8z11ZmMF56w",
-	1125: "dZuuqW9mpZ/Rrum8NayAxc1JmIeU+lvulXV//6tWPu0",
-	3983: "A6eq3GY/w7NN0jT3uC9YsWIhaTLdt4If7FK/y57jujQ",
-	2334: "vJd8ZxGpzVuP7RsLIVknUAdGlaPgsozXD22JlzjJwnI",
-	915:  "2Clxt9K+ucnmGwkCErwrezeDDWXVVIkJti7QSmGncV0",
-	1007: "T6NqADP2pzuWFnrf06lsW2s6bDrEJWE8rf1CqkcCp18",
-	3703: "grto6c0ytQvKkQsHSqa6ayhUtZdLTsFU96mdFAkdjs0",
-	2960: "bTTyiwRx/sBurPMZ4GANubWhmbFcMYOHPHV1GNkec1k",
-	2445: "WEwwl85/uh8YarOi1tRw/DEMEcdBxA0B7PH5zyHMP5Y",
-	2059: "si5jYzLySp2aGlvjxZQrH/EY3KjzrG6vT9VNPXxskbg",
-	3452: "TvjR/BV07K3Nby2WdVXdd9rNXD2pIrZxovTwb6h0yvs",
-	2019: "j8b4LTeT3m9EDQjT4sAnTVI2mB+GFi6hMg2KH2KdOYU",
-	1497: "sCQIeDE8FPA4fcMCGHnseT8rrKnkCxPqATPnBVjfISQ",
-	1635: "GLjXXveO0Ako7B/9JV18Tsd/6qTh9yRF2v/ZXE1XwMw",
-	1994: "jaV2SbzdWxdGQdYMDo5op56iq2LHJCUST8F4fAwTXJ0",
-	605:  "d5Km5CpNCopiRpSLYjejlvGpA5i68ZPBqK6SARlpN/U",
-	3411: "0gXFiCa6ND2/5xMuOJg893oeQbXg4aiz5dKBEmUr8wI",
-	1461: "24o73hCoz0r6/tu1UMyK3n/Xc7KewVrURSRoD0IUtCU",
-	2447: "FaFoCLavOGAReCMwyeVt1S8u0aG0iXXTzs58FnwU3s8",
-	2484: "giTTOR338A3bw147vPGFcRGatfoGBoihm3IOo+Mj/rY",
-	597:  "AmdBDpN4pJPyZ2Ct6ARDpvbbgF0WaNgVBs6Fy/rV4+w",
-	409:  "GvEVq2K0NJvqAnl9FYevV30dZuz4Uoy1BzTL0qWziOg",
-	1975: "6QebLtlDksoNvyp7O1p9/AoiNzZOyVdA5tPYoCsE9wQ",
-	2271: "wA4F0B0dBkSNpktr+x6Fs8Ijo2ljC/sXpD0ZxJeztks",
-	1288: "MVxmiLMLYLXUHiSEuJQNYGNavD+JDR1n0pad6+fGoUs",
-	663:  "LZsrWHqZbmCxAS7mCRipz11JQRzQToLx4WXVNF+O/bg",
-	3370: "FPMY1dOr8upwFbjAvfsPPEt+jL2b3azgRcn7VUrWbVM",
-	1036: "IeNqPisFc6CpIFLrhn/E91rMN5AtI2bA/lK7wT3aasU",
-	2571: "lfvaL7sa0/KXmtBVM+15EUXoIGHGZ7ebaqYGsJZUc+0",
-	1944: "g+UfCOVWxNB8y4+3ZNdhi2eDLHTnGx4I2EcW/NkVxp4",
-	3445: "wcOIfptkPV+12RojDIFNLkPHqt+425xq2heYXf0Hz3I",
-	300:  "FlU7obpjWCNwK85P+Lcu+nik75Ew6RWZfMR/6fFqXf4",
-	2278: "vaUNqoWD8E7ftmGli2GuEWa/xfB5gsKiSuhO1v1sIUc",
-	1319: "BprOpckWI6bJj6CZcdTdT7xNRwtezbHS7qPlKDXK3+k",
-	2800: "fPTL0c7DLMl6vSaBKPHdkqqxzWZ6xgawll7qNt3ct40",
-	2230: "r1Kr/pMnlv/h38zGXsKApHrz0a4fVVQ7ZxOwxOs8l/E",
-	1615: "5Y4NLjH3KrG4YdrQZxXt3OCDzGX/Y+Y9ukJ+9TgS3BI",
-	2777: "c7+jCozz2wDHMpS6ImoYYL0xI/pWTniFaZVcew6SuZI",
-	1176: "HSGnIDPIaQY2UdgkjsR0yElfJzIqW/0BwyVeOdbeu8c",
-	2124: "ymez0yk0jBLh5xmKrtft5Cz1NJ1kdrtJ4mYvau7pMek",
-	3322: "7uJYIE2eewqxWtcW76eKHyFzqJRykabCTBjEVXeHmp0",
-	3466: "EbaE0kH+b8GiK8Ma0LHJ2aThCpSE7I9NsgYjasETbmk",
-	2713: "WpvgYJpYksLvL83rcgo1ydmRlWXa6ud7SFeWzbjTpck",
-	3305: "tiKT9KMr8iRQSi8GR8B4hDGa5mgd1KxJV3eO2IfelrM",
-	1947: "jpwR1mVdrvtlKeIA6stG0m2JgV6epbbtyrTAbxz+j2s",
-	3777: "sxdldFWafnhx0W/PsqSj6tGz3vnM9f69+lNxa6gQVSA",
-	2980: "nidQhdnWzz+lmmm2Rw6UAP+/Y8ffOhdFK+RAXuphB4s",
-	2657: "Jm+4thi8JUpglun5QS8oMFqofHNPzN036WPnlDVeMVc",
-	2530: "HsuqOUkKzjB5q1jxIcDrP+GrGw2hItqEuiBXsMoSiu4",
-	2307: "4lUe6boptZh85WaNclH4L+1e6B7hlMGbMk2CDoW//9U",
-	1656: "+Kd1A97iPZE/uKn8fkfdXnKxCRFWsurEUK0loRimYmA",
-	2817: "6dz/BSe9HW63dBN/g/tQ3ASx8kgeVE70CfEPLErXyek",
-	1131: "zjQpnCHSQYLxp+GMYUT3n5B9JLeuYn5Yq743gXYb00I",
-	2886: "2HgU1vTYcA0H3HxvjcMvkuGW3PwpXbc+yeEuSL5DJgQ",
-	3384: "71v/pxiZedriKfXZXS/GqfQpsrPZ72MbdcLXG0DzBt4",
-	3786: "6bprLgXI2wZH1qa9qM1t7px+BBsVHru6wSczcmeMViE",
-	3195: "NQ2rzf1LxY42qhgkL5iHUQFakeXznoS0Qmozh/ZrttY",
-	908:  "zY9ZcmMaowcDH6o+LdyP5vHO37AhorxcpOPGEfdbEsw",
-	1449: "vAu0+X+jSd4kXkza9tN+b7Hs+hcFhBs7Sl/uA1XwqzQ",
-	3837: "8YpFcW2oTkdrI1e18bj6gIzm9ObOhn7hxc818wh8U2k",
-	556:  "p6IFswhdxcy+c64f4k4UtcAn5uPFJCi59VM+hL6ud9M",
-	1854: "Ji5LznHEvif0+wlmUOpyls2J7nOYZSygQSrsrG+x38k",
-	2649: "8Rg+WCp5Uef4nBGbvLUuYwvA2rU09Ux4yCLO5GnZObs",
-	2548: "d1TcW8jh0VTHmbk9qY/V2IQgtgnZxEAndZ5hz7VIDy8",
-	1440: "dWovJpsnFzRbwB0bWe/laOMtR6FPU2h8ds9udKASwAA",
-	3688: "jAZqxxyuY15NKQwwrM283t7WLbpvvwzJYJNtVIJkXDg",
-	3007: "tcXfzj8iTKHumgAFCcBGUeQOb85xKreCd3ccF8rmxXU",
-	3732: "s4RHJ3gWMPglEbYKQbXA+tsAkg0zrBoIj86MU5gN9yI",
-	489:  "x5fHBPM2hcWd67luhQcfR2bP9aH41pNhZcH4JFPraZg",
-	2441: "yQELtRq4+uL+OrR+vHjr+St8XhQ0wZwDAxGFuVciHZg",
-	635:  "wbGjG+ubhaahtG3bEAYuAUnO8/iheDgs7LbPaJ7LauM",
-	2374: "QRjo+uqIiuBP3zNGFe7sbM2oAXXctmC7th/9GwofLeg",
-	3668: "vrEPfdNKXBlLFE8L0GSvTQAksHOMAEDf0EzGxpQU90g",
-	1712: "tuDCdy2dUahUFG8uZxGEYUNuc/7AmCHnklEmFn/3vmI",
-	2446: "1i508V3TxRo6Xw5Km/WrHadeh7fBXiQv1v90Be4liaI",
-	1511: "HbQPSMVpRK3ILWJsZ0TBgesUKM893QasaNc91W9zBQA",
-	568:  "r4jUV4xYsX7FnkpjTTlVSMsKUo2Xp6nZlVSHPjMoxG4",
-	191:  "wQ7bbaiAhpALYir6dDF5QJwSJrPLV9V7cGG7w/bxkC8",
-	1456: "8Mu2FUsXFTOfMHBiF6iIbnabN4Y4JMYz35BT93tDtkQ",
-	487:  "yi02Z1MtzI7fVnNaZzryCQjbtPE5xwzz8vy7KMrDChE",
-	2493: "WRWiKWEWmLSrDiYiGI1wrdjP55llj0JNeYbEdlCKXfk",
-	2147: "O77XauK8t8q0U1qSTUrj8iDzD9bDu5LNPmGTJ7iQZUA",
-	2856: "/8qKhg20z/Hr9JlScy+vpa0fXt+VAUPvTqtMJK/dFMs",
-	807:  "US+Tiyh2tVvh2c697jvBCYEiPdKTW74rOuWaL2cPB9Y",
-	2464: "h9EtNRNb18gBFvxrro5AZLZJNovb4FmKtMf6FShoZtA",
-	2149: "pG4yk8pC4ACPUoEqsqAlZmogHd2JV/9mpg6nXD3r6xQ",
-	1324: "R1fL7JFFcSi6INvPz7vxoIPSlyUs6S2NoPXk00c1be4",
-	57:   "p0GFid5mBGMcdxxh/MVXXlyndslq3ChJ/ps8omlz4Jk",
-	3960: "NnDMR4I5aWGLpPB/ZzOdA/utQS1by3bROJoHAjxSrKk",
-	2786: "QGXUalPLAIP+k0xtSYu250DMrLMHzPVk02Y8JKHXrpY",
-	1607: "d/r+Ipcd5eqm5tru00PCnAVXELgLc95J9yfdu92kja8",
-	356:  "c1sgvh8jEJUYUEJgxT0Up2K4VJhYmlvsIbRLwkRZ0Kg",
-	3959: "PA5vBSll1QSb/sLGnCoB3zcutgVqZsDfQLyN4LhMQCU",
-	92:   "dYBM7bkOsgma6AoMUxbGdPETra2c1/bym9lOOn4Zb1k",
-	1509: "eKz9+UkzIomSNtYiJMA+AOKxsfatNOLEKJXQVBgZUV8",
-	1312: "s+Vv+WRdO8/Gc9Lsled65G8ZzzqLhGR1Q77fDqZcils",
-	1517: "74+kHIJh6SotXfty9v7kVZZNjDNZP4lWeWEIXxG0J5Y",
-	2504: "FHoVIdcE3ZZpGh0temKgY2h4JvfZkt6V4JBYEZSjy4w",
-	3678: "alUBVPbEGgDJvuCrs2ky6sPyVi8/dXAtWd8OB3nVNgE",
-	3005: "LS7PM57davI6eoj7cr3yNVosobSsC5l6Yu7/CgagJNY",
-	3133: "eUwXQm4Ni12H4j+r0uYcQESByWQnND5w4bu+8lMMFLc",
-	70:   "Sffn6+NjJY+Zm0jUcHq/rqwYEtu5QvcA6201Y4Gf47s",
-	3989: "l4ucfE3cTQYjpfGwyf4/dYAA1GCcRxrxl8KtOqsDAC4",
-	3744: "Mgle/+Lxg4EdbOE1wwHLmFRiAp6vYQy094t4eWthNEg",
-	2955: "zzT6+c3MVQZlLqSRMkmAy7VOsHicXBcXdqAfS1JBo6U",
-	3256: "Mafit2vw308/YVjoJtwf4vZ9z5/4QGmN3lbjendlcsY",
-	2869: "+uL4mgkQ4UBHHNslhdZWyPT6wE4T61pWntERomzEN6E",
-	2828: "Fd26daaQIJ4jWucAFyclvtNiRsuPirjeLnstx5kkQCw",
-	3666: "g0g/ClVZSgVTdk1Dw7LsXlmVKPk11z5xKHaYDaEY680",
-	2574: "mca5eiGq6dLS9+jvsTNje/0oKIBzxbY6gRdS9HUZ7wY",
-	3150: "QWKWlbLu6BTjCAc+O6y9tZzwK3faHSZzzoiPRVGjjss",
-	2995: "H5idJCV8p32D+M0wglBjvHru3u92zZ1PEd1Y5ZUXp3c",
-	3881: "FQoUuIQFbP0RhrNj27GblGCyqQtrvkohJgqJqUF12fw",
-	2832: "Hqash+yDip3KOlMThwO6eGdQH+QQz4HOL7YiuPRdT1A",
-	3638: "rX+6c5xEpINaZcTD9L9qfRVk2YwNzsWVgMu6tM1ZRps",
-	744:  "S8kNuKfOe1TcCTlupttIlxsghRhx942u0fDF7UJ+AFk",
-	1569: "J9yqHM+uicpF4Nm/W2XIobWDESKABGB1aHTDLPOQkz4",
-	3275: "mfLRLMr4Q/TTful7BPV7Vryi9mwbl5D0+P3UK12JwLs",
-	1869: "4pwe/ntNWSTy861zZIP3Gh9ivg1+B5PgEIKjQBbPmxE",
-	2125: "oYOJWfU41moNNbT5CGOAEO07uatFZyBtjiVboKi6r+E",
-	3217: "y0RWFK7gdHG5xMK5sLp0BxR4mTEyGP7pVdrSyBgE41A",
-	3942: "RtmikeHOOc/UCKwIbkEn8HAAN4EfW5YXb/I8LMrPl5w",
-	2303: "PmvUIWE5TdEitod6SLiO/jnJCtQUredW42GQVsN/GPc",
-	2341: "xAeXTHH7j6NxfOhaGMieV4Uv0ihU5dc3w4j0x80TyfA",
-	1556: "0YpHWM3naB7Ntlsn1uhsqs9DvP0j2iwWihvYVcQDLT4",
-	474:  "Kl2eoBPV2/mZc4Zn+JrY1mEKXU2weihyrxc490jNk0I",
-	1841: "BO3rJ7DkgSX9Kn+JiNh08aKjHu+mcnaXalT7doS0HTQ",
-	607:  "xNoNlPHVA+wDJbWEn6mO+jJHoyDJlISWRNCQNtF5HaA",
-	1404: "LjUhBCypuZi6akaalELaKQfGG8vCSUl9P53Y4eY5lqY",
-	2653: "Ujbjj2j97U1eR4CfQqEUqV8g3F9FLaJ3/fgYIk2plB4",
-	3379: "xUhJLFeNFXmLs2A/nUhdDmqQ6+gDo1369w0hf2Zi1MY",
-	1432: "ofmwPIfVOj17SI08lH1S4IaYNU3NQ8Y+rYD1hXHNKko",
-	1462: "NTk2l8DEDP7rfwCDB4lOOFtbKAz1JMHUGGloGBwxSC0",
-	943:  "TJhb0oRujlUl8N5yxBl+AveYytpKcoJkEKVnYEzdWwI",
-	1762: "TrAp0pqacxiLyOK2Dg5VFUrJC1KUFt9+kMIxx+ckuBo",
-	3208: "8NnztwrUZjfEfQYaksk/IpHuWqpk+ubkogRDwKT3vqw",
-	3087: "2I1Dg/h4MV7mViSA7GBTE8HKWKUAXqbiaSdiNqFhBA0",
-	2053: "mlSElrdJHflkpEalAElHpn2J41gsFvVYzWFyukz8Utk",
-	387:  "H2sIsrHAHqPqG1n37ekM6NRZHt0FHafklENcXicVtNY",
-	1598: "UDltn9heFuTctAz0Pxf+GliQc523RHyWQWUCvu5S0Vc",
-	2806: "BzujmkWvfyEtT1VSDVIbjSpOnHdPPsPT9hFbYl7g0Pg",
-	472:  "JYUzlUD4yHJLoJY9uC3C3Cfg0L91pLKMlg5MVEnnT0A",
-	2393: "tBJsaYkHU43wYZOAJ9UVt0w4Jfw2zH5wlFRlkVVo54Q",
-	1144: "h0oiZ/Vs3rJjKANXIAQPpmjnvtdMMkNEsd4dFGTN6os",
-	2518: "aM4MSWHcLyK61/qU7pQBEv1WkgFM6o/7PtnqsigY7aQ",
-	601:  "SjGhnZ447AkTZYwCgVoC10E/dD2cxA9jgygNz2lZ4pk",
-	1847: "aDMGqqcb7YcC3b0lOAPeWbXDljlRQKyQChz+gapUSWw",
-	2364: "n8okmFsWJ9U+iR5AXCOABc0TVycND/INIJHExG7jCPw",
-	637:  "S/GwhYGtRQgt3D1dkIiydNJQksuQiIyE++dyV+rfji0",
-	1535: "MysZ5Z9H9VV/btDxGfA1f+CoHpJ2BMQkkLLNijSc9WI",
-	1438: "ScEZxmK+YH4UwGOkXUe8//ueIhoj1r6sH+s7JCP3Bcg",
-	548:  "UsPgw/VGHLodbDmH2hLu7eOOz1MM9PQ5hudmwMS+o/A",
-	3539: "sfhnNq0jtIsLE94y7+VaaVS33JldKn+RBwT7XXNneqw",
-	2210: "9EHTPksWfkq9WURRLaLoxUsDGBg8qUeGWs8gEU9euLA",
-	724:  "KbYty6w5CYGSE2D/AIYaoPCxGQbyLieEvYWjjiPZwkY",
-	2624: "sLFouNNU3RfOJPhHucSyst+oYd13cZRi+/qx5V8DBRo",
-	2611: "QsLi6BbBWWQvt+h1kzc43l3Hfuxj65jA5A4qoBBmZ5A",
-	3633: "0AG5po3CBTAlyebwQ63Ohg0i+qxForSs58BlAZD24ZA",
-	963:  "+Yqsxi/RDUoF29K2mQocha1srFa86hgiIz/5ZzwKdrI",
-	3608: "5udCsBMwBjut5YVvXyeu2QnqEWVt2F8H75BCZzGbjjc",
-	351:  "XlnbNxx8YOlQz6LHUb8fGRdEdwWNHiyv+u7+cyCvELc",
-	586:  "nDM/QjZFjN5qZPOLaz3ruyKIyQqdqzuTpPkqQbGkHs0",
-	3766: "jKAMw0C46EGH60G6xdmLdD8J/Cwa1WBKMuil0WTtukw",
-	1093: "9zyhJfT9/amt6tQSGmwmKOEof3cduWRktXf5Sirsp0o",
-	397:  "492LAqqlEM3Uoo2mnpwKS0zAqx/rn99vFGf5Az74890",
-	603:  "VQt81ukfYdncPslAHGXtyzRsj/VPUAwkkAq0PauUH0o",
-	3561: "0rLhZ3uOVOiivyKqRK7tzZbuXbbuDOFnWMB6cs5QnBo",
-	3437: "5ILeom0p5BF2t4oYqqLdlIGOAUJypIgF/GZCcO3sS78",
-	3165: "QlivfKPXnxjQSzYYQsZdF+UXLikXDmBEQ4arNMD2sYk",
-	1313: "dZ4nmywpfidGoO8aF3S8P6Sq5kO4eONMlGcoyC1Xa7g",
-	2564: "3iIsiicCzihEL96w/zl0pCX/bXKs53T0KcjHOoPo4Xo",
-	3068: "57P2PZ74nBrMCdXOzMLTIsggxG4fdumci4UiTrM62f8",
-	1957: "khmv+omPZkN9EJIUIWVNXeSMyVK9DrWTM8dolbOjjrU",
-	891:  "qbbyhUkmPEZyBaMySwQG/QMMqST+R4IcG0v9n1RqLkU",
-	1096: "TAtPWQWSOg2YgQpIfLiscnpGJkAEzdEX6lxUx8/ji4Q",
-	3012: "dAFEOiA5bEGAHJoNIfHNFsu5+sJuS53v/gom5F0hrPw",
-	1662: "Df91btmjAueSeGhhvaWcGEMRe3YZROnxmnadLSRC9PE",
-	1329: "dxcSXw5rUt0nzqjcmeFRtUKeB9tP+POi8O5wtR8RlkQ",
-	1289: "YwKrkcbs15xuWVQ9RxRCv3Qx0Qeia0N+aOd7XTNkhxU",
-	1795: "dmsecVBE5Rhn9FTjFZoZ3D5HHA4HOxRunpKyoF05GpM",
-	1443: "QQuq7GTuoQBj4GCivKqFQpj+Ezk/xobMO36bDwTmEWc",
-	1578: "h5LYxYqU7oGFaMMR+RiZIWAa7j3xS/k71rS6nQO3tmQ",
-	3037: "wIO/ujZsTlLzb3vnuLyEO6+wlFceIgNiwj8iz8+829U",
-	1829: "MPmY4xSLeqUhJrD59kbu1D8bU4Kf4g3rbHXB0+7lNN0",
-	1938: "C9l30YcznWtrXi0d230dJHkRwRNJ0tKtPH4bDApqeP4",
-	3169: "6bXwYNioUKd+QAau7tMpbsDsHryJUlG3qruX50gWr64",
-	2853: "qcOM6f7guOq0Y1GZsalGI8fJSpj+II84UQEV7cx/who",
-	1315: "IH02uQT+DiaDJ/swXZeG2OHw3rmJAygStJEUhKUI3Sg",
-	3024: "gYc3FFpvxVlaBhlpIhyvQtYsHudI0p5zLZAB4uZ2otA",
-	1451: "zJBP6+deirD4pRbg/2EpdT8GeFb14vf2zTP/nPsIAUA",
-	231:  "SRwR44YYQ3EMbz/uPCIdB7DZYX4Htklwp/L3xKsTYB0",
-	497:  "94I4xMTIBpufPFSIesstkwNmmlLLFPqgpCGtgfwERjg",
-	3801: "4zsGNM8K4UXrBTP/WNZ3I85vD8uYpvXnwYGl30Vl9CA",
-	1757: "voSP+957SNvQ6+tzWCeXBeGqmakj8GF/IbQFextkeJk",
-	3616: "uKf4GwVD8mJaJtbZKV0GDI0y9bfrUsYSG7EzigW1DFc",
-	485:  "TxYAAZCXz2RnkXcl+ZhdujEBIqQDKOWdUcPt6JafqnQ",
-	3567: "1d+Fay8qiSXWFmtciwvvjK6u5KVTX4/oe2OAXJwY6I8",
-	338:  "yZPj04jhVuGmVXbiRYWaJsUPpppZoFwnC1DX+cms/0Y",
-	792:  "bXyNMqztBFkG8nCaVIFbNjayyi+zcUvUB/HcgH7Cg/M",
-	3986: "3iS1zRLhhPAN8fBilgsCn1jOKQJY6i3kTJ8GW1+/x0o",
-	2157: "UdCqTnW98OgxgKl1PF1WRhRAyBbc3qLLWhi4G9dg0ZI",
-	3988: "PytTNAdTmSEgK8Eo+1GSQeH43+5jN6Hy7r5PeOUqK9k",
-	1619: "ITkXauQJ60wGMQG5IdeVe59e7QS3N0jh9wQtRqP8vXc",
-	2409: "65W7LixY19KYa+G/40S81jpjXed3RQ67QWo8VZOmMYQ",
-	3899: "tuWnGFWXVFZYKJQ8aPir0QxoPRzBf2WUP1ryDKY7mRI",
-	1901: "APL8Er5sBhJftRRlWmcn4vu1xQlyYCrCWdp2/l2RCQM",
-	1948: "mxEA8j0ObwMJB/62n6f1o42zpzE3tZs3bNCVAtZ2l/A",
-	3484: "nmSkKWBG6uVzPgP4HNduiQIsMPlRAMj8L5Pe+wFdaQQ",
-	1051: "ZobZIVrjoe+WhV1K6i7chF+9BvAOjaHUFq2YqoQjsEg",
-	3488: "sREv0KH6ZlvQ+pZXi43PlKlx+m1Xzmt45gorCDD2+ng",
-	469:  "RyHgg/yrBAXJ6SKzUHJo86boHIxY7S3OOs4qirUeY0c",
-	2330: "PPyNiTeUl43QDYA3lMhIZdiHPdWumosil8kjLJY0jso",
-	3161: "M4TmvBhOHZRvskbjSVF+nilitFDkxi1A8LqLW2y78zs",
-	1951: "6YGwaEiKdFcpHI4MbQpqFSMm6Gg3bkAM6w19Mubw6z8",
-	2359: "INua5CUKYgn6p/YspLdonJs52DeDqkdKnXeq2p8rXR8",
-	636:  "+D342hlTmnAnO36BrRm43kxB6LvgHZbUNjn9kApsiik",
-	2097: "80XKL3h1uszg5rUU74prpyiEmtzaee+DweXLcZNwFL8",
-	1299: "Bsp0hvNSIkceXL9e7wbN24qcWBR2BGR7Ti9zHCW4NLg",
-	1981: "H1ceex7ruMTzKoQU9mC+MEPaj6MDJdzxR5W8hJLbSPg",
-	2375: "KWBS5QToX0AkU1NUTep6yBNPmtxC9dH9VGsSM9s0E3o",
-	3995: "LAbXRKX75dq0u4Jp6nmkUltGM44IOGkAYdyZSHbkXVE",
-	3600: "4CKLnq3HGUAwBQxfUlLmm1aw2O/+n4mCTHEwDbnOPxY",
-	3520: "R8yzLgHB/sivdcGqxqE8w2bUJUifdk+HmH4Ym5E9CJo",
-	800:  "he+mQ6tE7YUp0BGwG6AsVkZSNaOFWp0kFXrLiYZgzJM",
-	599:  "P/fsPcAAVrXdVVQFzY+CY9Flxc+MuPmT5l+hhGjxLiE",
-	3632: "96KXELbq5gT36wIuwJmRfI88OjDUOFGfSu9Se3hfeF0",
-	1929: "QM6xVBBKvcPTG61v+0JFyRQ4HgYSzD0zLb1HGHoqpMA",
-	3146: "6UvoCijsuqxaIhu9YrTv3hYZAGxaCK/MwG037mP7zOk",
-	2429: "bWaSsdxiyZWS+HUVbhZgpa5VloyUMvGV4pP5d2VTvKM",
-	1431: "k7r5Xh68nn5qzn89oCs+qCKBLfqQybC0XOtSfwUZQvE",
-	1479: "BqY1fj/ymQ0g6J0mxaYU6PVahky+b1j2aPazlVij9LM",
-	2942: "h9vx/PImX9V0u/N3IoBN9AuzT2xJ7S/V28I47eL6xQE",
-	2687: "kDwZZBsTdzpa8/gjIfmSQIx/dCbPpUGfdLKc+gPp094",
-	1334: "cHYkWTTJ1WkMVJ0wycs3uwq5QRdzv0r3UNdxAw75RUQ",
-	1225: "yt+Zp2lzyg3vlxTy/UGgCMwOGOpaQs62ekGSZk7Jyf8",
-	3980: "Ecu8WRpkwIQ8qUaz+oYZB4t/EQJRQXNkYJLg5Ae6Kb8",
-	1686: "eMC+pN8HSHnzmCOqKiAgxPoWNIFRSEat422vIy4t3fQ",
-	1156: "05WMIgEiWisFTtTRXE4ThvGCY7YSJGmKYgeMLuKktRI",
-	68:   "ZRxiYlSg0CrdWngG3BhpNtSrSf6CY8xQZRifGKMSc2A",
-	1781: "lEBZtmmzYH6SYSXi3sIrurIgmGtjR8MdBlxGuQgNlNk",
-	3425: "RuMg617gNIsiaEu3vlix/6gzFEf0oxZBr7Way9YYZ6M",
-	2652: "UXTNBYSDQJhdY3QPf7N+qYcTNmITNB+xifL3Hi9xwFM",
-	3083: "l+eGmOhjE7SwLLhG3uUbK7bH5kyCceJC6dYpwyibmDY",
-	3441: "sCMaMc7NN/b4y43gcNShHm5xyjnoWY8cwLr0cLPYkJY",
-	1909: "f3MakIcTLVgdfhLHVSV2W0nw6T6xxC8F98BHdCCojjU",
-	3231: "rdG1Q3jrIRatfjwAGUjtReKZkPjIQ7fMEplUSXuFzw8",
-	3672: "05FBEtRLuDNI6hzpMaBF1kQVnD7xvjcp56q134YnUDc",
-	2437: "bQvMPTVuzcdRkr6iEB8Y4cl8DzoVnoYuUaxXgmX+UB4",
-	1830: "Akrkw87t7kK/rDOfRP3dPW9gz36NwdGy4P5sNeUhrB8",
-	3227: "j2c1gKYag5tU23eo559ALHAQ++w1GkHwllEw5F9S+b0",
-	443:  "fS2QDFYNjgqdajfRefF0B8+8whqQvs1xdzuR42XVFC0",
-	942:  "/1gYr4CHMJUZpq0L708rOtcPikAIm7M/uGV1kx0c2V8",
-	827:  "CjjyD6x/v1gbWf/ht9tqIxLiAUE9jgvda+fO0WfuttY",
-	1209: "vjO0hYab/q+RsbIHIsduLrZBBZKgnbwT3zFtsmLJ4hA",
-	2636: "MLEG+ausgr1IPkoKV5jHkhaFd/ukPq89nRT5PDbpiwY",
-	2849: "Qu/LmrzN/mjLqJfJvA6/vJ33qUP/Ic9ePOSfXQtP338",
-	3509: "hJpzGoCQU3saQLuPv9PvsVU/HixiYgFDwY2BB4633Qs",
-	3798: "Rp9NfaX9bdudNu2r0+d3u3s/DmPddwWbV4UmKmZQoBo",
-	2272: "dAEmslhmYZ4C0NVrSW3puoiOW0xGQwqkF/QoFPk88IU",
-	3348: "MNNKYczxOpgM0DawBlbkO2OfroM/5fB6+mHqanaPl4o",
-	1976: "FmrvwXET7gpvq1c1vbRPVV3Wea5IMF06WjcdHiuhuho",
-	3346: "7tt6oBPcMdFUc2PIy2WKtyjkQJ1sVFhvGYhfpKgwWNk",
-	1546: "7A1lN7Pfme348MwLonIDiREzITjs4LuhVFLmQGT3aE0",
-	1237: "zgXmccgeRCU1Ih5k+cLKdtEifrcV4w+ynzdd1TgwlqE",
-	783:  "LyBxBmsGYjyI6Q6fbZs008mKjuWl0nc0Fm0PpjQ243I",
-	2673: "qmbMInoTlV9+VvrWMjiSvsPio2QaVzf2VeEB0bR+pus",
-	657:  "aQs5VK5buTI7Bw9i2xgbKJSYIe/A8fRosrfUtdKX6+w",
-	736:  "xu0r+hhoAd087wjPBuuoZUIqPbiosDWDoKu+e6c1d4U",
-	3198: "xXWohXoIq2KWO8UuqlRweW7hkDHON+TqQrhEW8D9LQI",
-	3377: "NGCm1bt/YbhBN663C0xItKh/pFhgn7t/fPK3u5s4aMo",
-	255:  "0KImjDKP2/MRuq+O43vHR5UXgr7KNanNq6X3asd7BN4",
-	84:   "38hZQZra/u1HZpzuHRNVXw3JU/06pQH42fnh3BzYXJk",
-	1049: "kInXXlxvL8z1xTDs23JNjw4ca+7MkATWw+1Bw0mzz6I",
-	658:  "UleN58CIZbZ+pTxsnZCT5L477q5kHaPfF/ovwaIThNo",
-	2355: "znTC7Lb170lyeSDmduG7PPV8UEFzO29rFMUjOOioGxc",
-	312:  "v9YrWRkBLDlC9GLfzA7CVPinuMsWaOT2zS/+5TEnqQE",
-	154:  "f0FHGYNmAdx5FcooA2DeUt0jMpzcZluOieq0SQip54g",
-	3594: "uF7BHxiifH4f6pko8FYP2oYOaXGqssKzC74KMCRM408",
-	354:  "Y8gYw1hGzoeVWCQ7sOuNAuY7H5niZnvjQhRVXPYHP30",
-	3295: "2rEOD3xORVCUjyxFMcK/RWV2d8v4cUl8OPXBstpEg30",
-	237:  "FDr4pGQPT4K7sL9PP6QsfjUJndC6IOjuz5y0AK6KDNU",
-	3605: "6T5tJBupz6o6Xnpc+flmrQ+7ZJmb7ErE74o6g1SMAfM",
-	1777: "P1R6ktFBVCTq6IyiPGAE87TiLgiEKLKxqCR3DrkO8ZE",
-	3953: "8db0la5mha405XUX4lttbz0xmev6CbPs6O0T5GfcMhc",
-	1716: "6AWkzFTqZAhhCDt74O3oXOCB3GtgTOlQBOWqmKDdtrU",
-	510:  "NH7CuxpBQruZ9glupS2ack8OWfjik0bcmtEC/cOe8Gg",
-	865:  "aiwjyVzLZd+7wQ8fPmnx/ZYe4VTVkk/0Ta0YBRkPMDc",
-	3023: "UvJCPmqf5huFVXc3TKJlML3VjtCHJDTzdci0IUO4jhA",
-	725:  "mGqU7J1dizDA+RmvnZDPLfEzlJAL+9cxHKlKP21qGpw",
-	3366: "XfOjUQyiPFqmi2CPR4nInmYsHkd76Y9AJR+kh0CFoWM",
-	2897: "+rOmCNx0dFGvWwDnQyhBqztyee1xW40GmZL98J0GvmE",
-	3497: "aSrd7UeEX25dK1heetnobolSVUrDcuzSvJBxqso/mT0",
-	1331: "E9WEFpyF1tySoHtaUy8CvA03OykS11bMQK7cZTiYXGA",
-	3215: "mXzRoKFvLw7t3u3rxMdAl9log3m0+j8fm2J7dXAy/Yo",
-	981:  "xlpsEkGvC7tzTkCV2w4bzMDFwkKtZV4x+sVpEruUJh0",
-	2684: "z19B5yP7rG3XYuuYMAOBGMI1NwNeoDIt685e+UbbO7Q",
-	1177: "O5PwK6WYuvONRyxJ4uSj1u1YLbf9FLMtJXQcuXk6Hk4",
-	440:  "RLYpPsgSzJVASyRN1g1DpUOTKAUADxvibRlNIHuDe6A",
-	1849: "IUGF+z/KgxFLQ3MtKFx/wKxfE2BlTN40NrBRLkm1HNs",
-	3438: "BgHtnKtXza4g8jiuMCZlTV1wL6p97Vm5Dy5qmx8OqD8",
-	271:  "aUiHMYSvWl9Kd4/9jgM5RcRjjR3R9mQr6ldA+thFXl8",
-	3586: "tZH2HMfL6QOoTSs8RI3rs3hWwQ8VdUMsy6xX1B9oM3g",
-	1278: "ejgtUunrqZdjaCzXA8VpmQaWIdgmP9aK5r/mZdaRFWs",
-	42:   "cfTDO5Xb11AQcHi6yWWFDjfKfBD5bosMgyfRZuVw0Ts",
-	3713: "IUuJpyxPEGLmmqDVPLc0BJkRFo/vo2c/tjPhjZy5opg",
-	375:  "TMpCZPA/PKSJ29Z8eq1a2z07ZrS9GWEyOj6rUdk21qw",
-	3527: "u1oIlv3eR4i3C5DOIB4Vc4xNffoKxxMLeyKQso7w9T8",
-	1803: "IaJKDZ1WofNK5p8BJF9AAU7/OchtDvl7LkT1bw/+6hA",
-	1020: "5HVo2kOuHJqFHz6u+klzoxNWKe8ikPDRL9g5RUJifbQ",
-	1026: "5HZuOF24eo4DZRrYQHm5Lg8TgY0bDkZBwS3x1tzEH1I",
-	1618: "ieMfpPsrnnr7If3gc8CKKKzbuPzD7p5o+zkvOUO28dY",
-	235:  "6/EUtRnq7wkF4x7XqZpwFyeyZVXpzIC40gZhkhaYb4g",
-	3676: "04xsypH0c2pOh2xWibbAkOsmszwl5EX4qozArcqvZRo",
-	3665: "aXrBPFn9hInFMsMA81xcNbLGMXqenFo7qDMpiNtnwds",
-	2012: "XoRivVAGkzX7YxaoVSDTGvjO7XlxM4ikJiwmTHS5i+A",
-	3282: "F2IDHMx8GAZ/3a7iqz09cyXk4MleA5Xoh5aUFOetM9w",
-	331:  "Yc2PiPKIiHa2oL64K/JWTNSRxXvjq6YDMVfNHHpfeek",
-	2123: "Hg7ovJQJ0UxOfOsMZCnHdIWs965BUhIZ3ROApco3d0g",
-	2412: "dwmHzb7Zzrpqlrj63TGFJb51Y0G5815swEVmcPE93VQ",
-	1057: "qzc0ZG6lHjc1PzO48V0gaRh0KHAzKHvZb4mR0+xZtGE",
-	1632: "+GLRTz4k+aItNXAyYz8MgAV6E5uOcpH7xzFWUdzVXxg",
-	407:  "ZBwfJ0zM1uiHy9EZmTwQzBKlrowzSN7W7ZbUaUMPI00",
-	1579: "AQbOBGlKYJk1OKYSGahvnv/ZgVeqs4EySHA++TltEfU",
-	1264: "keZGuqPiNHfDeGKMnlkHOk4ONP7rjAVo92IHE2adyqA",
-	989:  "gjDV7Al5NzymFFwm9l2t7hcEudmBrg+QfvejwtFbmxw",
-	2745: "02zREvg1lnuSFDc7ALdB9Fp951DulOXVRt5XnTkKzrs",
-	3094: "3Kzw9bOJR5g95xTdsi03BraG3NVXdiRXn8KBO0T7eVg",
-	3216: "s/PH3xOzGMLK9vWbX+Fk5fs/4Wyybe8kt1qTBYmxRaw",
-	2478: "Cb7HM7HSLDp4EVc+r03WEnXtOqfGqKYTe3pRYIcRIj4",
-	2764: "K251u2ECkNClnHhxdQvdtKhZIF/uGw0J+IdLOVjgehw",
-	3450: "PiFndTZHfr1tXSPFOZQkoOAKMpfDboToUqKMGQrpEZY",
-	2520: "dOxqrPjCdggWcTAl3IIxOnAF5S+bkTVu4XXlmLTd4nw",
-	2618: "w5mi47DQDVUQlh0RUFHMAuevvyQyI/LRcqn7Itkp8DM",
-	1993: "OVOJowr6QyJ+WbG99glQzHyqLgYO7BZcstz/91rbKH0",
-	1198: "BuUzlnYp4bDDiF/t54JvIISotsgbVFJ8Grr/q+LP7uI",
-	3182: "727lf4lMwuyVQIsTiOpRfBLSf9DbtVTlmuy2N+iawkE",
-	2290: "37Xd0SbZETjyjV64/o9BcIt6ReyBd1KXQCHnqKDlLvA",
-	12:   "yRmNV5C/AAOaLF0i2F/fbTtvNWsiBu96IRMROK6B5Fs",
-	2982: "RYHz9YM4YFL2MArCY4gEnpR7vwgoKqOdgvah5UWSSoM",
-	1032: "8ziOCR5s7AjyhU+jiV87Nt65kLUicDEDGrg1OLCZ8Yw",
-	2959: "KX1SuKIWYs0AR+BmhMpgP2Z04FFCZcjZ5fWutfvadqA",
-	1679: "nEo6JoYkiogQGNXhFl2zue+wuWNmC1e5qm7o8T2Rq6g",
-	1399: "JQtWBpTG/gWOyyamakOjhPNGzuF0dfYDHyR/P+L8ZCE",
-	3395: "r2SCVHWuZHzlhuRB86Hepol6xrqnwcRw6SgWGvYYPbo",
-	1344: "QI288cr75KBQy0EeuN0fXMQTU7kijXasRhmZ3P/TD8M",
-	1778: "kvgbDotrEeTkOR3r5mrPE7mlF2IRSsmFgE5tDAgV/6g",
-	169:  "QnvDyvxwdaxFIWo9ZSTdhhTadjV9uFpSBLha7Gbutkk",
-	3693: "5GMDky8rDit6Nvyc7ad2cMzmvDhxDjDtJ8ax1WM6k6M",
-	90:   "4OAfRbeoIM7rMg6Lty8qiFaPyXGV6SgkVdUTsCMGzfA",
-	3883: "vCUUN7oh9tUVCWlX+u3YPm797sx5CID6Ajfs6X2v5NI",
-	1658: "joCsl4jwA2eR/BBNJRhnAs/PxxvREKQSkZa3/Q+MpI8",
-	3148: "yfliz+8Q0o6vZ3sMwwHoHAe3AFS8hBTTIZFyS3OTKs4",
-	3422: "7FxiJK3VJXTXt6LZNWCwS4QmZCFn2nLwORVoXMvPh3w",
-	1175: "YkPftfhirZ66OuTkUNbWw0Ka8h3JotVbGXVFeYMvYS0",
-	3835: "8HGw97I8u1t3v1we6WQdzYOq5LyhfUYaZ3TRpla1Mmw",
-	3778: "KD1MIkCDlQ8kZR2Yn4a6wGW22LPLjB3ss7/0FjCl1Hw",
-	3301: "zAi9DQiHFAWZdm9YiTlVOPdEOs2rCpQsmIm7J6Gzo7A",
-	2021: "iqevmfbM2R05ND/EP9zv09Fd4jZ31phxyBaqj3e0r8E",
-	1499: "OmrmiGnbAb9fd2dW0LDYKuhZp5tioBxAPpqV+TQ/jiY",
-	2399: "VKa7h/rSnh4EKvPCTh7faRfOVs6I8C46DIOXLOh9FXY",
-	3929: "L2r1gzmYVvWe4vdTkDctzR8ej1wlEbjAgnqAXusXD1I",
-	295:  "Q+W83NPfITDIvCW1ZXRS+sw4BKXkoT/DXJAb2NfybTU",
-	418:  "zdA8tGXYnlnWLsf9zX4qwwKgBwgYhri8UXKPQ7mipH8",
-	1633: "PK2BeDY7fXmR9Brm8rLJM4/Qas5+V2oIrTgxRZ3XRuY",
-	3407: "qkWcddnGv6F4DjZ3+383Axt4c9T+C8iCd8U/T8EviuM",
-	51:   "1M0SjJxUqPQK+cQK9g1589riP8vJtJOouTJk0Pjxj4E",
-	2511: "IJJ3eXWOkIN6rdaw2VRn0YkmGb8UDHMvP83kGAfXmQU",
-	1659: "wRG7FBB+6B6lBP2UaiYD1VhPobGGXjSBjR1mZvL1t7A",
-	3647: "A3Ucq3pwObqCuxSbG8416ccPOb1lbwzXy1L24nLit4Y",
-	3114: "S4tQhq1TNz0Y9LUvj780onL5VujrypFjm8qNJDHmNzE",
-	1906: "md6I4HkDeOv5lIG1L5ZG5nlgEabeTslNBKkP+fqJ4o0",
-	937:  "K2iepfLoBbS2p+sVgqXpgPHy+Tw/DByKUacBQXs+6WQ",
-	1673: "swH2qY/cqbdzY7GZA0faYGcGEHB3ExAexq6X2N6VKrk",
-	2092: "KC8P7ACSto85qx+i16Bz80VYMPeOj1MdMGE4wQaNa5g",
-	2177: "s1d26ondD1nZ7BrETJ0+GBvosUxyEKc3Klu5GF4Gvz4",
-	1050: "Pwp3gUgFYDB/9YY0knMZO+Se2vqxL5IcENy/pibBW3I",
-	33:   "umRE4QgJLqn5zTsH0iN7NuQIxuZalxPbyRs1lO4AcYQ",
-	2919: "TbKrEuljM5ErBbcoRZBUXJboq0jIFGgwZgXrm0CM3Vs",
-	3091: "tO8KGZ76xPZgBEwdou9CBHYqAMhBoVbkNP9HaudrGto",
-	726:  "H/A78yKGrHbO//eQIysNEvJe6cKGjEkrR0puSFLSwDY",
-	944:  "5gFRx3Nw7ZAqjKYnj50qpFNnMSRjej0lReYWfDZcFac",
-	1727: "s5Q5imluc+3y+SEMDHT9THOC3LB2GQo7dQl3/QWV86w",
-	2414: "5SCO3HVtDyu55GAVbMyqEIMjBR0oBZkuo7jyBC/F61Q",
-	160:  "QycKaiaeY1q0XDY3/53T0PoamwzSnEb2cIAlcNUfzgc",
-	3136: "2UONd83+v+uTCoKeiqsEBNjnGA1m4vHO/2ciqr5ewRM",
-	3754: "bbHpRX+j+yvOz0doEBFy+DsYmZYcHht68w6v9FAoDSM",
-	656:  "Zpn51Ad47mt0PpPbX2ChtcPVqk71dancKCQQizpJ9WY",
-	2933: "LhFFp8HSBa5au/2bbRwWsV1H5R2JZ7bx+QvOQubPz0A",
-	2625: "biAeZO8DX/TPhAlpVsIdJclMIlZP4Y+V6rtq8mJVEjU",
-	1217: "l097+wsIWmKS6rOcyZ6jBx9+hf2gV1fi+JMylTZDXpo",
-	288:  "yg85y0AJ3dM+XGN23hd39ekdTU1d2s0trwj+PnGssC4",
-	3853: "OZZAjdjfDV0OoKTQO6wvsyHT8WYZi4zLwZXSp3OXNu0",
-	1749: "oaNn3S98OazQOrA8y6pzZ5NigPqe6q/bS4Fxdim69R8",
-	200:  "AhWlwfxW8zf9Vz7Z8aekbcI+4SviA/aKSL9UPSIaA/Q",
-	1700: "2TpQHA1T8PZPtNS7tyg1CrNz1jAXGnm0SwofekS9slo",
-	841:  "1y30dUT2mjT0PQsPGTBzn1trOPBiR71PxIGqXHFafvw",
-	3482: "stGuAdhjC0di/sg7GGDUA7dqrspbHl96igTX3QMKEZ0",
-	1446: "41N3IpPz5AaD1V1PZbk4mIqycd1DwYStxmpArcfKP7E",
-	448:  "q5K59oCK3uFzUgdeJLlDwOn6wbLxtWK0OzYGSDCPMvU",
-	1774: "VPcc5CfiXAnH8llYoHQv+AgHXwmsEbURZzJktFw1SSs",
-	1746: "Z4pIwgUs8bKEpD0D8WusVj5Ek045Dusd3xlU6WnZOpQ",
-	112:  "iANFEvy1Wc+1dx/C7/bqCODWsrlE6h0xb1jC5onltck",
-	2854: "/XMhtgiFMxp3/k9blWmNahKOOacnj3gP2XiqBhDqcLQ",
-	2957: "p/wSJ/SOQ2oKBP5pZ4suWyo8uXs3w/FHZedMYYE2W6g",
-	2724: "6J4TpXrrhW1WqkoaPfsyQALkHyP1pp/utacO3qSzNRU",
-	3184: "Otaxib707vr1KUVEq79dr7jN77PZk5bC/wXYnooPMb8",
-	2291: "kKeycW0LGhSx/LY6VuePCJRtJ2FjSDflHIbtYGfBtYA",
-	3974: "shOlSaKIW5aK6bVsDG7y/O7EJpfaR8OWsq5l9YVVyzA",
-	3314: "Ii3gm9SDu+XcCkvoXY4dmK6Pd0ZEht3HxZ7N4SbB2q4",
-	3727: "+reKfD7E1Y474uJCvGCQMHC7SKvme0+ZlSwN9Ujsoxk",
-	2279: "SE+HSEGxuUmou7lFaX6nE7AbiStpL049OaFEjuZUXVo",
-	22:   "/XmvE5+jj80Y+VOtHkDMa1VgRS77BFUe1Qgm2KyjuEw",
-	1565: "o62slO7TU0GnMUI4RMS9Yk5bGMm8MlcRJfuVozAyZhA",
-	3237: "1XU0hExtrk49AN5y34YcxYcSWdjNYNGoCTWHiNxdYhQ",
-	2052: "h/YK+DD9CQsnFwOi9TlnkrlyXs9cVh+oQQ5tAgoWW8g",
-	2182: "FpHxhsdYSR4jZ0vtQKZ5ri7Ip2GVGcaLwCdBEOLX6VY",
-	2512: "G12uSAY+PNr8aGyhqVAzXPQxiT6jcm5GSJnyT06WjhI",
-	3828: "gvBYpteGO9OeIyGFI6q77LAb3Y76wYj412qzRVyPAaw",
-	230:  "kX2VjolzGejG7scCzHmwIbk2Gu7IjtqwErIbZGgNm8E",
-	1448: "d2KOruimcIEdsiBJa1yGXE4uK/dhXA9UxQdJ/GFBSng",
-	2400: "M1buirKH758PgvOIgzxUvqkzsJQpwTr1xcaIyM6l8Ew",
-	3397: "i5/NituPGF3Bj88YdGMsokn+QpNo630XxmAatwCws/0",
-	1614: "uuXRTJbr4GTJT/USxR8Qq/tfH3dAYO97fC+IgN2ii84",
-	803:  "RtxSmi8tmIs1Q2nSD+ueq60iz8GLflyltvSRnP20aYU",
-	2270: "I2fYgyLxWm+4PsHGySIgqitAAW/xPh8MABqSgd3NNUc",
-	1003: "hFFbO6TN3BRVmVWtxIhbjrNkBcFzBCvoooT6+i465y0",
-	533:  "iHbN4yP6tYxXnTdFV1SB0Q7AoJtZhuehit2YLoNCOmg",
-	2567: "cLYBQ9vwH6phR1Gn70m/ILen9oRs0Kn1Dmvi8wltr+0",
-	1492: "fo+1/eXpfUjdGqQSeKkgg0TIwmrETG4sDf1rqlNMczI",
-	705:  "xMHxyLwpEf8UIzpVppdM1ZB4UoFhNhypLU69pCheH48",
-	704:  "XGnp/QjIvDdHp92z0OPMy5YWj4Sf3t17otJnrGniyHE",
-	1672: "/MvDbYfpPKROISnW80MKpm81KLRDeC+6tC+6qhvFjpU",
-	168:  "jGEHdavLbbieJzuV44bqet4y+fFjLek2SZcMFBWRaJ0",
-	1918: "UwHHF0mpAhdfVIMFpvWRBJA+LIfG3fZJPiET3sW11dk",
-	625:  "T1uID/994owuHYkD78Kf6vHdY+HORB1S+d8QoPCl1+8",
-	1410: "OZ6ZAnWIIS+X/0dK85PywMJNx4xur4tDnOfu7mb0ENk",
-	2532: "dvfUJ/bH760OX0mDrbekZZV8zSyjct+H0hssU/DSDKA",
-	1035: "9e17xS6n4MQh74BqoG5D6IorZxpFSphthTCCOxJlJ38",
-	3390: "mf2HZAYg+yInc0DvUiscsQUx0UnxBXQkwcgADkADnQo",
-	1666: "0vs2HP2d7exgGLIRc6ujKNoF9jl6VZIuvBB9JKAz13o",
-	1707: "Nilxtl8yX2Bwdce36XhQ07x5zRogq6MdJhO0o7WKFz8",
-	3196: "EEM6MP9llrLrDmb3WEbtd6nFNTG0cdZCeSNsXRxW0KM",
-	1731: "lfpzV91uFXSAhKMq30izHQkdV1S7iIwq0mDQ0UiD2Cg",
-	717:  "4imVTfBYI5pVMAeyIP2m6vjP5yVotOfvxhqw3VLDERs",
-	2728: "RgxACukbkCCzaHvTbUxx08sV4a4ZGpd6FQfijIvB2ZQ",
-	1369: "umbah/+/WETTwNjLGSB7jxG88CFy44VCZP/yKcoPf/o",
-	3242: "dpBXWYdyw4yK91JTB/4S2OsnjVLGe/uyT1fkPdxGD8k",
-	2651: "KNzVTIkO1E8rIC//51zckWadJiSQ/OVNC+KCDDZ9j8A",
-	2910: "5F0QhFBZuCxxdBdfx8qf4ptomKOGPpqdL+xC/kEs1Ag",
-	906:  "++WNLVtBasrtm5i6K6ItLSvUtS6Lsg4U32smfbIXzC8",
-	365:  "immd/4bOGoIbL0pHlNO5no6WSSL1yePh1qaDGbfYapM",
-	465:  "Ky+Uw8eYbP4WD7b+KqrAZ0vfI/CjaAlX7bINFXk7kgc",
-	3596: "vLZ2n8VXVBCG1xMapSCbD8f+HsW+ikT7a7/ZXLt8V+E",
-	3343: "tdJHPrMOppYRph5s60UUubPtrH7eiS34OrqWwZja49s",
-	1047: "Stf+j0pckrWUxkNrdMy73V1RibPR/mV0QD3BZmW6isw",
-	3851: "EFYa+9Ta7KI3PNYqgf7yN0wTHsqJMmnyoKAIVA1sx5E",
-	2738: "c0EJBd/Ar99LvhGcGVudpujXg95gbEyb7qTGLNkigZk",
-	1475: "VCihbDLnf16uFhvkcnKEJScD0FAfbCixkrjtyXU8Tts",
-	1014: "e/GQqvrp97VzEL8qywCcSHBcrNkgFpzhpiFHUN7OE44",
-	3794: "bJ4/IBuPIs4flXI2Qbw4IGRMPCT2hTrHMa3MO2dsFQs",
-	3188: "CYQXrESjrRIY6uJkXQtzfB67ga+fuQAW+x2mzHgZNqc",
-	1425: "0J3btqlslURBWIWo01wkraIR87euFFiBXkLjFEaLUNk",
-	3172: "OUa6VJ1VzQXsv5wxSjGIcpGFz5sG3efBdIPt750bV5I",
-	3054: "9ePy0dEQSXjV9HlbSRXnc54uFum88qc5qe5GjMhiE/U",
-	224:  "ydjIZ50+BKcGhinoV2DJFzDvi2QH0VO0RBWDA8KMe4s",
-	1030: "DWyCq86PWivqFPgN/YD8o1EE6tuGNJR1c40HwkFQmJM",
-	2462: "tsgf1ngMOvbSSFD0DNlMmBVV/Zr86OwyebdeULShQ6I",
-	2782: "l+s2IxqCZgDtSohc6XGZK2zCDlG8E5MaLM8wLvZzbZE",
-	2622: "8RDHq+Horl63c7ERkqsVBxBep6uvDx9A18gs6mPLOQI",
-	241:  "157HhMNoJYps2PIl4WV6Q1fRLTiqTpNCsTSdLzNKd6A",
-	2039: "9FduNxZ7nIv9dery0FYrB9NEPyksYYukpDA3UriNzSU",
-	2857: "ynhKhwHOzwSq6efval3TK3dMWe6tgxtqo1eZixZiNJc",
-	2118: "DP7m7g+Nz7U14eF7HeZv64CKW7lpzZ0gnSJrpcuIG3U",
-	3811: "7dky//+al/lfBIldLmuDvNcl/wsdj+LOIG5hv1/Cmys",
-	3420: "qX8gfjs0uuuaMFfhDDa8bsqOsPToFErW3+c9Avir4BQ",
-	2515: "Yli1iwbo1ReF3fErRDMBOVXFa8D98koJugPb2ZPvnEw",
-	3065: "VBv2YwWyMYJJzwqLzdT2WZ5t3l66dJ04LmJTiCAyKGk",
-	3300: "n7vZXIfV3IaXLrMnu9BK+HDsvXJtMcHSOx1fwIgYfmA",
-	406:  "B68bozV0x5DjnaZ19DASxEVExCgyd+hngENg5US+gYo",
-	3528: "rduva7TwGqJfVtMcKuOjGOBJ+MCiPHAI+9wwFrSLkyE",
-	3325: "LYkM73Gog1bJs2cEg9VsHNO2LPJohf+Jw7/0rhfyV98",
-	3095: "kadif3tg6ndHeMQ4M5VBZ/EjlGEDpDWleP5F6m3NKoE",
-	400:  "nwluZ52GU1MZlmF0Q+rrESRKSUamU/6CgqDZln75XP0",
-	797:  "1jLrhDxPpyGV16POoSQwH9S/i+gfUR/3QQ3oOs6aOJc",
-	3733: "Wyno4leLE5O8Fe/snJHgDrvVuUq3++9SL8TwUmsgYTg",
-	2529: "rOuLpP61D6Lk05oYezHd5O2xNjA/R3rOH8Mvi0NFiNA",
-	3872: "e4fbwqx4UmZlYhgCsIYt1Au27tjgxmx34Fd6ZrToG6k",
-	2183: "Zy4d1QHJ2JhMsdPfHlwNZJvx0FwHrM+dFtm9xIoCkXE",
-	3749: "aNuvRS0+RJLPbJPHhZ9tQ2TeQDi4ysjTDPfM51N2QKA",
-	1730: "EZjtwqmPlSmM20Bo/usus3W9yr62uIM5BLQ/rCuLd6Y",
-	3747: "4nLrrbFsJCGuyJZhPkxl524gs6PIYjWsTfuyP9755Mc",
-	2870: "D1UUocXCz8+4xo6DmBfUG+PytgUzQgJA+dvO8geJC9s",
-	956:  "TCsltMUNTY+WzifE7YHukhira5qokVKGm0rVDoNE9w4",
-	620:  "wMD64Y0ZVNawYVQW+bp6w6hgYdtMXT514eW83CPo48M",
-	2317: "gfefQ1+GfE5uWsX/drGkySB/BoIedbxVIK32gTtG1uo",
-	1485: "cWHRrj4xmpVexdZOvhoc+jWVdDga3pYYq21pcOBrjS8",
-	2539: "MmIYN1GQbNLEXTLrDUn9fdcoDZsnf/heSmB3/vBkQQk",
-	1260: "PXUxcf8B/ysOBNFAZfIcM0+rg15x7YZYm+/9S0U47Vk",
-	3757: "hx1VbbXRZQdOSmwW10lGUycQ3xEOjAz4LqiUEBodpiA",
-	1767: "sbg09drNEFd8FJAonVK/txrPuxnUBTOkm+xqCHRRve4",
-	2151: "p9WNMz/YTnEOwXmDS31tA1gwpgJ58tiCKztj49BpNmw",
-	3061: "OSXF1fqcYWhH70tvmeKT0dPxZ+niUZmXNR2iNyHMzEY",
-	2296: "eUvhp5bYgLMHwJM0PT2xUpAi0ZpykrUcCVixFGsV3n0",
-	838:  "TYWYbkbXzi5bYIA3tioaIeCj8WEWtYi0sNqPbQfExnQ",
-	3171: "UFclCqMlk/xlfAOCKz/rpsGCCyqtP2phSlB3sgfHN/c",
-	975:  "Y0Uac2aa3iXHmJH0NURTWGtGVUC8m/YgO0gRf0V8fy4",
-	254:  "q1iOM0TbpWg0LFk744nPZZTHYWxJt1P53geq2jOOepc",
-	1811: "ra0NFRQIzmLBV6XFvPGMQ+YwqpR2kBWlRDfF7RW7KNE",
-	2366: "072Lz2ozh/CEAfMDfhh/Bmk2C9/HKifL9FEyogwa6FA",
-	791:  "V/7m/MJBjKA4uv+YA9eB+FOgtanUQXympQI1f8FiZRM",
-	2555: "CORW8r01ihDCVmO5Zp8wfF3wZZYnQNHp+U5gd7s6poI",
-	3376: "bo49T9598ti4oVwNOGhX4dfhjlZ+2AvNr5uyl6+feuY",
-	2892: "zmqjlWPmKXkUBrqwOA2YJxm5H3WGH/U2KlynhkLVQQs",
-	1838: "gEs09i4c7xZYukotIiQLEKB9pbVdbuFqKXI6xHKTlPk",
-	291:  "A45lsV8VjHGg+4X3yXTcAEjw0RzEffQJ0GRmyHk0tC8",
-	1991: "mxpf+3ncxlYeO1yvvhaCjtQquxtZqKZB8N884C6uNKY",
-	3011: "Nhu8GTpOm5KeDhZmS4MJEn2Uxaq/NXte65QNBpcjeNI",
-	1502: "/gVOwXmfBGyiByaQoMzy4yf5S/kui2AvuYdbYT+cFx4",
-	496:  "/2KkHKw8p0/R2u9tq1ZxtYQn6CPnbXb/JWWBc+KydnY",
-	1221: "UalW+mvM+1TYRxlGM6TpO460wihOfhcMPAmV3G6P0Yc",
-	1400: "qee/TIuhAranZ+ofoTLT1p2dWfTjQAYQcw1C/Z6DObc",
-	2465: "GCYHU8uBKdj5TaGJCv9i/Ax5nARndY246pOqhm4HhSc",
-	2187: "X8/vuT+WzCGaTCO/xFNj4a0JWDmuZUDqb3XblPZ7gEI",
-	2701: "vXNwk91S/nxwUk4D/nkgWV5swPpFaOBNNMTop9yvVZs",
-	1729: "mtwf6Vhlnwz8eQS9YbarZsIG364OEY0gQavGUjGW5yk",
-	2476: "SMbl6/Wf9Zz5Sc/o/F/q30FhZkPVAvnh8fbKUXS5w6s",
-	475:  "XRpUY4X0PsYQyBeyyDHYq9nC5ssNjIp8TxnA4kyp3a8",
-	2579: "Pxb2XqBFId+yzsPMj7JBeGoWLFfty7EK6crTG3R3IT8",
-	997:  "BG3WnFv/9eS2WhPXJGgF4CN2n2JtZzK20oW473tn5NM",
-	2594: "QjVa8D5Bubo+a8LV3gH5HY4UFWbr4u0ityGgmfPKwjY",
-	2946: "HYq2WAlahddfs/Hta3k1CcLFrNJjH6bARiMUoJUj0iM",
-	3137: "qxw6ZJnZXzkSEHIjP0Zt3IgAxyjVZ0C9ruuesudUo/k",
-	1722: "su2Mxn407Eat78b+GwXmJaRnDwDZ8OndKUPeFRcpxSc",
-	2841: "tH4AcKZ7Eyuw8Ziks+WR0IZwtCnI4Zv6adalbwilFD0",
-	2544: "eVf+4GrCvD5k30krPw9mbDM4Gx/2qmKrGv9K04qZs3o",
-	102:  "oDBWGbXVyMzIzGyP0RhM+2mZ0Jn9gX9quJO6DKrja5s",
-	1068: "dXSgHGHEHFG1XcK8bzya2aypw5JRh7LdLpXYEGeQR/Q",
-	1159: "yofjBnOwXTzD8nUnQ5hFvTiZL/U/JIzdWUEZ/E/t8DY",
-	1785: "w+Zq6IHqJ9khoJsaMn/DToZCLKH8hbKUQehiPkKa7XI",
-	3501: "msnIl/Fkykxm+TeQedja/F1o0sYptQ/gUTluhuvzheA",
-	1285: "itocUwRIGfvq+ms6zkLqqzU0QFxzWvtPwYYOfyJP0GM",
-	2898: "EZf8z92FVeQ84og/OONDVQCxNEwk/ve7l/NBKDeBack",
-	2413: "IYPH1PD6ssoTnxysuZWPLIa2IF7zrnDMlMZXaEAgXmg",
-	1340: "vlvISYwLvgk5V9tPVb5Lu2TwzoqJYc3+ItFB0MVgVAk",
-	377:  "owiOgYOU+COxT4tmIRSuuUBy2i8HPoLGkibilPWV5Jw",
-	3971: "ycoj6iRgVnKuU5H4xaZZV2yuRarGpfLqBqE/dDSHto8",
-	2410: "6v/Kg20CEY0V/mRWc6+tBbXcY7ZZnDWzPTtFszAICck",
-	778:  "G/9GZQy1hT89BdnUAXZxr6WeDPnLq+l7r2LocY4UcDI",
-	54:   "6/Q0bgK0bkjN1V6uVtDj8ATaLxXBkd2pXGh7EJoXWT4",
-	2195: "T3Solrkj/JKqUDQsr/0AXgQQb17THqTaE+UrArDWwQM",
-	2129: "+b6BdhObpsaDefyjUubICIGQcgvM3Hrn98B9jMVi69c",
-	1330: "OWvO11OxsHC01qPQXEEsiN+s0me9ZLnvTF5NDL9qKEQ",
-	1444: "UmFp1WIUF4Ait6IiHgGdm+wiCab+Fp1R9v82v8xGNMY",
-	269:  "F92WrncRfhwSEv17n4jrqGPdro15NdMWhxRK+uoBy/8",
-	2941: "NQcngoIOunlBG1TnO8hoMte8UiSxcXAlrGYyxECRYvI",
-	2553: "ObCHYkyOWRC+mU6uFd9yRa1MRvu9Si49on+vVArLEv0",
-	535:  "0vb2MZo3SovkVcKeBSvadTkJGlolR3I0m5NFdYEXu20",
-	624:  "Q1u7cWkzzAHWe9wsq+7JRz3ZZ2wLLqylbHyumyr9kyQ",
-	3587: "xQOguh3ValE151eJWsZ/LKr5jz3vlwC+IrM8fFXtpeY",
-	3870: "H9hv5ewIQK6OUJrYmS3KnFxmhn+38Goooky9iEg+DmU",
-	2973: "VuZd+XvGRqoUplVWIsHh58pM+BTS2k+FBnrFIKGljr8",
-	3210: "eJLnqqhzU2ojIE0xobKKrKJgiumO6xCmWklVcpmNfqU",
-	3276: "kyz3+9GoPB3bpTgOjY8NE7CkVqlwRFfpvcLIBp0E20U",
-	2056: "eQEcZsGExQdaA+AYgFCc3DLHN82vglYrh0UzKuZZByA",
-	3590: "HxcvEhfpSug7tsApfCdCjBOxPDc3nVk1UnuN0OlhHJY",
-	1852: "2ALXmFlvz7++YL4B2N8LsMAD1QNaSy8/+KNjbWr4+qI",
-	3190: "CrYez6zKF2E+NgwCn3FlYFZ2oc1xiyeb8w4W/qJoT60",
-	1268: "pMK7Bb4rSmhuddnf+kxpPZRxgsp/zzfew5ks7Vz0q1s",
-	3606: "toZbtQBnGEh7d/7eUaRzO8/Zb6PlT8pb1jaVVIgAH5k",
-	1956: "moysKRu3K8SDw7lhi6qrEF9VQ4HeVUQc3ahWk8z9oiI",
-	3199: "2S940HaF0dmh6lyqc1XIy4KtptsKwHiPZVVE41ECESw",
-	861:  "2t8rSRKfEaM+R6mxw6dFaD5YMVandiKH6r5Jogg67p0",
-	1682: "UwaqugjWGmZM3bqL4QX1l4VRG7Y2iT+HTtG6iJwgPKQ",
-	2063: "3B+7nZr713Ad6RYoYjp0mqWnhmRjfhkndh0Aggv/Rpg",
-	1413: "Xp0hWwq2sIghsgByYFYrUJgw3U+l+BdahCizgfkf1cs",
-	1240: "6k+9F97yox7lnw+fzcGdXOdVzjJ+ndkC1RJKh4cqww4",
-	170:  "NwrlY4GJNijD5uSC5lwO4gVNV/pFvYPfG/syTefYjtk",
-	2852: "oNnH/SLCKy6RE+9/7EH1k7MSmNHARNDFMyTp+BJk6Ew",
-	3222: "1jJJHy0mcREYPBFP/qU/qQRFGpq3M3k1fx7NqbYRuco",
-	1058: "oFi2EUsFqcaQUCPc9blAviPKeaWSLnuFboUoSGVXoqg",
-	2563: "MNwpqkpnmOwqAENU+T27XeAVboR4N15V4bZWbT2TDqk",
-	3414: "Xg4SaMtui2z9PIdb8GDjQlBXkfw6PU75TPpMyvgfLZ8",
-	1094: "jAqdwkD/Srb59LXtZt+oAfCa3nrYiVWJNwSncL7O1Mk",
-	3045: "dpk8ouZ/m+Wyuj82QgqtTka9Wr+Z8XY0FMUSk/LzugI",
-	3940: "lNyAbLCT+cOzjX3+9JEjrvJRLC68OTd3sTCweWx9gDI",
-	2757: "xPsUC2K8DelgZMZCyGELcNI78xgdEuxG7aRriAkmOK8",
-	194:  "u2GKiWPnckMgivdyY7Vc8+gma3w/x4mEo0V6OrdGT6g",
-	379:  "rm+bZAn7cYCtwXEidovenBKU597Z3+UuaJlt0iSFiwY",
-	105:  "rmC21+aciarbv8wcObkM5JlDxjrkZkOBQCql7a1Oo5M",
-	2403: "jxxLo4Xaazk0dWNGTpl8lxYRg9wfmb7373g236tbVRc",
-	608:  "4DHCD7xJ6hDiTx6x4PdYlSTOqiMuHQyHb0wIvZ7EksY",
-	2769: "lFgfJ1D3VelAq3R37MaX1XTk1rqSDe5ElWRyfm7XDjA",
-	3817: "4e+f1nnvsEwkR2BdGaG+bd6+aJUOB0s9IHoQ4kdyQH4",
-	1992: "WAim0j4ly/9g0e3kKAM/Ul+mSg2O4IEXw+6SefXfcgI",
-	1218: "UGhUHaWByiwRpBdKKqKtsfYX+L22SbcXHxGdvn2Vd7c",
-	2603: "rL1JiWjjjzUPxHjnXsBK0WrIG0iaG+WYko0xmdPjwhI",
-	811:  "jGq9cWrBGS6UkrRjys77x3YDPlEiThSFJbM7wb5EvdY",
-	1477: "UPth7+wnZ61sFlEl5lBwl6vArKSv8IhbCT2OY8BphKQ",
-	697:  "i7MPBHBKwG77lGiWM01Q8LmCJkomKDVecIyyVTsBBME",
-	1186: "pqVjkLl8ZaWpiLjaAlwhEanGIQbJK7GGN6qLTQnIiig",
-	3126: "TvMJLcZHkFnMsHn6ehapkFFdFW1dpWypdmU7legcl1I",
-	3736: "i1S3YqvbAPerghjgxZiuntIWIDtnwF8lSrA4PwxZ0tk",
-	1220: "61CG20M+DNokoKCOKoy4L7ikARRuntrwuxYu/RgSFmI",
-	3559: "HmXo5h4IMljgpHZtJpe5ShMDwK/ShLRrLyALqs7jxjQ",
-	2751: "HbEjnYIygK3mXrVJ4C5yLhYmTqKiDTBl+vMkXCtzQ44",
-	2452: "Iy3S+RGbslAtAE4T7WwSuORs8dv5rDO3GBuZYbxe8io",
-	204:  "F+O1uEY65Rdacb5L1IoR0GSxfiDKxzw29NpaqyscA24",
-	1471: "QlXy9G+Y0QiLNfeW+tL7uQU071t72QfduNaAcDUpAVE",
-	2578: "O5s8K10J29eoNtyQsu/S2YYNw4yNCgFG6iWU/xRdtDY",
-	165:  "KjcfTkH7G4s4kLwmbjUcoWa/9DC3rLepNPU5kSzNBII",
-	544:  "hYHZQ2+fHAoNahdIqfrGFKOI2v9d2vTXjAcRycmjTho",
-	2987: "Zmle6sAF2iBlzEh3hFjGePEkt2T+plnEIrvIudLgp3s",
-	609:  "8ZKVSfNBM/oG2amxpYkIz1Ty31y/8Gz19fsI237EoKQ",
-	3706: "OTSonQ9yQiCix+PDnLVP5wonEAwY6XLFWq3uxLYCFgw",
-	1816: "27dG4fjnEbN4Tcgap0Ow+az8sfRtK5k4xYrNFfNg+TM",
-	147:  "Dvq/BVRm4ILzXoxdkVrHYH1CmQOfPf0Z+afGMYkrRTg",
-	222:  "epPxyiEJr2ASQyeCK/3MEk5pM1+Zf1sz/jwDuKem9yQ",
-	1595: "u7gsO4QBEk1bOVy/M/G7UGTUHCsDZxOV2m5Fzs5A/D4",
-	71:   "whISSs1dxCf9SfMIoTOzUVzvY25KaOgaz/I79E0+W5Q",
-	2098: "QYSXHqAm0DcoeAMI8icaPRm21jOsMmEdkb8wVgAKo2U",
-	449:  "dX8HjRVpApOhRyQConN7rUlbajgjlcRIGWwbRKNbrhI",
-	616:  "f7MTZNm0rgxXm2slewr37e0oDOfFEb0kCKPywOHO/ts",
-	1170: "VGxA06XEHlTmtp4x0ERhL6Q7w8gaU3HD0VsU8/b3ecU",
-	910:  "0aoRznto1kkJelfGyTccq3umyADqwlwedMIZigvd29A",
-	1528: "cp7admnNQQuzdrIz7OefOxPRAZqv5wpvfNfNLciuJn8",
-	2314: "uwOFfWVO6iideC/TYAt2uI6jStu10BDmxCVjOyO7j+4",
-	2240: "uFPWMbh9ZsraN+vbkQz0e9o6x8aWaHTUEKSa9P4ma0E",
-	188:  "Icb7j/3sPqCnHCix8cb2qbJuFQaPVLslcg1C5uqLWHE",
-	2311: "s91oD4WmcErJmHGtwOD1j7TWGl00G8M1LrGI6qjC83M",
-	3081: "O1A44T7ntCMUY24ZvoiLA4XNz1uF6aipY8VaDB15eNA",
-	3936: "QCXWr/70pvZ213+I/e3+gLGbHXZF/3m9q26P/2mYkIM",
-	1351: "Id/W7xbiyR9eFtzA72nBi+JRXg+JTzMoDvpvCJpo46M",
-	2089: "IE9CO/I5vRNHAYowYfur7NJeKuOhL6qfsLKuPuN3xSA",
-	2036: "I+ZXmK8CovBqcz6Vmnx/pmm6tNWWzOEh9yJc6lA0/hk",
-	1396: "6B3dgWkxxqriZ5h9mch6N+XYEnCczSqmrKYAt0kJGUo",
-	3772: "lVI00SKAbk8VKYr8hxGUdJKNdtjeunnyrqKJU5EYusc",
-	3168: "zDtKidd7x7wQjS9hIoJ8qdHgq52Nlrpo+kc04skypBE",
-	3427: "e11kK2gRmJJG8yXWEPG6CEMm6auLiUBctHRMF8W3LBU",
-	1117: "Gd/t+FieEjcdYAvpf8fQhrma6uUXWkTbZpLSuT9V3EY",
-	1879: "D1HbCd4Ul6YOuhGzqZ2EyBUYolYVdejebPUTSetFL2c",
-	1464: "QSTj+Q5Yd8v8MQ3i0PnrktoG7tpgZJh0bDxlWEsEz5I",
-	2908: "1LW6WHIT9i96ybomfgMFMZBLG33RY9Z3EmYc9m3xFSc",
-	1610: "BySbqOT5emTU/EOu+h/b9B0XgOS/kKiRsmAhziHuJhA",
-	1316: "kf32tUlgjvJHFREmspgCf1qo/jocS/Fjb4KrZLG1CMg",
-	3178: "nJo252RTdpTAlVaboHBtGq9UeixmD2y5woBdjCgiiwQ",
-	284:  "HF3Ttpm3pu7e7eteJHwIl2HF6Cw8UxuEhpGEtnk7s88",
-	2282: "/4Os4VKDbXvkyUD1eUKbsUhueZpkPVjd5R9NcYDOgGA",
-	199:  "O1gYYu4+iPCOU3+yalFfo9mcs7dU59/297bOA/zF3RM",
-	3932: "s4tK/g+lekx/l0S27e7jAS2jUOLu9QQs+tjXL0KpC0k",
-	1548: "+6OgMnTIYQq6w3R7dp30UwpAD4rhT+9RYCLNaDIWlVg",
-	186:  "MUAI+UcJKu1GaB5VKgVOyS0dmfWP+Kj6eIhIpoHf28w",
-	2251: "4bApGsSnj1PRWymEWeFN5zdyFLmtKkEDo/TTOkt78Hk",
-	2937: "r8I8gcpQXbgc2JUmVUqN4B/17QcY/i2LGHwDzwODTp4",
-	2332: "tdajNloMQTDqabbEkzQmoNPQe9FrsRbhCAx8iiI1Xq8",
-	444:  "YNpuwRbMsd6hPZfrbn6suoX05jPaQpv1zW4IHzJMdgI",
-	2682: "DzdoSpvs5lLFOr6lY0vGd5tU/ylxQT7vVeCoQo9jXq4",
-	1282: "5hxIdXKa5TvmZ0e0w8gprQCpvHhYI+x5B8Efgl/CbI4",
-	1617: "QX+hoB7dRk9oeQC3W3xU0AleNtnJyvkNj2kl7UmeyTA",
-	2474: "XEkLGH1X1RIFoXI9S709lD3HayOcMEzG3Dv5aJmqEx4",
-	174:  "pWlKT51HfN+SBq4+a4FOVbVapZunNM5BdOY+0UxkXlA",
-	3790: "nsfwxzFhmUA88zmLhe6XEYXNhyuszWyBsGp+vHZ4ZrY",
-	3584: "hjihsnnFSs3zZqsvAZ0Gwfp3dTjHJSQ8ZiRkdHcSKMQ",
-	3004: "GoKo1w/vi1MPT5LJzlzBT1yCHcbvhiXFZaEo6IR5HJs",
-	1134: "rsvHoZumqLIQ5/EcZuJOSrJtF1tCSjZKN5ylZvr5QkY",
-	1629: "plxl1vOI79fm/hHwpHs84fhAA7hXhrEU/o+y7QzzH1A",
-	2835: "DOPvcacD1SPl3PxqHwJ4vOd1uO9kdrNk3vT5EE07A+Y",
-	1158: "qmfegrcDH3mckrHRBt/T/zv0EvwEQfeyjwmDs4GQEno",
-	3352: "Kzdkx1cpv8sW2obU6dFnlXh+YRGltPhO7Ul5PfABp34",
-	2494: "T89rPnV+nVtkjtUeVwn/FC38NWAMRX2af+r5hmN1ZRI",
-	2936: "E0SmR9mqPmFCkGnQElTVJ0IvHYA3DYkJRdNF1TWQsLo",
-	3762: "Z7zb+qaVGRv/k8yMhoKlofM2+YXqjhH9lxUkNMyVZ6A",
-	3080: "tgV0eyH3aD446D3RYV2lv4ysEk2A3ZQbwDji2N0XenM",
-	445:  "83czeP8jHo/Jl+Mu55RhY+cELoVr6kotr3jBrAlDJ9k",
-	3652: "0ptL/2OsKa20aDHenHgwgZxeYbT2+LPMZ2L/4Wa4Ny8",
-	2211: "0GicV8jPTSbauYxBO2gZy7s2lgPpPDOoKFiQRgmPzPU",
-	1204: "r8SDmwDKQfTsTEKijnE7fcgFZoZK1QI6Q5D4QwpBsTM",
-	1000: "yYm0aOTWLhdBr9M6t0iH5wL90uRUNnUiEkJQsJ3aovs",
-	2417: "nSzpL4zo/+oI+oiaL/prAzjGs4YPZ8DlOVxNobkoBaM",
-	337:  "mPkaq1dKZ9VVzE7BgwQxIRjKnWcWeVNIcsMvGgCJNLM",
-	3014: "LMcl+WwRfgXOfY8fOZSWPDqMhS62DrgHrxWKwAYl50k",
-	3313: "VN4L3TGsrKR1W5DkTqfmp8E9k+6hG44uNuC5K9XcJJc",
-	3686: "/pfDd0bbxI8BRFzCSGJRmm/0bCeqfemLJrRuBynuOKk",
-	3930: "kSzExXUQfT6lySK2yDUhTv/4vFdAbvYCINQkThJ/KdI",
-	2263: "13c71LtYON55Qvge7zq1EVAyrVsr3a5plA78Kgzbcuk",
-	355:  "t7UTJXYqGW0jigeEDe7NtLEyu6UYJLiX3zDnrCQJBa4",
-	775:  "3aUSH6IZ+OokJj522QVcHELK3NUnhiviF8fOIn0GCJc",
-	2743: "XLp2AGBu7PWhSgUkzf7OZs/aZl6bvS1KGbanahGlOpQ",
-	3052: "AUv7p3z8riG9k12UPlbYbl99i3HQuO95tzWAK5Kg6K8",
-	3056: "P46KaSd2lZ1lKe+J88Mthuto94WDnB+EB0FENF8B3Yc",
-	80:   "VCe550AcslGGbtyk5g2XBTR8hQaS3O2Qf1t1iFXa4sM",
-	2082: "qvbrHTKPoU9eSJDGucrHdQnWSbXGpthlPmesjhQaflc",
-	2762: "kvF2kpetjc5iM6c3xn5jQ+Fc0UqkI7k2k4FHuDtKweQ",
-	3714: "aEKfXEbNqF2yFuwtT9RS03nlciY059nZHF8FP1eBRQM",
-	1066: "cPvg7Oe3LyJEdANDiHTcd5O+AGlV8IH83QL28lS9l8s",
-	3610: "HtW4JCY3N4tHkV00fIeq8AiKTUogkBHgkMyVdYYboNE",
-	1120: "YinNDsjwQQkagbyAIoeGhAYoPpj7i7o+PjkA2wsfa9Q",
-	484:  "T7pyNkPCsjWmzLRPqcDlkcoJiLZUPpkZE9Js6hAu48k",
-	897:  "DFwW0NzkGFJJb1a38xiYV2xG903Z1jeADsGYfbd6YXs",
-	324:  "XnHLcLiNELr5LcbVnHcijDBBB0Vpls1E0q9aU3aIrI0",
-	2067: "D0bTcnT6crAHPUnPgoCpqUJCcuUJ+wAnU6z+SQtn/rg",
-	2120: "7aw2mgnk1DorJ9jsfGUFBtQxszeO3LlGkEepkrZV5VA",
-	3130: "P3WahHU779ppefREutNIhVXiR7xEogX6e8OMtS4d01g",
-	2153: "acW9YHiYMk3jMoULw4UjpuPBS1G93xdlC7Ze3KSXxnM",
-	1660: "dmxwnghCddozULdjfxVTnhTg3Ad+n610SCnO5ouH71w",
-	2250: "Hpj/G7L4vORpuOfYROSUOn6WSi/QY9qMFIEmlYVQzrE",
-	1255: "HvDxIDh+Y+H6y3q3le2qxPKBTWRaltNLrUZY3vw/HB4",
-	3993: "rFj6v0PVP2CWyGsL2UK+yjSUwWpnqMhsPFb3DlJiVvo",
-	923:  "YwGntB0hP4fcES4Xgs1QOb9DgT3KUXy+8W75J7BdDck",
-	3572: "AVP3G56LPiTDgrgb02zIkk0uWrZXJRkZAvZP88uLDMg",
-	1970: "ocRRtEmF5/rLm40ZJMfiZf0MowvnUsjBN5Nb9OHLKUc",
-	1744: "DP4mJMblGneWW1QpQI6suvEylKQ+kQov2T8nuvB0ABc",
-	737:  "aw5AbrwrdhZp1IgfhMw6KBfrU5QXOPtTEjRfAYvrXL8",
-	1620: "N8qytYnG7HnAUdefPTyD8wNO7LhIua5Mr7T8Lg0USQM",
-	28:   "IodACnas5UKaXGXq1QbTDWFD4g38M7JD3MYC9YFT48o",
-	527:  "5jRKsajK+XgqKoMf9XVFL/DKvlREk+UjqG9Tb1N6eZM",
-	2538: "T/4lvyGfEJVv8h1KOz/HUNWpC/Zy1w4rqQWT9PYvCWo",
-	3064: "gHSXRQAff442jS/otxtEM62s7W4gEr1eWHItCT0SyBY",
-	402:  "PJaw9MS569pvENH4mDkPH5J/ASCl4lfosNIm308zShs",
-	3538: "xMpc0cf0t8zaSCF2eFpJXx1XclebpKPWUfc4oi7hGFc",
-	2455: "/HOM/TxSC9eVHzarMzsR81i+SV/UJR52JKkywKJc+Z8",
-	1895: "B6G43Jg+PlJoKmBj6EALjQggznWZPNrKpPHucaWQfVs",
-	2083: "stP2HP2nrHtqRbE8UsE3VUooGG0dxNyKUMBY4lQMzwU",
-	1028: "s1p3WSizWBNV/Rdco0S9JcaeUyrAbSV9auVve90Pa84",
-	516:  "uLMTqmumY7aCM5dUul+4PHgN4EorlCT+7vhpnBIG4DQ",
-	6:    "cyN1LhmIo+5iHTcw3mxXXsAV66j68gQ1gpj62NDDyLg",
-	2922: "6LqqFNCYGTEb9IsTZvX8HlpzSl01QC/XbbPm1DA8bdI",
-	2179: "7LHZpUU2cF8ZOjWLDQBjCldvVGT869yH9pi5D+blwac",
-	3869: "WVG+oul6e1q8FZjp7MpLwOjjXfHnDNh+N75uxnDRgZg",
-	3332: "Mv2Iov6CldDROCKrNfqcUEURrmB6Nf4+1Y5xyBI8d/o",
-	3783: "QAGZV7c3YZexiWYEZ4vsI6jEQDBZXHqEcb5a6JQ3g9w",
-	2331: "IWrMJcbnFKikKFDPT8Iu9Q2xUsD2Iq7oLRRQXolD5yg",
-	3375: "AKcJ/1zF/oX2may5u6L1GgO5UWAhvAL5Vm/s3DL78mY",
-	651:  "DmPaVwy+k1WSgeTd75XyNQ/aFec6r6JJD3wKWLNk1f4",
-	3069: "5otS681hO/jy2x5/ELZuMZeCOy6goYKFV+QUwsqr3Ds",
-	1936: "xioSKt62p489CgrRUnKQvFq5ySO1OklpCSiwCcd/Zc4",
-	3141: "V9/sEUFRJxcuYBdVRvRrTJUfL14FdpElhQMuQcPo1iQ",
-	261:  "QAr2B6uIKPd9PRcAHKPW0MHSy83dFreDSi4Kb1m6Xi4",
-	3760: "X6Oea7wGmKtAj3zV/4k0lG+v4Qnc8AvCqUky6YrI5WA",
-	362:  "xtHu0ATF2IGaI7ppCYPQF9GyXxeo20+i0X9O9IZdtCY",
-	3970: "xyeX6LXz/8FRbUum2prPl2eEt3db0PVZv7/IxdO6Lms",
-	3285: "OGIN5JtABjBqqHt6xZ1h17pxQwhZ+OSdCYNTiYl6FUQ",
-	967:  "CpkHsCRheXBitdF0FbYY8eDjP8TsmBZ+FiAVZkXIVlA",
-	1652: "2sJwAhUThO79KfxmzgZnMnPI4keqchLJwqjJOnLrLyM",
-	752:  "x3CS24/GIkxtronFQkMHN1qaFnmBiy4GMb6/bXxkE5Y",
-	2602: "TKb1Trs6zwiH6PSK6bprjPJOREryqihv9FLbcS9z/0Y",
-	3680: "rOM9qlANW+sIfPF5ZIhM6r9mzSTN1/33qObR4gSA7/g",
-	3952: "0gDyUDBTnY4UN1sGpd0Tm2dLw+MJSTvPZeJMmKrsxWU",
-	3719: "GsxSiYD+IUVon8QvdrjwpegyKQ+0uKKB23oMBN0XI0c",
-	3832: "mLdmqtB8WJBLbCdeCUgxTEvRqO4OPk73Sth5pgpExkE",
-	814:  "5f2eGRFup1/t8QqWFq39kaNXIqA8qtBFbCBWdBkJo3k",
-	87:   "zGKRbKsSc9vYClqOL/tMxO3UxL3imrSt5vgANa62vYg",
-	1953: "RwozIUI2NxDRCdt5r8zGFJsUcLckAienl1ohhLHMNq0",
-	801:  "Bt0yvwwsgp+roOuSRv1FX2ldBecL/EFufqw38RAaJxY",
-	554:  "X2q9tBzvweyt4ovRsgs53oZfaPXMjiYQn2DJxQ3FlW0",
-	2055: "e+NmRaXJAuiY340RWHrfAADtImgzzdF5bZ0mYqhG59Q",
-	1359: "akoq4t1DM66/sIH+O1VWlWd4UUJcuZEFPyy3JXNCJYw",
-	688:  "QTru0cO64xmaaN1wdlUqdUmeOLRbH8ejEo4VyFJRbc8",
-	1514: "JP3wJ5qfsp9NgqlGDqHJboJP5R4EmahhfNN5cIUcC0o",
-	676:  "hLlX5O50Tm190MlRDX6OzY/w3q/gObBS02UKzkr6x0Q",
-	3421: "7piuaJRJbAMwBzBabxu1Iw8kbXdI6ueJtOrg+yaz+y0",
-	2688: "znMIl4TDhnVxFlwTA5Civ4zLrJglS+O4Mdcpjpce1c0",
-	2617: "rOaEkzzXlY4NMOazwJEcI2dqv+WAJ/fZC/INL2WFJFI",
-	40:   "QzNlfg27YXLKyVCsZoBab4GJnTCIn2DmrKSTE9Ixe58",
-	3324: "U0pm5PzRhpJ6LNQ6SMxthTKxVHFmpPJVoWvvNaFS3F0",
-	3949: "8WwYJGm2G+GSpp6XbZlqvkGdRprDubEyfxm4BQqZDmg",
-	2384: "J7VoIYTHc1ZJmhujySQCLnuU5ZyyJcXjmlkqK/rAjV4",
-	1592: "ylG9mapZ2o0J5HIkqsLfHPLzwjuOKstyb6DE6cGqO0o",
-	1077: "02CLKKxfFvqgBV3r+/Wzr/Vw2AQdlhB4uuEyCr0ZWPA",
-	3774: "IyFcG+/GO5+cwZdlFOuGJiwi7woUPtpmK+h7yRphEHI",
-	2693: "NqarE1AZAx8UCY1gF1NdUAvVog5mXCTWS+aUkXQooi4",
-	3536: "LdCDFZzZqSpjL8f0fMtQy6byLy+2U0sxuQ99BlUfGS4",
-	2466: "JHkFWxqcS94LVPcOClqik69GrNxG10IR/GQFDpNwyeA",
-	2347: "Va3PF8mEqNxkB3IQPjYUg0Wr/XqcYpJfK+BYF3QD2zM",
-	3334: "PpSBiUp5TwyruVG0l6QJNlEk/E+iNh7TNaOc+bZYQZU",
-	1428: "WfDtCX3G7NBxHBySOkhMChQ7c4ewwzGQxYB7DWe2TMA",
-	1367: "QaKHdHeqiz3sLBpjqvTY1pqGhQ3P8w7RICCNCBlSdjA",
-	164:  "cCtMsgVFGM4ou3EffeXLc2Lb33aLcDPolX62sj+FjC4",
-	3745: "jTMsyi7Omn8p5ejKazk/fazP6UdAQuHCW4hxY2lFPCE",
-	3896: "H+XEBj/7i7dHjZaqolHJh+2cQNg4wFGzXOrgHX0ZD58",
-	2513: "rScJYksZYPYdPfDA0QKWKvu3ld+fz5mywU90UJrp5gI",
-	1181: "uxcg3mbKwFl0888O65UexhSqPtkp0kyDEKSlAnwmz2k",
-	146:  "7p25T9cDBWbeQpCT2haTdt2H6weHvo+G6nT6CyoX8Nc",
-	2740: "sgltRDFyYXxQABtXK7gfB7FSsr9a1Rj3Pz8gI3CfE9A",
-	2550: "odEcHjdO8zAuVu1chd/X82X0iuuUjVr/vRb68bW6R1A",
-	1915: "ajmGiOF5tMew03uMNdjyh1FMhYNAyZJ4GjTH0aFSjcc",
-	2903: "co2pO1VOJS86N2owHYQbYiV6Swwq+XL/xXw05ZUNzQU",
-	1408: "WGtg9arX2KZvVRoLOuhtOs4R3IMpLjrFAUky1LeZTu4",
-	3738: "1/RQJ83fUSgBRoo6qdyqFcIuYkGNzlcucdU6RNSZV6A",
-	840:  "7h4TXQxPamKnZfh62Ht8ZXuF8LybzW04W0PVcLWJIjU",
-	1623: "baOaGfj7WCFOyOGE+LouAeCb9gms1e6JiftODlLeXHE",
-	2185: "0NynfYb7oyOz01fKCfa4h6MeQs7pAQq3AJOiv0hXXQk",
-	502:  "tPqfebVmewmLwYqeN03Fa3f8XkHkaBuV4yemromGMa0",
-	869:  "X+XdIdlWCZh/HqzKZPmJicNSjb2vrbMMsc4xl3DNBR0",
-	2238: "xM+IIf0WT9AigHmtxXSfplOdTE7YvA+zrLwveRoM7zg",
-	1925: "MCcxggBfw/KyRDe8ZlPQnxT1AaneyOfPFSdJg4tJkSs",
-	1294: "8tOcp+j+BUMxzlubghcNSZFlZLVkEniEtbzZGUzY5O4",
-	2664: "4gCy34w+bJwf6RGXSNuZeIrl/fKFspiCSc6yLnf6C6c",
-	2823: "iNvDp+cWQeaV0Z+aj4krNbQ1FCC+9uXsKvGZdXOkZ/E",
-	577:  "xZNdD+tF3pBOMSI+EZt1QwwMOx1Daqjs6eGEhBNmLxQ",
-	1926: "eqGNT9ss4/2/Di5lchTggZfD5sFjTszL49cUPhdfY4U",
-	2131: "AgPNmJ8KFVxnHhsrbvYCam2zwdszZlyjgRoyYSMmMUI",
-	2163: "o2XtLk1M3bxrAw4bHLL6siMJQVOInIEghZWHRQgyEzw",
-	1819: "o6sypPDpzmovZF5Xv/YWQuNiZLcvJNjJ252h7wDgrAQ",
-	3885: "0Df8gCrfRQZN/fmCTDJXWPQfM7HDlGb5Grl6Ot+TCss",
-	1280: "zUkCbgD5UMarJVfqw/hVrc5fmQgIKYHI/NcS9MNRjNo",
-	930:  "GsrdGXnZLXPRhYcf/3UijfR/7OVzHv0VxnDiHOfQ6q4",
-	193:  "bpixpC8VZ1/2IPgQoMed6IpLtYnzJf/70WW4G8g4e28",
-	317:  "8oYyJAI7JE3CHf2YUrDkBXBQq+i6hyB4b0gBmImZBfI",
-	1474: "phixR39MCRHRajfn85PgwA73/fXtSOclSyevpArKerE",
-	3013: "zhPnCNq2N+rYg4eR3PASz1pIRUg/PUO99BXPSydOGNI",
-	246:  "dpSTfu7qaZ/tmTHtyUOLb+1nvE5pGGnyJLu4tOfgFvo",
-	34:   "Pi/JxfEs0grl3x32KworhZ6bNj53VpWFy8H9hTcaHQI",
-	2096: "bVmk9hQ0oUHM4+KHRLY5HeMkmH4tbowLlXickRvoGj4",
-	1064: "OkTvydUfJZHSN8vIWXOL+1hm8YaJ2WzWLZ3pw8nYx2A",
-	197:  "2ZakpKiqy5IrZTqChuDmXzJpMBd65Ni1zZzvfkU/Po0",
-	1783: "E0yNWFBNE5ecUerRWm1Tu68O98Wyrwp+Hq1/oT8XqH4",
-	1017: "+FJLs87bf1jAVAMZyhS0UvJZy50vjsBve1xj2fqK6nk",
-	2200: "XFSlyv3RTjgcPYByTobl0skKW3Md57dxp9qRrJoLqes",
-	1542: "6L73+LUGG0c4IhqguZ+ioUw7vDT/zTiUQsOK7DO8n+8",
-	2831: "0bjmOsmgAvaTeRPAByIMN7D9Z2tkvhswJukcSIfEpy8",
-	2943: "WoUvxzM8lbXpcMQWZZXBVJc7ZgNK/4cdQOclAltvqNc",
-	223:  "T3HlXX2I1gAHlCWHIcTfJ7vvPgMAqrtqzv8w9ITDuBw",
-	3645: "JevRh7tGIILGtROhRRUee0JzEn9fcqhg0XrtX1789lk",
-	2289: "lppWdU7QnynEoPZB4MDIGOQKIW0kUplgoFqs2ysH+EQ",
-	3935: "gax3CkXKtnlpYwU/b0fbXjxrncGd7FdJgPFBiP8d330",
-	3443: "mZ784vESrLLY2NxrEVSejoj6Pzhx4ux8nBIwpMgjby8",
-	2763: "VQOnRg8O1w1guZdFp6eg5Ai63/BQle73CeOqtWASKBE",
-	939:  "Gnv5A5AkzPtToMIrr3+ZdqNvrSJYqzIj8gS+2zs8XZ8",
-	2010: "K/yyTs5fRZKyeQ90EfBD2lX06J7IA1wY+AH8plbf+ZI",
-	1079: "t7qLyDYf5/1cwO5gDjlycGBZ8bkbnEWVHFBS/FGVd9w",
-	253:  "/OQQMLSThSMXlubDdl5WEiIkIxpesbg/TNXRghtLXxc",
-	391:  "HkfHeouB4sf+itNR2S4jC3Ij1NAvy7zQckaVr1KMzwA",
-	3467: "as0pxMO+MxFt5U6AlHej+Dn+IU3FkJAYHygpNEtaC58",
-	3442: "X1WvTwgguKDJ/5JxsQoRNwNrSmDm/apk4/jC2vY5/Yc",
-	1478: "hA6xHR6RgdKJ5f9/1Te5KEfP+QFSb8U2d+/MsLynkVA",
-	3564: "vBQneSreycTgD6eJtNOvodpKjUhatgyMecnGAs+khaA",
-	438:  "zyyaatX5Oht1EqmZyTD47aixK+M8Kby4gV4PYjZ0zrY",
-	2367: "3peF5mM23W9UTyaAdbycI0vwdcsi56HvxmhAkJQ6keQ",
-	1793: "5tzvaQ87Bm/gr6XAXcBE/hlEpWrDf4suFegG4BqGLPQ",
-	309:  "W7Jh7VpjFyzS1oYLMCAbS8hteyE5qYoIQvtDM4kqyOs",
-	3627: "qXBXT3fXHCxExgktnENtr+43bRI+O3LuyKBFSjIMSag",
-	2768: "8W8xrBPgZHDoZS19H170/yI0HLl3a5GjY5q6XHDgfB0",
-	189:  "8YS9SiribSf60f9rttPotzFiFMvRuafypGn1WYdC/qw",
-	320:  "L5rXUPofKC32c17XBfLpbHq4CrGXasY9EdMT/ZverTs",
-	1562: "YCVGqk02xbL7oW2F0jPbvoq79JXTf9+BHszU8eUBbd0",
-	3074: "0Ul6Gjr8s2dPMIAeSW2h6yaYucP5Wrye+1KU7N0QNEs",
-	2257: "VWENHwy+fNAGAxj51kOoQg1VbKfw5CVUqeDwx11JiEE",
-	2950: "i2QOF7/O3lrI9AJvEBTpNjizBT2J8+R+Qj60giysOwE",
-	3250: "Mg24x2KVnxVblIqGd+zXVXm2spRdfCigNTkYwi+7EOg",
-	2293: "gBwMRYsBEcCyL3rJI2yAfJvUb5qSNRmMYyisSA110V4",
-	3782: "nX4NhZUrhsUjlpx3hnfvJMH//UK3b3Z3MXI/N/bRrxk",
-	91:   "AIGkYyamzO/Tz2zCZMJM01Pmo3bPvtE5RdGcFndpbGw",
-	3750: "eSdqqiuBcH2yo8BIyMGlBY9876JH2RAa4ZcklCZLqqE",
-	1740: "vaheq3KUjw9tUtm4tOmMs/WDkSccOnK089CrqyGASK0",
-	3388: "ljY60i5cDsBMEmIRIprI63B9CyyK//dlX5yyTdJcDo4",
-	2940: "eP5RDzfhTNQ9bKML4wnCjlTtVrfkY1IuiCg+WTYPRnA",
-	1850: "dQ36gz5W1ReiCdySF7mgAYBPqtfI9aE16rnr8xtBqdg",
-	1863: "Y9uDrWBXT9cgdcv82pIEj94/9bU/wjlWKLlPMGN5IjI",
-	2325: "0WH7P6YS/tcMOXEVg5zzZpiOgyhGWHkXWXxHrvg3lKY",
-	3078: "LW/C0mOW/x/+qLLZXnKYDZvIycx2HD3sMetUsPpa+/A",
-	2262: "6wubKE00lSn6nHxlJypOuiOdJMfwzO0Mup7wDgI2fI0",
-	672:  "Ryma9NgVG4YZBbaMMUJklJlj3npnRPF+ZDeqWnSr0P4",
-	1677: "M0jylTJwjWPDxsSQxSCl+uzs24DA/pDHMbCILGMgELU",
-	2909: "rEgm3iojT9XEg+Z43NjeXyjrO5Pc64dZE7c7rUVAlkE",
-	1247: "04CrMMj/EMaX5vdICa/QvjZf3b9Oz5v08CLSS3wq8eM",
-	3802: "uNbq5hD69QEJ88VGWSly+rrDWA7y2V5vE6TxRZLVlSA",
-	2793: "aouSKKL8aJ7LVBLRPa0p2KAc6rJ+4Lj7a35JujiBh58",
-	3278: "ShfZgaMRmHCE0FmpxFaJqT3VXc/1amWJptZ3C3I/FyQ",
-	2753: "pyOVRICFeB3JGdRGOj6M9x6ha/qxt17iHsWTb6vPBZg",
-	3943: "uLxOaKn8Nohjw+kO0sS88pHaZbqbl70fB2gWMa0dIfs",
-	2336: "UIWwsyr+E35gHndiD0sgEpupoIfwtwdkbWOcjzIY5VI",
-	2385: "2IYZuXzfDWUcDBg4oWfTfNz86uaKPzfVW6LrPhBqQGQ",
-	3280: "rMVXsJocyfYo5D+BzRH7fmy7EXDzVxHxMjh9NWPnUCg",
-	3850: "FoBBtcvWX8H9dTnCW8nbym66uYcCJ+G23G0iul4eNNU",
-	2246: "1IIaC/ewOXKN69ORw3jzzdoJ2YjSDiebnkdZr1cZjo8",
-	788:  "FBhZrwmWAep+50KnnAMjPpDwYWnb/N7C21x7zNLq3Ic",
-	1529: "jKDT51aQtnc8AnvqJZjEdK/3oxnOWl5EOmb3P6+KMy8",
-	2679: "ujP0xNWevL8a3c7AS3NnSYHEXkdWXQaah7Au+OgZUTA",
-	1980: "kLNwkyLyd0RggCgURPDMC8gTlucVXE15OLr/bASUIXU",
-	1856: "GZFKOVxTAwDIESwGrCo3NdZyQi3AkZU9Obpb2lELSsQ",
-	813:  "pGNlgs8MNbbHUPzWjuvl7nKBCRfQT9enAc5A/Pr30r0",
-	2004: "Hq6tJi3rPSOG4gNKZa8NZfidEdd20SrNWLUHET8rQlI",
-	2269: "w2tbiYwlvyevqDfGGkzc/LZalDbqT5asQ6gDiFKa50I",
-	3763: "0IdM38mdi6UgVCAqgUoA7zqJMd7HBneJ0+TNW59MyA4",
-	3568: "smtZesX9r13nfPLZaJppZpo8fDVU04HMPcvO7SZP/m0",
-	3374: "ZAxoV5KLz8ElQRZwqsmF056LiXwBaUK+5TNhrIK2sxw",
-	3371: "G+E+EynzdwmywXFaXfeX2waM25stC5On4cqISnpXkKU",
-	96:   "PQZSYrp79EjJp9wc0j2AC9vKXzslIVV+hNMpvOwRtB4",
-	2137: "twU2C/zzVqrIMiUfYalsWLGXYrHW8enrr+ablXZh1+g",
-	3792: "bVA1ZzwxW7EEK9f3pWZYw0XZlo6MyJiBPtoFLIHB8dM",
-	1121: "hVXVvNPLIXNkI7bW+VsoG9+PtJK7NIfU138WA6ItEX0",
-	3127: "cC8bGRZ/zYBrbZSLWNcw7HGl0BdC1+NTx9bsSR6T4OQ",
-	2860: "A1eQPuOcZArGLlhmG07AcSNyhRq6ooyXlPE9PDPnnJU",
-	3486: "z0qtrlbAOsDNDDiTHI16AcYFkLpmE+9Q2bzxDE+ppww",
-	659:  "izSjBomg3Kjfjwp5ax+hnrNSk52kjcxHFIjvsKDYUyw",
-	1526: "Vgoge1PDanYZ97mhhclla6sg0my7hpK85Cv4Xs2a/IU",
-	3537: "jhM/KXa4pq2kXTJ6ucvPy+7RZJF4su4KAeW6Mqqa4Ck",
-	1236: "VPwLPtXc3uX43XwpMc/vVIZbg1Kjbxc6bnLhggXizrg",
-	125:  "ywf+Jrt1Bqt7yunMmnd6p15GFUdnLa1apkc5vKKvehE",
-	3844: "28ZJ4uJqmtaWjtYNQcZgtSHiUN6sardOSzl2pOKbX/w",
-	3861: "zlJi2eN6VwIwXXpvZqM/V9O3vt8DkQB1uhVyD4/Pci0",
-	2572: "MG7A5wG2TUW/q4UFeTz0a7EgMsntyyFCT7nesIiTTgE",
-	931:  "nWLYb9LSoiy+D2QXQ6JcCFQ+ScdzWhZkS1U3pc2usic",
-	3088: "F21AW/i0pmsbdij26sMRoi2uQW/EVLuuEQfi42aFw2s",
-	2486: "T8d1J7gyelHhdPBBgtTegDaTKXZsnX479ByiGpw3MGs",
-	2655: "sOG4jvhFgyVVB3wBKcbINMYq3QEFyjf+WdL0mX3b6F0",
-	2377: "SEaFsHU6PM32ifz1AosdHdqKPw0UzeT/ocWq852g9Cs",
-	1211: "L5R1uC78FtDbp1iXL0rtkWNCb9dZeQF237uovsx7RG0",
-	3968: "QfNPym/X3nwrS6o8tFX2xSwPVmc9mNqPRaC0TzhQdtQ",
-	3808: "aSDYhX3rkZCdV0fuM1ePDe6KGK9DDb0f3k89bdyIch8",
-	1243: "KpwRAFu1TxapKTtqJ9zO0EjeK5dDoCTn6Ce6BTEtWGs",
-	555:  "wsJBHcPk8ILl1HOfQlbfcMgzw11jGGPx54GCqkG6FMY",
-	3888: "fRAwkg1NyTksOPsR82NxgbJ3VxvnArFr6WJ8t5pn2PI",
-	1270: "+crAGxf8MvksO/vHfprbSIYec+/NNu+19c7dWnaJV98",
-	2406: "hchTCOvkyZT+mKa5qkf8vFJ7dn+WHMRY9A5ZXGhVXAo",
-	98:   "daN+KNo5efkSxkrS/+cDX8W4Z+6S73qxMa0GtAo1x2Y",
-	3419: "22dqTg0S4LjyIpRVPIpcKTSI4na8iykXHqLo74UEZhM",
-	3416: "4ksEFndQtllb0xnxl/nn4bkUV4uo60aqWkj23FiAgIw",
-	3622: "OilhmM0Mz8xyB1eZWri2UeiK84Y4H3U/DhfZZCkUWkM",
-	2022: "n2avpSNjRq+Rq6HHdWCO75Yz/UWAXz4+/qbLqnEDfx4",
-	1534: "HJQw+i+7J13PqFO3yBlFpzoa/TDsKw9KAMRUEmM0a7g",
-	461:  "qFilh84G/NXNccWu9Aq+J9vgWMNqQqSy/lL7SanOIAY",
-	1197: "VxyDy9353vhHISZ/Dg1hEZTf3ddebhLk+9G5LOSeSbY",
-	1422: "C1XfOZ8m1LwLho4hZ46cWpf0erRz7TT80g6bx/3LSRQ",
-	3593: "SbfhnHtOcFQWxL7FgXGdnfTesrImVNrgweRmnIp5qeQ",
-	2542: "NUJI8KhdegCI6aXIYbAnCwfRiC6/MyrMjdqV5IFiHyg",
-	1962: "WdvvnUVGl+A2UY998l2W232bwWMiDlI0+EqrZmN0kt4",
-	2037: "egnm/+zMXs3nDPna09v8SEPTZzyfbUIG9cr3IL4roqQ",
-	1972: "m11nltngBZM2cUAFmXagtVzEpdj7tp9pQfR6/3u1yFQ",
-	3306: "VejdIx/q0+e9gQpGN0W4+rQC/8T797hFc7fXaNUx13A",
-	2516: "qIkQmFfwdm4RVfAudEL1qEHnrcakEbpMG3lAumYvTts",
-	3585: "tT802kALzOa2j0zWK74VOGNKUG6U3UFIjnu/jXmXWxk",
-	3084: "t0mgy+oqMAIJiohJ9krA7vDNUb135ZQVzX+PWxJ6HnY",
-	259:  "llgdMKVQjNHGzIVOa7WzyIaKqssVT/PRugHS6ySjHZw",
-	3677: "/3VbFnj9giFoaM/+QgDn2O8Y4mZ+0UXffx5f2BQ8mvI",
-	3125: "qv+POj48jyYyO0NKEYFIRlNadEO2ebVBEZ3FWLnKyzQ",
-	695:  "TldJLLo+F/rRIhWMePoN+i0PA+gjk2i6h2Ftwnq58Ng",
-	1763: "V7QQl6rusVwsYW4hpZfN0b50EMBlRvbba3er4swjgjs",
-	82:   "dKCrgZN9rIswiJVIFcoKSv8sXHTzTQSD+M5J84DByyA",
-	3725: "lJbhh54wF8TVjX40ugNoufYwJkke6rGeTa09/4Nb1vE",
-	3785: "Xq6WXm/uBdUlv6+SwgCB4cXeazOUjreUs9sW0IeaSzc",
-	220:  "qI5kMbxtRxzi1Tkw9FpLjdNJusfuxn+nNopSk6qky3M",
-	3446: "pvMZEuKGoPldEImLYX0ibUFeyNRHob5TOlJX9x2bYp0",
-	3532: "qVsvBOS2Vk9QxdWPyjhZS2+YJScoYvvywZdWEtJrlc8",
-	3506: "cBcPZuG8k/at9PO+X1ca4x4FaGhFAhMFgpLQFoZQSX4",
-	3681: "1XdeHZiuKV3ABgo1cq9dK0+Vyze/0PlqpTFkD9LIj74",
-	1388: "xWJq+sYsEpkDjxbmaMbPK1N8lWHuNPatJdptUJMuSuQ",
-	3232: "UMm/oaOV7H37I3ecZnzBBtSMufk21Oacp5u+ASM79S0",
-	3824: "OGAZ4kBX+RSundsmniuXomNLXXpENTwNWf01uqYSqPI",
-	585:  "4aWPXYc/dey72Z34sptQLuNDEZPSso1LoCSFcQ8aX24",
-	2348: "fDh4BIEJaCF1CqtvWk/CpN3XrIMJat9aimvTX4sZmxg",
-	3565: "HQL8KEqyWT8UMWNd4gzqfVzB2ViG2P3+Q4AHU1gwp6Y",
-	3746: "QzgNULlkkmBcp2qy1jLcTYM2vqw2ONyKsIj6ruRgpQQ",
-	439:  "2NxICxP2hUIxJFTW99mALVTFJq106x8ZqU+nMisopxY",
-	1310: "KQNSubWSdfEYF3jjoBKKewSG4edic65Yl/eC7yNQGdw",
-	3975: "klq0SDVo4K5gRIwzGuftPjHmBOoq6Nx/PMtIK+AYtO8",
-	1436: "tpc1dQJXQLYUU9zQr1Ai+mPq6nK8XnMfIMT4OUB1IXU",
-	1279: "6sXHcDP4U7ceLggVh+NJHZ7/kcfPctD1eZC2UdY9TGA",
-	1491: "eyU185jGAFBVK8HqdpG3/gg0VcPtyB6WwNlj2kpca5U",
-	2890: "v9HBJNrDQ4WiXYUchr10wKIZZ2gzblv757n+NdK2TC0",
-	3108: "a3hjLqTjnKCLFriOjCpZN6L6iCO1VRId8mnQ/jdCzZQ",
-	1154: "WW2G0YYhzXbtFg0tRAnL3h6FETUXgiNMlPRuIFnOZIE",
-	2074: "SzMFiKmtF7XhBnw4mYl36j9U5T3H0a4YZBdLhJ6jenU",
-	2027: "puJ+4u+RnVy3xqir0S9tZGc3vf+B4JDyS1h0hy7ohhA",
-	3847: "/WTz2MXTRzftnQGfwOxkA/BatGbkpmbLMaC54sBQ3eU",
-	606:  "ftdGDBEBZl7z0n/vCTDecwJCWiowaxn0ZpIraT0HU8I",
-	2767: "h4N+zQMSrLUPTe5oXNGt3uC9IYAghKuD+yy55iArDR4",
-	3617: "XUvgWNbtWVG/vzASAZXIrnuMIQIsqZBXugKuqU9DT+I",
-	1293: "g4vZdOyCfhvD6clXhef0GmzTBk0qipvi/9X0dMP6w+U",
-	419:  "REY19H/av3URuXGUaTE0Kb7CCEhfMj6fnj62jWvY538",
-	661:  "aFlyx3ZePUtjK0Dqfn5QzvteQQocd6FOGdZuS3tYe1o",
-	5:    "Y1N1IyYnr/8G5HV1TkT0WaoKeFsaCxZCQ9K0+FJVlao",
-	3541: "hziJxO39n1oHPMacBp2udGkP2ppDBVLaDj13vMxcgrc",
-	2407: "nKbvCMPafpjQmNGZS2ZT/O13j5oR/UKspEnG4AEkLSo",
-	2215: "4iT0AyjBNTuS8vCfjyDjH/bwK/glBBDWnSr1N+IJrZM",
-	1246: "lP3F7PaK7J2Gl8pHbqfziIpb067eliNKLvBO26yda3M",
-	3696: "59A3XxfRgDjk8R364CrknMOls8UPIwiWIiWKxhpPvNg",
-	3359: "iAIC5444/o3FwrtsvRLrfJJQyCuAcYUtAN9HXZEcS1g",
-	1326: "65jt3QDPtVu+E5sHr6SI5pHb5Pd+wxJDrx56JnTERbc",
-	1421: "wQWN/AYRfcHoYg0xIOLqCtf2Hi2SWsyskd9ZDN6amYg",
-	2081: "TV/ww4/Z+KRGGOvCwje1gdbNnW55mHfDF5lX6HZRlDI",
-	2974: "QEpKHa2cf1kKHqIw00q6Zj/EM42HKnoIZy/PY1qPpvk",
-	3809: "8eikBAvaOpWx9Ge4bH5YUJCNHl+kDAZheRsbiFGf9OM",
-	1815: "ovgnL9q/0tENz/Is7g466FOgRpHgcNsHr0VcsRWO3II",
-	3272: "CqXi9d7KXONJffmrM/Ym2ZUsHxPCdzsQhUqCDvBYFmM",
-	2680: "wq2lFcbaYWIw1j5Cglv3uOTpsl+cHbDZ9DK54tfOuBI",
-	3873: "h5SmTK+odOg1uI0VntPDkpnBPccEGJeNA5Dm8/2rIl0",
-	1226: "q1TZhKDgFotEigUglytDfP0B8aDalyqB3k/KcV6DvcA",
-	1332: "5EBPiu+jRGW3mu282G/4jjx0e/b9V2EYfTwfKPisnko",
-	3578: "QSiMoT74IEik9sqJ3AKczux3gjcKrneYdfcoAi4gvn8",
-	3843: "9kI4TB5l4Pz6TbXqvf1kSHlVxbwKqeJG3SwAh2zUta0",
-	1897: "3y+SPNwwS6Luihobl9EJIs35Er31BzIZI6C1i7Urxsc",
-	3426: "AeSveGlgkJljpFqf0KUdNP4tmdjIaXD+64FzEAVz3lg",
-	2189: "7ao2HRF8tR+WGze4aDmx9+a6RvFt+oFD8ZvKu1l2YvA",
-	488:  "Nqu/e8GsKLZWYPfLjGnrgCrl7DglDUl0VDpJ/a+Gajw",
-	3926: "NuwfIwQqJal2OoDsgcmDtw7k09p4PwDQ0c+ZAAWw/0A",
-	1522: "bIHSMc9SZTxhIE8GYPaJ9xaU7gK35yAW/aoLarvUFlo",
-	2468: "kc7/dQCL7L8ySpsCUXNWFZc0aLsK5nFIPFLxxeTy+sk",
-	3729: "fGaWPovBvI5R4wceiP+of0fcUuSovZJmDMwTqB+a2Hc",
-	3551: "skchfqobcbQzeCnQ8dh4psiYbi3B4eyymAWFaHmqYF8",
-	3372: "kOkHYFVHSLnWFRIzqxyAlRHkyP2fPzzPDWmLn2/lRgc",
-	1594: "1xOZY6AhyMUlNApIN8wdocaWZA4mi1brf0OmqNIYA40",
-	348:  "3NlV3uuPDO1WY7G2xcjAXh49yFdWWmWqSOrJ+bXOSD8",
-	671:  "CtV/sMm68+UEbi/xA/1e3cST5izh55MwYKxBA7xBMmk",
-	1504: "ontrXh54Vb5VcLSA3AIsOBNa93RokKI4Yd//tZWScRY",
-	3878: "ng80/PNKK2xU8r5cpxyTXtjbKbiYg6vzqTM01sLWHE4",
-	1052: "C2L/uSeHatkP2CPdXCrBZHMMxo5JYUxuX4VzC+P8hdc",
-	1779: "ILyAdVsNkRhS8m0/gXMN7wNBnvf8ck+KziUfnHE8Hu0",
-	777:  "Fd/hs+zYtt2cm9enYf25f/FER/idbqlE262fqcrr390",
-	818:  "UCnR4oWisNQkLjlkRdhVYqZCVvbMOlTe0x/nGb+v1Yw",
-	1298: "eW8/Voth2hKkUruPqIb9VbnnrpCPc7HJVAroU5JYnMQ",
-	1360: "+oWQHm4XJkabWwTW6CCPmgE2mwBBcB7ZCYuM4q9T/IU",
-	2225: "XjoOlq0vSn7oKusYxoAoUTOaFt1A3hH5UzOroxDfuYw",
-	10:   "5nDQ18CWvp6/YBo+8mrrODSbMou0MM3W8sKblzyLxYY",
-	3451: "wPH4XBEaYa8LLJtNucZxNseQ7l7VUHEqopOEDls1E6w",
-	1080: "UGABCtQGcGDKQOM2rTVcyVrVjjpmzuzuksRQTaDnIsQ",
-	1301: "uki390AXkDzO2TsufEnzMEwU/u32kk8ymeTRZ5GInbk",
-	1452: "2ywdDnUXoM7j+OMFQWbaCeKOQORLNMNh8Wpk+XUMzig",
-	2176: "yI0gqf/7wQ6XGzu/cILIc4nU2ClprWh0FuKclJrrU4U",
-	1823: "h+ByooMcO5SE8zVunxeXpP2vWxGPDLVPQjRmh9FSEoo",
-	550:  "z2rDY98msW6llHZcNZ6Ljg3/U7fiAfvrgdgX/h1jqco",
-	1054: "KiMl+aAKENO012Ph+SBhiv+7au8caWPxQoysOc/7cRc",
-	1606: "cfQ3vquRjuHOFmhmWr7rYAD2k0qV26mukiamF3TdNls",
-	2173: "4g8JIV9qxFRICr8G6yH6Aflin3pQZPGOZLQoEb+HhYg",
-	1194: "KAS7kf4PGCKVWoVfDpbLt5a/9hzbDrEY+ZYuhAJh+/Q",
-	3799: "mcuqANuuuP1Ptx8LlMp8hiqCjeYQXSovHw7I7E1WbTw",
-	1407: "Qp3b1SkFxT4HcNxhBS2gcq4w2DJRuspQvzFtbuB971Y",
-	1961: "BJII16yHlfrASiNUEdRn6PJoeZ2DiAuhffDWBmab3WY",
-	974:  "HQizd8i0WKdZSPHaa2h/Q7k+6XvFuj7f3cDJhdEINUw",
-	3793: "IizxOCImZjvInTHTMGeNNWUv067iRgIJoEbWAZ2ToZU",
-	3400: "qrwZ6U6zo11Wfxklbmsz4fQJ38u80W6qmat58sW4dDg",
-	785:  "OqM/ilZRweeVXrxEVyR8Jm67icttPrBbSlTqx+6x0zk",
-	3264: "M7o6n3uhhj4KrnxjRgKllWiNrHAMiahMVABVqZqMMuc",
-	2612: "iJW1G1p68EQWGhe8x+3JEVaKRkw4j+moMKYyyDgsuoQ",
-	1894: "T6mSg/6jfRp/kVF4clzoVTfPDte77d0dCbb9MkRqWGQ",
-	3164: "Gxa9n23MHl12PuxLLKrEqBbOGqdFN/+VltZawWhvuN8",
-	446:  "/7r0DuIzYWexvkgBXreJQ6DZRFxoOaAJlLOjz7Dfj+U",
-	3880: "Y3ylTkWrsIMLdNkOv5m34lThSfCHsYahzDth/jTW28s",
-	3795: "h4jrerBSvv/YZpDWzg9hwQPPO2MQgeWPMepE6PtCI88",
-	3695: "F01Zt92xSaLQPhqB06m8ESE3Q89S0Jy/aUQmchANzic",
-	1742: "MJ/EHt0fhsyezQfRNxm74jQXd+SsVw5UBj/xB5K0FEc",
-	2217: "eecgUT0D5QH4WMsiEIlVsmRc7NTIO+fErYk7qWEJVPM",
-	2747: "mp0ZcXhabmc4m1SBcFkWF13V10QZVnfXS3DqlC3voww",
-	2999: "SMzGxnknT7WmU0FfFOlvThosMJEkQieKzkplaoyyi1o",
-	1769: "HljS8tUjcuBlnI8/sLu4JotNhgyglgyb6CkdpBAy/ps",
-	2150: "qsME+E1h4ietI9A40XFyt8my8V5S2Ts1XR0J5gdsQ9o",
-	1824: "tqR4QLjrJHuGqtUr5Hss1khdzlvoYq8nhz7TnLhRbNI",
-	3866: "z6Z+VsUztUeAi+SE5VXsr0tMQgDfkT0pLL43HDXUai8",
-	3985: "0ndqAvwBelQOce3zBfoGWiLq3AIc0lAK+RvU0E5xGn8",
-	2522: "+tf47YvU3uyg5irnSbEy+6cwxsawFEYX6d2LGu0mNPM",
-	1782: "vbDxxg5+D2kbOsdUNmb4HSSPuxBk3RBI9yVxSiB1WP8",
-	491:  "TgK1yfrk2oNGl5rxCaAJ1D7tVD0GP2G5Dgq65jKvh90",
-	43:   "hO8EThy8WOg9NIqygNDDb9AlIkRMn3kJtEzeJFbz6r4",
-	1912: "glvgwHvPaajMssB2srjXmSfIowmu1bElsGpHsVJczfc",
-	2171: "09CUBKr5tkEERvK/FpYDaAuOkTULpXllWykWWFHxxnE",
-	2138: "x/ZTGxbj+F535CyVZyMXP8jDwUrQPZYy1gRExYEFkoc",
-	250:  "zITr9CnU4ayBPqbDFzZHgnvqt+OE39HJKKCh5yKURy4",
-	2121: "Ekt3IktBu70lGsVIB1mqBgAIGqujT9qNx+QSiAfz5tc",
-	3945: "rH1JBskSpef/ZCougBMfRPTH70Il4SKIP9OEt6RoSQI",
-	2204: "fzS9N4TAiBrh0wDaNyfVOHXTwxG6BDED9Bse9u4moA4",
-	313:  "wIDrZnXUqInlX4DIjw1L3YqkhDEbHpsf6TKn5Hwzfpg",
-	2640: "7IhJzSVy2AI7tn8cMEfMxC8v4JV1MusjdWMC+d/Vel4",
-	1138: "R90sM+WSuuilq/WdUrCXLLerl5sQuoW0JaLVQMCZguQ",
-	2597: "aDLdSGg26p1RWnE0K2GdvCsDUpVzLMqCOqXvt1MjPz4",
-	864:  "XO6xDLgO8W++eMBj5M/8eldL8FYp7dMUvPpKBHF2aPU",
-	3654: "+ilRggrSU5v4BuqOd/AcC5IDFKKTuSZj+JJl1ZYYfMQ",
-	3787: "VgcaJUnagtSEND8SzhbW55E4RQmkcvV0ErrXehx49Nk",
-	2203: "VQ7sPk8WS7iw2iAbFR8OkoAlfm7Mk89AII2GIb+l9dY",
-	2495: "He6h4WVljVKodr2roAN85hSsEkKmT521TXcpv17QIpE",
-	3229: "YF5CEEZjzBWHg0Zl89JFSyF2gxBG/XyfQS3Jrh9VBwc",
-	2630: "ne1Tm2pdcZEh7xQboG+i6MF8f6yVUW5xg+Ym4cUCAd4",
-	3592: "WDLHtzCy2I2qFqnu0TDaGHYMywbBMhr88zUyD1Q1tTY",
-	1930: "om3RXdEB9ySh6/jccNMGP2XXaHiapB/YjZ2zrLE09xM",
-	683:  "YICTIo2QzOa3V1hhZcilgrlSl032I0EHnMYpCbVoKSs",
-	1031: "9xUytFMS1SsBdcOJj3Q2yzmHlaqRunjG6Lznh9hg+xI",
-	2723: "kBw/TBl0M4SHdv+M5Ym5iqpZDUWgeoEJN5SVRm/3D/A",
-	2629: "PYAhwq+LwLbFH+BraiF8GUtT2yZdTi8QDWu5pIQmqdw",
-	2001: "7/57oPSiubp6Ye6mAK+fD2lm4HFgoA1W4I8t4YNheqM",
-	2535: "T9ObEq/Emen9Zv1cmpOUOG1dGirwtHuuG8MLggtoUGI",
-	1102: "gfwiDnGnNw2ciWNqIyxozFgKJE9Ta4nk/qOQWs89VeM",
-	2158: "I2S1Pg5sRgTX2pz2bF/3ZxlvJVFbxwG22W5z3A8RQas",
-	3917: "0iVxop/zMZvOGgv8JZz8elFkoJLDYqSn8ucAbrB4EWI",
-	830:  "AUfsk2mX6ICLBNxzzL/DpVIeAEvu+SZk94EgVE1LO4A",
-	2641: "gs/uZ8FJvdVxcCtC8s9cgV3rEX608kjmmAnU0IPPMRg",
-	919:  "9OE2zVQaOU2U0XpCdBoa4zI0v8nAuYfWE3sDQmlAq6c",
-	2219: "JkxZeGLBYtA6NsAV+Vmdkkn3J4IrHgDFJljBOQsiGOw",
-	2485: "XXbjfq2JmlNlHnym0fZwcg7WR+r3Y6PQLY5tPoikY3o",
-	662:  "tJKPsOdDfKsnXdlmikgnNUEasKdt7jI9tT3t06bClDE",
-	3490: "U3rgFf0flgLR+3C2vTp51gyzXRJQahJ73GzkUAjoaYg",
-	1634: "MLpozHKoJ384az5GAyYLeiDJb4pPe/JJBc5stV3bQks",
-	115:  "elL2so/ayH1/bQmJmluc180SDvhXPOUpTHNv599C+4M",
-	3557: "fgyS/rOlzdjEBnPpb/ljP0jRgU/Qpfzi2RXH+9PyDo4",
-	1640: "6E++oNvCu511eO6yrD2b3zVfNOPt9Tvfd6mzhrhG3ps",
-	1097: "7YwqmN4y+6unWxwdzpeRV7k32rz14QxyDvrFNLm1qTI",
-	2708: "vkNeFCRsPhVUWzOIQhxXNOBexXx1dXrOJ+z5sZfvwSM",
-	2444: "RDaF/zbFXMdTF+IwXBvFrHMVZiasUek2cZmkKAqnHFE",
-	14:   "h366hs6WWOQW2YGGsWKfgZMGCCZoV36lUIMGKheZc0w",
-	3818: "D+IeY4ON9CZMSYlHsofbkefTZvI5CKeis7izTjvdZ4o",
-	233:  "a/1EWGFShPOvOWr26XesuoGU63B8XP8JPpvIX0lYapE",
-	2069: "TSptpI4PjQZJahDTNBMV+HhReVpQQ3RrFS5jpPisYOs",
-	2500: "jnb/Wouh5cfxhpHfAhNIBBPeA/pclEYsdcOKFYaNypc",
-	1323: "OvKPRfacxZkxkbU0Dlivzzz5PwXj3gW9LIn03NrHP1M",
-	622:  "4Og9Rc9255Hh7Q+XTkipUFvmGSDJnlBOtXxL/ydw5aM",
-	3277: "IUent9x//y4coOFBfYj01sz4sTP/UHkT/kDpzpnXzxM",
-	927:  "TJS3xpdmg3e3cBPKpgw0RaD3Qj0qtjubpnR4cULsVGQ",
-	2277: "K6Ts+86/xQ9fi/PM/MamzId5gxjM/Y/BZckVJ7SDa+M",
-	805:  "7+4BLXwOBlsmIKaP6E6Vbrlmd4ypsxDUa77SD54BxP8",
-	1603: "HNC6NDu5D93HTI29QfDH0AnNSqEZjMzrh2E1zbih5CE",
-	2876: "sM+3RVwKiI0G7tgcxNU42ih3/NSCpSJrk1nDoc08gn8",
-	276:  "bfyjF2NcwrEKNCRe0M/BGMQyXmAWe17Fjs+Fy6R9M1Q",
-	367:  "SFtdVRuRbF94tjuO+a01xPmwTRYnM7YtdfT3ftBw7TM",
-	3966: "ihFk5Cd8zK+aVmvFRePJVojkBxoyax2RWkdZq9OqPHI",
-	3987: "qBScXmK3oEKvO1LrcIyOCa2abyCknDFTs3tnmWG6Ly4",
-	702:  "SqcdRwhspfWCu+ZNZCW4TGYUcUsEPPAPkduZI+VzdN0",
-	2864: "A2F/K3Gk3PKQf3ZyP6I4X32mb8XQY1sgaVp7nUgjYz4",
-	133:  "WWIxoAdjkIH1OGIANH5b9pPub5OBpK3Fh6RYZmd5oJ8",
-	3177: "i17GOEb8kgZsDrEVnHs4ZIu+82ZSdjUHZhOydLVzKg8",
-	1541: "PUmJPABIz/mA1aEJa/QjUNisKrvKKxoGVQz1o3knvWM",
-	3044: "lbNmcMgkvw+nEDJZXXGd2YOk2SityDSdQeg3KA2rlw4",
-	1450: "bmCfXkpwlbckwk6NlcIQUJ72T9DJZnnlPKbIPcYr/KA",
-	858:  "KqmW/JCTy4Z2NO4VxfloLLAcmRk4ERjIg3ELSEykDjE",
-	1690: "B9OVeKsahU78hZfdY4+7+M2+9eh2U2gF1rpE8MCy7zk",
-	1012: "JWPv4pfeC9M336goMn9xU5Eg63pYapG2kSBf2wEapWM",
-	2968: "n7SEdTDhy/V4Ec+/gEv5aw+fUD1CJfCiOh8WmINxaY0",
-	1130: "rM2GpS36Md9u+KDO+2mJagzWLZHyJWr2oQ3IHd1nGCk",
-	1923: "y+Idk615KX0zXRlWXkjqBapxQnt/I9pF8ITzgbJwoNA",
-	796:  "nf/dgTulreaIYP3V/8dRaVvnmazU+onJaVbm1QY0KqE",
-	3494: "nMnAieOCu5fAriytjBd4krDskn4dH4dj4HO9Ifq393I",
-	2193: "UHK43ngaKxRTs1DqLUOjS05vDEDesRrmf7rKOct2FXQ",
-	166:  "+osNk7mZly1ykWgyYsUYW3LBO46H3M/+E1r0hxudXQ0",
-	1527: "o3xflD26/lsJK0lsfSQ0r3nGVVUgCvbhv1ztdHBKffQ",
-	2141: "7t8XBcXrdWgnUkkAUjd/umP1pVNWaWVItzD3VY1Dfgo",
-	183:  "tSU9vNjZ6vS55/uDZ8Q5JQUiV4+pihxDhy4tD82v7F0",
-	558:  "XlrxEGGOfd022piHw8cTOOI1uXwt8ahXyLzu97yGoOg",
-	3558: "cIgQJs1WayytUqXvI1hVhBa7Cp4Hu9+xVWtxAZuldTU",
-	2981: "5BPQEzO/kjswPM4qkybpGACrW7fu6P/VWN8JRtMFeEU",
-	3892: "mKoi8KtJ0iB08kiwVWkvbriOVoDSHjMKw389tJ94H8c",
-	2422: "9WKPXbnDZiqUSZRyLOKvFB3qyx72NRHAzThZ78WmTQ8",
-	1523: "sj5b9+TKEAsonIJOTHlBZbkKJ16KypNTqWf2A/1M8C8",
-	2676: "b3GlTm7vvxHGEf+gHc5vBFQt2dcPFQJCkYYBauuQmO4",
-	3032: "GpORzYABzH0DAhTzcnexv8D1nNZgmzUmY3l6NdP+Jyg",
-	2104: "2HONXiCXpQwct+NZFucvkGTbt0h0KhracpayxcGHphs",
-	335:  "B6qu2SjfkooXu8sT2Hxg7EoQa50arz1CLMEkJa8nzo8",
-	3758: "zXHnyKWZjwN3+bSb9xq2iafzCqf3BKxAggwLHQtAfe4",
-	3206: "tvD7r2w4/xSeC6NzVamIgOTJuhiWXvFCUyEkRhl4y+U",
-	3240: "URbnjxH645LB36eP0TF1aEKdKrufs+X4Lsjd5AbGHJo",
-	2005: "BRup2ZyS8NPQsyaXRv5tCadoVj8CjzJjilEmYRN6O5k",
-	3756: "cVyuvVlsKVGAEjuXLGGFROmdoAHKBO8vXIB9kBeM0Ok",
-	458:  "UUAhU2NLL85++kP8FI233/ZsBxNWnMZ9p3IelG2xX+M",
-	675:  "kz17pufYYh0Ao5SaZXSDFoR2xNay07BvvcCsSIkuCPQ",
-	2866: "wWbsrkEwtM7bGXaUomiV6qAmdNfrIP1xwUmCiH8m780",
-	1587: "an1IhMcLDkh1HvVZ0BMgReKNSPTlZ6qV//QbT3FJzX0",
-	425:  "AtE/F6K46WCBRf1sm12Bk3gfniQHfd/q97NlLR4Ul4Y",
-	4:    "5pNHdibLxj63/is0Frwh5MQOfk02KJiRdhAal6ayA60",
-	3711: "GxVYpvNVRLBv85sDBfXXMjflLrqnA/+W/YPENF5YhxI",
-	344:  "VlGiJlb77xVtcFkTsXAYoLgJSVRQtxALngDNSamiiU4",
-	3060: "ORXoU6ZxQp+GPEb6fy0rQQWnSd/H8u+iH+Gm4HhTuX4",
-	1671: "naJfOckt7FfTTENrRfMe/ZU2hz6+G7XhS4YZiz+L/68",
-	179:  "pYrVygypTp+WuDrwLGadgr/vpc657qVsq/26s+JH/L4",
-	1378: "CF6u+eB9gjD85FppE4kqHsN8/hKojixECPu0WtECOMY",
-	648:  "gZJTJMUq5NpRMHEIbvnX0oqVefE7PoSiXISx+GpA0Dg",
-	928:  "3zCaSPGAwvhezRoD6rRrFM7KA2Ri02Iq9SlDcRubNGM",
-	1489: "9xpVF9QBHywlS8ukpFQnPanD8MeZCCP0i/O7pv1QIm4",
-	968:  "+yZryIMgsrruP+hGhxJyAp0nzsIF8tDMdx2mr2pSXE8",
-	2668: "r8pZmnzpACMlYAp8TEm7zFWl/L3pqmW86tOM4lWw6Tg",
-	1468: "eaOEdrWGdveGR+rCSm9IPu+r1uNzbwokrWy5BUSEK+M",
-	987:  "uHoCFcFuOGxQwOQLrJdoqgrBxgPvjJ+kmQZVtfMsnns",
-	1341: "x9bhRTQHlJAvYv/mysN/bzpM2te16aPQAjw3tJL7ZaU",
-	2000: "KjKsapIJFlQH442tAG7ILmJK7EWqhLkQNbnr+mUIhxQ",
-	306:  "D9ssvSjig1J+1bjMT6d6O0D8+VasLoHwKrqp+6z0dys",
-	1274: "aVzLhJYRZTFRKlhbX4BiVerUWPPflRB+Ol5n9AUnMVg",
-	175:  "R40Y4/RxlVybyz5TugboIr429lzUGz+zeCO29iDItTA",
-	764:  "03luh5JhGJuY49dUkFaeZTfNd6/h+EGu2caVrjC+jjM",
-	3003: "Nn7J9v3aodpD/i+Us5bGEdCqx7Bn4fLZnNKUyApep5M",
-	44:   "zohZA3DjZJropV77YKgTPqIGLMY7mONF4J7YUYW8yNA",
-	3493: "lhKtbg6V77o2MTR9x0igOUuMw/m1Qz48T9cHkxxNwIU",
-	2302: "tA7FgO01VYJQfQfL3pzlOmPoxcDdBYa/O+0ILDIe0G0",
-	2642: "IyUA8NKhXerg9/nZvlW+DnIT5aIgLGQs0VeaB+2q8ck",
-	646:  "EXYUqUnltQGUBIbsT/+EcBfmWSpYvAz6bngs04qEreQ",
-	3602: "CwJOtnCpCvokmgS9pjxCkRE2Dsx1DE3GqdtWXquiVpM",
-	3476: "1eWvdDisSbjsRXDZIFm0c/QlGD4hVOvZTdYJsy8uBWI",
-	1735: "aJRfQL9EUco7z+cfBFfB0eEwjRXK6tS1EARt+RfLt0w",
-	3472: "NlYqCLCPdN5EiBJzh195896uDZ01QQwNfTCApZ7BZVo",
-	2192: "hILSjeqT98CJDoqNXsxuac3AWu119UkCqtuR8g2lN9k",
-	177:  "Lbbzlw3hFqUZATPjTQMHiqmj8UGyP+ggT+FmQrZLYh4",
-	1537: "E81kdeIwQCBHIOzxU9IUISg64evfgVqW/lAxFG1T+po",
-	3560: "YFe5+c39oZJpv6kZzGbQjpnQ2Z6/NwtMKYUyHE61Yjs",
-	751:  "6oXz2xzamRmCOjmtlCU0l8kBuxZX8hYCUl+keWj/jJ8",
-	1189: "UUSzVrpsXUEc+tvaS2toYm8hN41gfRghgA1J5XQnnGA",
-	3294: "oAvRYbsCIvTObcvSKeWvSc0e+qBF0PWFbNh4oyOzP5s",
-	2013: "O9IfTxSaGKav8GNigl8ig1lwzdfQVsVC0Fv4urAOdcE",
-	15:   "A1YOKoz/YMYFCaoDFeUdaBjGoAGGqRRE5CTMXztKMfo",
-	2419: "Ur8YosfB5OlvoOpVdivCcS00Mzc+zlKPkAQO3X6qVpU",
-	3884: "Ayp1Nbw++/HQSDt/PrJ0UbuiQwq6E2z9PX8kMZD44EI",
-	3573: "lLVhaKK7EvC4zBeUrbrrGKIK2ZYBZhaPhX7cPmMAL40",
-	1085: "Cd8p9idjpYpC8IXf4TnpDbCOe5S8QqZbNQBlhvamMbQ",
-	3173: "F8p4U0E7oRZW9uWUyLTvsXI/HHSkYFIRYpjrd+DOcTo",
-	2850: "tVaDLkuU/xEvQ57D5sDYkBjJyLPloemwqvc85pntcJs",
-	2388: "tcICJ8vZzTZB6vduDKTX0zcrVOvGvW8DDn+w/82s2oA",
-	426:  "7EQQRjqRaqe1vpcUF/vLBhUi21tNX2p4HuZXxG7GxZU",
-	2134: "Zx7TtRybgrg+H4Foat8cf2Tnzhoc5sHZQSt0yPKfYPs",
-	2276: "DB6Em+thiBoYoJQnWvZGf9WfDDeC2ReY/yBrsSikNXs",
-	3046: "bCirqfh5+b2BQS8tliZDeevuUrmqc7kRHriugAFQRmc",
-	3838: "l9G96NpPeUxmUOUPMLxB8yajxe/vSUpyqlLAiscAVjw",
-	3470: "bVKr5bG98QccsfkOiw7uq/DsMywwwc0F4pip0u+13cM",
-	3183: "iWSCUZ+ly8ue3Snmm+wQRwY47f4Qd4Qg3kqQrO4dwXs",
-	3260: "kbkB8V4Alt4y8ST0bMEPOyYEHRsocY3Qqwc/7OPOyW0",
-	2993: "NkTqiR4OGWdkPnmGHdAi9D9mAb8DHbdmN+PHhXpWAI4",
-	498:  "zCtQAUPkyR/e6BNhJwxW9WP3xKbkLQatnHuSQPdx1Zs",
-	790:  "VyFdkhOgIgCzCD2d789BX9XclR40XzMfgefn9Hnzy68",
-	2795: "4bifWgTTI15MsMN+lBpc5hDsdK4xg0OWJFNmpKDiqBY",
-	152:  "kMj7beD4ZDn+8dEsryWC8W/AJIdBG7jAKYVz++v00uc",
-	854:  "kR7usVfbWsLSdfp9bVfpv6fJHiViu97Xo9/hAp8hBLo",
-	212:  "GNVUhiKOA1476dMTqmjeybYcqLsran4921KH2PVHS2U",
-	519:  "pZpcUbtw4UwUq7HQk/zrjc8swAuIkhRgm9MS2tUhZvk",
-	2337: "IBzlh4AxbpTLC03j8KsdcjNp1jgCD/UwFJHQStZKMt0",
-	3218: "ko2Rf1uo6wb2nO8P2FSoaQtrco0r90x8KI+Mx5rSwZ8",
-	3204: "bo9HKMujZNVGtj6f0HxhkZCUZfClb6UtxukwZfNc2ZM",
-	634:  "FjlgyQgLUHwJyilIYLDY/5wqxmmrx5OOp14gMgSo8Mw",
-	3373: "9T7DXvMPD95O7MY1ueK9ttFuculLLCtztJXBRGJHZl0",
-	821:  "s1K1lJZeQefiwBaZIBkQW+j11dwgbagijNX+3jwrm1Y",
-	3392: "IESMqEIGEGR2MulaVcJIAhsGFwrB8MqtI1JNwSvOseI",
-	1943: "amn/oDoLh4c99hKCrbbHgM4X/Ghfm4Z331l7fAawB7w",
-	1932: "K6JmY42ay762KFgENlnIXlFcKQds58c59Nj/FFKOLAg",
-	649:  "tuh6MVlnOdPTrd1FMvMxzMHOq/VNU5lRiLnQmO/+NOM",
-	1368: "9FISUhPayUiUUPL40W8lVy0v3mUrC1KmdnD8NWhdvmg",
-	952:  "F2KoFagjOVY2jPN5byaJs0089Y/XogDQ6Yl5IJjBVN4",
-	3086: "8t2hyEiSeJKEEfkTx5ndmiNzwEp4IkMgir1Er7OGrwc",
-	1401: "qc8hSkpUDcqTYBl+W8OkO6Nxt6bA3bNiqGfbT+qifAI",
-	921:  "L+0Ykks7OG0IJumg5F5uaVTKEW2buXx9EZyBlwTKalI",
-	3755: "HfpDgKBK3XjWmN/sWao20AxJMSLf5OSBSvZLkQFfUxg",
-	1760: "WjqVtiVks/KmmaAq55qIps4n7yU4ga42zpt1QDrCSNY",
-	3109: "Si3GzQTwvXEhMd6ct4nNg0y4GkVdeZXzA8SkTwVlpH0",
-	2703: "YfJXlQd4dIG8wCWdcrDrAK11kfP0Inw6UVHTufm8FdI",
-	2821: "mN0JFi/88jt6syjy/gkLa2DUG6yP9bChm1SEbXOe4FI",
-	562:  "F3ToY7O0N/IkoMMaD4GBKRp/5EBczoq3UQQuaHsaNA4",
-	1990: "fQyiY7CDCLesSgTwAXQJA9ZmNBDZHEPT41Vv8nWmVV4",
-	2232: "71KV5/Qi0Qw6QeVNU0POGqIEuOFYTGqVrHSggwb/pGI",
-	3459: "2tINQo1ewZUg5xS7TX/zcXECNohTb7MPuq1PnKeVMf8",
-	889:  "eLNS6C3kovFJd/nWwNzEa1l7w+hWg5bzWpttJr78g60",
-	749:  "p3jKbUc22H0+9ZbVrEnxHq5DpS4lzYJ+Yp4RjiXSvJw",
-	2731: "MIclFOwi9cYNIBfGqr9je7nGwnTQnnIF9AADrJGYJ4U",
-	1571: "Qo+4Yg/dGRxBc84BV5dnbo4LYleiup4TLFoW1gXA/Cg",
-	388:  "t8UjlcBFJEzdrk87sFQSd6M3xcgdbK3GimVKILTxrAE",
-	2198: "Fc+p5tjdLQKET951lkMIOyjP4cmfKgJKmyipJJ8ZGhM",
-	2996: "DkQDbUwVEETtBBvJ66zs4Dc67pdyJAAq0AxHC4Fok6w",
-	2972: "kX+oJsKNxq/C9eIdE8GA8utX9CQJQylfCGBEsSa7Np8",
-	2459: "B3E2Peo92EuXFYaitgUST+mY5DqNSV6Byh8a9vHTPMw",
-	3981: "ZfIFoKIRwY2K6GoERyxaoVf7Uhcpkag+KBN48tRq0h0",
-	3138: "g+HM4gGldoEhnHV3ZeaCnpfrN+CaEfS2gzOhTa67mK8",
-	3191: "6dKEKktLfXwA7zO0CRVu4JwerF9piJK4I3XxrYbHoDE",
-	1321: "Li7d2gk2J+lK5WrwdOp5tjtv/XZf2ZItC/4rVsAIG9U",
-	2030: "UrCOCqhN6J6WE/fTCnroGwNKy8wfdejt+KCF+nuAg2U",
-	479:  "AW6+x93Puc3e5Gi6/uedwpBVa8cWRwLuZmtYcjmnT3s",
-	742:  "xgbtRiqFbgRw6FVU/eR5BE9n5DmBOW8iFxoBIP0KaU4",
-	3036: "VnNTmDI2S3YD3OossuPgYbHjOmir+k2baaECF6LdJb4",
-	19:   "b4gLvfOjdYKY8WTLoqaw2dnx2U5auqrTQ43Ce7bgroY",
-	2009: "C9mBdf1JYU4cMDuakaWWkc7YoMoqqO6uHQ9049nLIcc",
-	1098: "CLhz7wandWtrULdXwGyVHA+0a2OCfcC606lS5oJX7dw",
-	39:   "n+ZLFubWthGneYG35zd6FsLumACVyQtgJo6G8+PQlow",
-	990:  "4veOhkq2CnlpVtU5ypD30TNxxfCORUStQ7xvSj9pBoM",
-	2448: "fxrqLINr3YFzi6xLoh6zal1jSJGKkwWTrRbA9en6Aec",
-	2449: "emkqgB/+QoSBMjRV5nluAsGsdNzCprF2U7De8/ew1yk",
-	2305: "P4os0cD8x4k/LhIDtaaUy6uLtcwB6mr0qwTdkaJSg8E",
-	61:   "fbb9P8n8T7ir2mb5OAqRmgjdtIImmHesYeHjLHv6Boo",
-	1383: "AsFVsbDzOEGHtyKT4zLZ2EZtMlvQ59ZPH/Rmr86+gZg",
-	219:  "UtT4aSBJbt3LDzFLhSrsSixoNnRwWm+wwLSK7HDUHm4",
-	1853: "VXnnmVTKyVnLsn75InnowBdcZRz0mGye28OBh1N7yPo",
-	1636: "nj1TWf/oYsaUL6T5bg6TV0D8rCrmi2xDMm0AURbYkS0",
-	3361: "JnYGvOFA3Rne0JYQzv/M6tX0WMa0fzKNVcH4gNy30R4",
-	1678: "IaoxOAWuvTgDTWY+4svxamiPHPIw5VowcfJD5f6/KTE",
-	2776: "t6tVl9Gc8/3u+Z4nG3NX/PDpYTM4VCxlE/NhYE8Epxw",
-	1836: "mxqt/5nbR5AwwaI/FLHO6axIdTtKb2ektGmUzkqfDEY",
-	1973: "+I+FQ6OmauwW79A1p6n88ofYzxAp/QWp6ffiMAYep0Q",
-	2440: "gABHYUh22G9CVnHfqVLOx3YZADOT/m6Pf3KIz00nqJo",
-	528:  "FqQhT9j54L86ryLtrDuljnn9RAUrjTbWARwz7SITz3c",
-	2042: "lJUAYE0d+G5zW6GjzSfIc8Q53OWxatidLbWxtQTeTGs",
-	2284: "SpMjTSgdE8l1e2jOF2micVCsrerWrgSnnye38/31ri8",
-	2851: "Z9QEut5bE7EqlImcNYelbnDZsT3pAvtzVccybsHZX5o",
-	203:  "Oi+vi8LJpe6EgWCOqD7Fjyev1k6LO6F/WvBBk6ul0dA",
-	709:  "tkWVGUwkwI4bKq2xfelAttA+7XItBC+2vuilF2P2tDg",
-	1044: "j3oUcaDe/u6ov+VKw0b2VkSaeRX0hz2s2/+EPqPQctc",
-	793:  "N+R/MUar1qY24s5T6sEAmrwyXTdtSkm3mZYOID+CEmI",
-	1266: "0JQSam09/PEpTECFUjeeA6dQlRGi4OL0/Uh/4WgRF4c",
-	1196: "UYvNelfhZOzDIgGXEOUWlA7e5emlNz5YgvNbaRSCA+w",
-	2798: "1sKBVLNt6Gq+qVyhRFXjk+mGh0DHtxFPMAXRYZrZBL8",
-	2294: "BtrlV8G03/pKn6BLAQAkNnC3ZchiQVJyGU9q3+432QM",
-	3279: "sUVpOgtV//yjC84Y3gUtypMdCZJc7WmBboZp7UTyyTQ",
-	1789: "lMRPGBQIoOlLbzCRj+rT3a+ODzJqDHmMrxbwXMShBFM",
-	3289: "7i7cPa/CvkGwfEvE4Sc2/JOlcBtYi8wGOBiYL9AZ5EY",
-	3871: "C+y/ECzMutAEQF0LwkuUGwOSpNEJ0E+dSMpGfwXez8w",
-	2893: "iccTqqnjtzjVBAY/CJwNS0ANYExhsqJAFCDTCia9dC4",
-	973:  "Lo2rUOmbmDLYpiW6BtHMTd4gyGSCSLa//xh3AwQd19k",
-	1284: "H2ut5ribTwSa+ZbjwpLFWUpsAdx6BxlT3/Q7sM3blko",
-	3649: "mecbpZI/wrca+ykGii34q6l52ka5VxP8KUoeC0V3ing",
-	1902: "rscC5JZK+p+4BVxDBCRXEUsrhFjYrp0i+rra2ceDj4Y",
-	1143: "+NVdGsmVIaAbXQUmJ0q3tBJRpMA0LdctDhxs9UCVsdA",
-	3329: "qQWhg60rhgKb6PrJJzcKpjSzdrQJrff9D8ThdKzdZbw",
-	857:  "jW4hAnuBszaZThJuvmho8aEVSAzd2rWnCy8l2exuBXM",
-	2820: "luVzB9Zoy0ifu6LQD4DR69MNYhH836Jj07xFYPXnUyQ",
-	836:  "dhyFAJ0iDkx6Eaq2ezQZFNL8AQLb3KZCJM4v2QGAQ88",
-	856:  "aH2bPZTNKpK0OtRuRIzSYdqggEViiu4iN41NXaVRR/I",
-	817:  "U/YdPee0+GBrBqCghAY/Q02f4HC6bRoHbZkQYysaf+w",
-	3994: "zuEJd0AeSuAFbT8Ymdk5ax9JLGxc+lB4QddBBiQ8nz8",
-	307:  "Y1gxhWOc+VJASIlZGr0Jbh95rzkbpMt4cSKczZS5XLY",
-	1365: "DAFTAgOtsF0PBccqQF9I4DWkeem39QyUFvRNKNhBbeU",
-	1206: "HwN4wjH3OPmmnmkL0pDfc9d9RZ+CLftPTeGqwP3Q5vE",
-	526:  "NCcrwuw3yWZki/lhBxM+Duhn97bICvVYJR+8gdox2Dk",
-	383:  "Kr90LRG0r0LARYDkvjYwWZkIE0oLvun5ymrNK5Ilv3Q",
-	3642: "bXC2cYJ+JCbYIYEsDlNO45Dk8FNRCJVe/rcWpNqB7po",
-	1543: "O70vQ83m1gQ/n+zdg0BlhE9KQmXx0qjMke4haPfgZmo",
-	456:  "Vs8mRKVFaGfakkxih2yLKPZJjvncZ1MBsZ1jMzbnql0",
-	2148: "QwwQN3rXmpdtBO6C/fu+a+lVsUEWNk6UnNx8Crf6FFk",
-	3139: "3R7mUnjzO20F7n9avyo3CuhRE+hGIizKwZWUvoIG4Fc",
-	3406: "Gukp4Ht/xbQCKnWUE5tdYU5ht6a+rht1OvglfzQDEYc",
-	3317: "TSkTjDgxjMc59dRurGqeZQ4nc05/czSkA/JknzMDyhI",
-	610:  "G3zGz8Bcs+aWjzocxH8Z9QzbTRdx3GEQYhgjv/U2szs",
-	553:  "ooAxmV6+3nFLHPgA7V4Hnrq436WpTPQ31GG40kQHQ3s",
-	2875: "bn8rfBhETQuBCX0uBCmhJMJ7qn0i/cMhYF3hmnZQII0",
-	574:  "h7CErrR4FBW/hH/OJzLQWqD+wgZD7uc7ZP7kFGu4oYI",
-	3234: "/Nc/+We/vc48q4Tq3JXfIBLveJ6Ruiv7xT2dYGFwjfM",
-	2658: "06EJuDdy8fsPT2rX8VFB2oJc1VLlAiY9xdiQvnufT44",
-	122:  "FZwEKmnGWaTkAXgw1QyIQlIa8/G8xUhClD7q5RcArug",
-	2178: "m45CG3yfGvt3f0iQ6+PtZco4OpcmqHweI/doMCzEne0",
-	3453: "CZ/lTgDRBgkLUfQUh1Wj6SGpBE637yoQjhVbUbxqUSY",
-	3712: "XowM46awJNwnimb9ZQauAc5SVrC/xspiemS5Gg3CrdY",
-	1286: "zZ6+v6Z6fkdymaw11behXZOMgGNEwJzFM0J3KaBCKJk",
-	3353: "ori+a85wkYSbkT0Bbml9qNo9ANbQsVhM89EqpPL8ZeM",
-	1414: "t7RSUs7CjXzTnFOsGWWvmpQvRp/H5Ieh1SK+z9Gceh4",
-	1753: "Y3ac7DKXfMx+FsI7b7oMu7OORX+N8kinFmot560d79A",
-	1188: "2MP1xE/mGiKlrosvDja0MC0xr52aRts9XzJ2Xebqsf8",
-	2421: "/M9wYmihO/7cZsE7GPTsE7bId8BgXFC982qcZ87oDOw",
-	1392: "JfEPmfWjqcoBob1pxm+SwgAijGFi2G87VnKVLa2zHPw",
-	1855: "b3HEACTyQyt6oj6aIzKN5KMVunTsm0oyDs1dFaYlAvQ",
-	3519: "LBpTr6DrS1cBrtUYaiA0uaGUAgbg3nbuN4ddArziOkQ",
-	3513: "36QwqznlZGWKPdX7cleampuz5t5iIas0DW2ZPTaBjK8",
-	1427: "yHuFrcNjsJq+36br0/BiH65qrHGttPIWbDF7F+V0qrw",
-	435:  "3v+h0ypIxP0POu6Ar/14v8M9yeRo1l7RdFJ/Zf8tXgw",
-	2221: "Tj0fpFxH3gQFrV2m6flTcML2LpYcMKXUGrD65VX8fuU",
-	829:  "vWqE3O2k8LMPZFZF3Vjs/sYHwWYqD4qyZYFMB/QgX/A",
-	1954: "xoOk/sG+f0Wmz9k7vdIlKDA/Yu9An+ZFgQyVRduxf+o",
-	1311: "+z1VYy7XbAIuPQqd+tBzKv3kpJ8207eYWK+/NBck4cY",
-	1417: "GDbnnEl7v4iwpNhEhwUP0dy4uG0trM7JNaEniMZTAEU",
-	1725: "iEgYyQ+INJMzLhtXtVko75dVzTYJuzUw7hfuhdfI4TM",
-	2808: "3M1HVeGhnvmgXxFCuthf3Ai4OlKtQTHzDYrXCASpq54",
-	3115: "w2VTwffsNEhlBqtWgzufCRhWQaPZlYUU7A1nMPKlp8Q",
-	1048: "rXg6ZiC3s8hoJ6GRE0u4ihTIMSQM/dP0UnG2t1xf9ws",
-	1737: "/yxHdaLcivAvUtUnkkseJCrCQKUhGN3JHnQGErKED5Q",
-	638:  "AngBkPH8B0jr5uScanxqo+d0qucfhGRYeoQBOxY1FT4",
-	3077: "565CacHtdAbt3WwIWFoBBd7O3btQ5ke8EHJIF/SPTzw",
-	2623: "NU+KTT4v4h0C5cy4CYgb/jZ8TAYDx9LmfW7gez823VQ",
-	3432: "qk/+rjAUSUH/6TiSl1uBaFLoRVCywqQQElcM9nz3sjk",
-	2967: "KgEfk0E8Il821fjHEp8Gp/IEa5P5dx3B+3euGJN5kZU",
-	1796: "Rwncjovl7STNgJLWvuAztsKeoDp8290YDaMP1uFRJ+A",
-	3604: "Sc5968WrlxnS4EgkxkCC31rWg0JUjCP+yrkzGWcxjdg",
-	1192: "qnnGv8K7uYhFmTFQ8eE7XlEJ6dgJ1IVzYWMe0ltOv4M",
-	2161: "9BfX6NQzRe1x/DQ6dMwEsUFh76be3eVZhd0WFDdEhyA",
-	3768: "SQjR24yqRp+EBRHD5dI6s2TOIzLTQMczW4twcavDkpA",
-	2322: "D/BNzajnPuqtM3083EO3dDJid9Rx2E4wBFH5yayd+PE",
-	2181: "7ZCXT/bAUggwRdzFd1CY+f8wYAb3W2yv0+GfkHO0+Es",
-	3944: "kWqL0Y3rH/uvXka+lc1lNWPGS/frUbwoLv8CKN3foxI",
-	326:  "8mTFHvvRsRmym9JPto8mQgMUemlN2mGs2jha8khYNjY",
-	1794: "0lSVUhlFc8WE9py02m7DIeodZvPa7AwxC28zENy64qY",
-	2218: "fVSZEpE+USJEdKaqpETvncaXokMTp8H5FRMyekMzUqc",
-	604:  "km6oujqpFycD280gR1L32PewlhbaSnUuAd7Hs2KZjI4",
-	3820: "8sasJt0FiNhiiVdFPEmzsesmgLomSG390UUiNcCpo1E",
-	2911: "sP6+SoBNXsjciK4ND3sTufPp9vcQ6W1AqutPqQxbZbM",
-	1381: "AG1ig2JpWnGOVjlnbZk0Pc7AqiccxSjxJ4Gd6zd9ddQ",
-	3140: "Azh6Gxz5eyQ0T+iWiQ0LycIPJpTv4R2VTjzDYy8BR0c",
-	47:   "CTONPuGaf40M0hrEY3CrOyhsLif7PMBQidB9ohdngWE",
-	2376: "H+99+ANnOCJ6i8dt1G5pPmxVpEDVX21ycX20hpHhUA8",
-	3315: "+7KhxN/P69WBwLm1lpIU6NykRF5sbXFONyjZJEjj+gs",
-	1250: "0cf3/+FWXYqwMHYlfcDrfdS3TkB/3z20ssQFpW/4ABk",
-	1010: "dpxYloLi6fO0jOowlDQDKsTSqRHjxuECGoOnDc7G2zc",
-	2661: "/qj1DQxLfOTptmrCIEA322Tvi2hsYpVUn8tpe4VxvYA",
-	2267: "WA2i7y3wMYsz159mOuVohjSPB/smmSyXhzV+DTO3bDg",
-	2989: "dXV//LgKCy0V7WDDyLNnaR1EI1dEHqRXATf4kiCcxcU",
-	67:   "FI4nBTFo3APNChlVRKDTTlTF5j43lU0CG+OcEOVKFRg",
-	308:  "wmfHaIJkCz2z9vAKXO3C5k7ajWr9XdIyGMCpcl4piUQ",
-	2824: "nbaFESDqHlYoGjFY6WIXhXkFGirx2hBnCfB3xRJ1dXc",
-	1974: "PMxPrha31MMYB1YB1njJfQOxbkzJ+DdqB1PHQP3fo3A",
-	1934: "yJNDLKicf9hly9uf24ZscAg4X2QVS7lkS/rQFus3zNk",
-	894:  "ziEjz879JP5N3s2kdVoVOb5qPie/gX5py+IP87IYBbE",
-	2885: "zv4ru92o8Vfwj7pe6MJXOPdZooh9CK4HuBK1mo5/8+Y",
-	1460: "BqoE3hhIcaxIpceHbRJ0+hXJYJpD35SJbLybX1qrdl8",
-	3879: "n/FrChimIP2pTVMu73YRIBE/qHjFyPNouT7saPefHUI",
-	710:  "7q8nCmk3RJhDJgLQb0F15RjJa27z51RPumF79jwr/OA",
-	2126: "lyCrZb3ESGBTZG33AcI5+3KiNtrNuL27NZOGbtND2aw",
-	3742: "0CR2tZCHLbDMoZARKJJlfrz4lR5k06pgQ03EG9+Q4wc",
-	3984: "d0wTW3/Dup59UxxnzDo/xXEwUndkZi7nI5lBn5ddCTM",
-	1877: "s/jUmoqX0dqqKZFtqES9GaDcwzBeTzoo5m1/efBifMM",
-	772:  "KiT0AwzMfZAq9Kp6Kq2GOxKpebgpPO/KUtI4Tbf4Oik",
-	3465: "oYn+UdJOq9ssWzNe+zkKkoE3kUSluVNvD/hrFVBRU+k",
-	712:  "VSBNKKZk28EAoRzE5NDE2NCmRkgZ1K9Dylh+SppPaDI",
-	88:   "Ryjl4DckSFr+4zUxz7vjnPWfcmcn/N2DfvlU1/8yDM0",
-	2644: "L8lhdFZJ3s7tjUmcJeilStTYzomsiHtGd2cPntCs/zI",
-	25:   "B3UVSka6hm1+bKLQHUZQAcSx9Kg5WZ2zxSoQUgEDC5Q",
-	3200: "j3hqLYtoZMZF1J9dVkANBi18xh7NPCCEcpfEqXxBo+k",
-	3034: "q8zGgdwYs8d7PX1WJIyEi8lrui8tpHkAODUDm+fnal4",
-	2101: "YKQ3xbRxuc8lOhKUr1ehYSITDimv69oRs7ln6rIdI7g",
-	3423: "lNJx5pNO5tH+gBw/Ye/dvbZRTDsuPeODFXZrIxLdXWU",
-	593:  "bJS7khFa++egPMbxWREVvG2qhlrG0yvODDPIDxP+4zA",
-	2935: "ykdm6aSV3J3Oj14QkDz7H19bSjqftj4f7wNzKAqsyIA",
-	3514: "xilL6mGmPqGhnyfEEOWJM4s3y9Wxznk5uUwSdsabmdg",
-	1466: "RDGM3DTZ1mjxselbb/WMPMan5qx+6YAo6GKcdKOvg/U",
-	353:  "VkHcnQe9ioCertIhIvaRQxCZeHqV0ySExl9gHgbZ90w",
-	286:  "5j0cvoc/uGhqbH7ATTuXv6ee1OZ0YgqZyzJ2Jh0cNEw",
-	483:  "NSYe6tpwjGFbb69d1P39GobSwM8okZoUmK3aLihDoks",
-	108:  "f97fFCOK1q94neD+mR/tcnM2Gd2ALVdIdk6Q/I5ZwrM",
-	3092: "qhbcee5BOYNG54UvNfnWkzp1Mcj0oPQ0t6di4ZOMwuo",
-	3886: "oM9YMr13XOvgcBG3M2sKneA6xUCkymgKe+LHsyO7Fao",
-	3542: "kDCjRFYBCwgYhS+O3CGO3XMEIo7nFgp/6KGBgrhqHEc",
-	3510: "zffqTc93/kfryT4nwuPq7f8Z/UVBB/aceBeqWCBCpaw",
-	2815: "BWxahSLDFrl8jSf/+2QqTx83eNWX/jG3+eq0HdVx64E",
-	2072: "ZwnJx+m1VHjRXhhs69rFm+l8PF3q9wet7dd/lT5UB2c",
-	3079: "uXceTgzADsy9mEnOwyfjmtWMJ0cUTHRxblTSpbCd+BI",
-	482:  "aiyPJmAVJFvrt8GTM4ZX0Miy0lxMRVeF265bgfwlZoE",
-	2861: "86xqAQiik1hgS1CjdgOSQ+NdilsEXdSUz6NQPuwTHQg",
-	3364: "Sp8GoFx+10hgUTNH3oIvCQ/pN4Don5GbzJfollhpAd8",
-	1338: "ywZHfzdEZrVU3NLUVnZznYzmE0pooK+tVC1Cp8htqlM",
-	1455: "BI+e4tVKLq16qLfOFd0EWI4TwdWGa/0pfw5zNr4WprA",
-	26:   "75FVV3hlHprqtw3MgioIM5Z3j3qV3mkxRFpbjIqBtck",
-	216:  "v98SgXDZJUj/iPH0AwRBs99UB4iS7Sbij5Cz0lfw6m4",
-	1232: "fpDYH2n7yO+kIcNWqxZrxw1/HxbRIXly3GzR/zszitA",
-	3202: "YTlPAKMZ4wc4/uKW2sl6LHPx2J9hiTCi9W45/QOIyJY",
-	3268: "ZGqgaMZR+dfUmZgmbosIEnH4FkvVsz3C/tl9B3ya0II",
-	757:  "/kJGhrFpc/RE3F9RhvBI6xV2jWEYqtyR3yIr0ufFIzc",
-	701:  "i33EIamCgcfoxDiaTTXMBiTUyRDK+OHIy9pJATbosag",
-	665:  "YDQbtC0KpGaXtpI2foaz9SD5XmOceGIXj9vbaa/def4",
-	129:  "e+zroPt0pTmuRODqt5x3r8GKzfJZ/6Hr3mJp737jnxA",
-	602:  "7kJNrVd2m9yhZ+s94AOFR2udsuKcNdMSBUTeBcUrSis",
-	2842: "irYmo2xXl7XKtiXSAW7UrMXfdf9XA2NteF3CzZe2ufw",
-	2796: "KcKMTEPS01VsCOI0km5CFB/LCuU6kRfXXJtcYayhWGY",
-	3269: "61Im8PaNJ7rhBCuyaXQt9LY0hmVjcjmy9qibk1fVlwk",
-	2231: "HZPMh3U9vEFLzd1Thks6LCL7euHuAjfzLfbbZEKB/ro",
-	350:  "oOmp3eINQq+6zCHFVsNpBaMSsgaFFDsnQ/KLs/cgXAU",
-	1651: "fs5OvDhZ+MDY4uvyc5N4J/Vuw9xqMvj0lawL+9mgR5c",
-	1405: "1PlXHPaLFxnAkzXGmlsRvzJxP8fWS5o7kD7SUQD8pWw",
-	2002: "wQuH3e93yUcheumYLVhQNmeiQ261ttqg9b6RUuQFzg8",
-	1675: "XfJjArRP8kwQIT8e1DFMKq20gL9NwcWELqi7CkVlm3A",
-	2799: "uRLMQ9iLg+wadtK9EPGKqDOGe+zy3Mz4SEOBxU/rCCc",
-	1799: "UY9j8VydXOQKmu6XAnT+ok/9tGEba/YiwH2QH3pFzQQ",
-	2963: "Jr2Jr+SCt/HMAqQE+ujvpATHuCSnI9h9Gvi3v8QqvOM",
-	3717: "YRmJxEkoHNsM0JZnzgPy2YBIA7Fmzp+gpfJDqyXlsAw",
-	1689: "ZpHS9vfWbCM/jHBKpzfIKOktVwy1+T34MzivOn4iK0s",
-	3852: "0HA3EuqnnmCNWEcquR1fwwKhA6eYTzDVyi35WAorqyQ",
-	1798: "JJw6EVOrXBiLzFqKqwgJrBL1pWAX2AiVjvICo/b3L8E",
-	1445: "4YpUG+UsoNLi9bdxMUEHgMgqg/bUYIT2XJqeqR6ODCc",
-	1695: "qw2bzETJRxxVBE3iZZlwa+NZflPUVl7EcVhlx4vCEtk",
-	1955: "4undYUsybcmn6ATBSeEgwxMZBGhbGyJGCB87ZMLQlYU",
-	716:  "Rzvx487bd8CUbXJktouKHPbl+LLIVU0vSyFjxAlEaNQ",
-	2811: "oEeVZu5QMH0dmfmMJ3hJTG2LFHX5lnCYjSuDOc30WNE",
-	3435: "39oZyX/H47NrnqU7vgr5uMFlY5E8ODa88mQW7XgDzN4",
-	1229: "2/kuElKEmUkWFlraqHc94XezZ86HPy7sZtnreAdavOk",
-	1859: "qKwKMaFW8vEq8Qgl18pqLMFeyItaydP1ql9UbzTBbZY",
-	31:   "zNRoeJTtvyN5rAnqNa2QFRH/hXQbvr0drNtjTLXOAd8",
-	1741: "kPbISDRtwhyANgu9s5PWsablkFa0hTfXOaWI0vnoWdU",
-	617:  "88JTtpglAKBVvHdSdaj5aISwLDT+3QX/pNzhsSJ/X4U",
-	3982: "4wRy+I/BwCHo2DSl0Lgsf/ZZevm0atQhsDN6u81Ohvs",
-	1899: "TYwX9CT5huXp6DamNHmShdAR3JvvgF/lF6ukedoCMCo",
-	2554: "H80LZSkNcxsL0QRRlFCzSiZwpKncK0PYQiF3I1pTbvs",
-	136:  "3x6/agwQYc8hsYCBd0OrK4FhQRTKkTTODkXodVhJ8lw",
-	848:  "unEYxY/PAEXoaCyt+veBk96RocpSUisTa2cYfzQYGm0",
-	2188: "yEQbhnZRpuh/AwABIgFEcgPCX6q+ktQNcP2F5S4e2nk",
-	2704: "+wb5LvuHRG2zWNUOpFoyrjamXxnzaEM2pNt35PwKPTA",
-	1590: "q9BjMxt0m0iD7V52BzP1InB16IcKMaiaDq4tDousDUE",
-	268:  "1gby1Xa2/t60QuDk68rtEpGrUZ4jqRn0Bs/Iq+02R2Q",
-	3067: "cKEKRBuRoJsydTiSpjejgkRk3Fa0igVfkN3s5QNTHrQ",
-	8:    "dYt0fBO1GH3WFthi9irRonbYL8w0YYLkbenAySvkzes",
-	310:  "moQJdyt5ubnwWb/6Mn0TilMREdwY+1H6/Plpg4s4enU",
-	2566: "zmIs3xfM89Z1M+q6e1Gj+P6TrjNpuegmPMR4irOxAmI",
-	1525: "OsCiH+e2iMumplaTgLSWgUFV7FbUDY8IVd3hqpKLb64",
-	247:  "ICMQOrPfcPw59R0W6HDexnlhcPiw0V8jnXzEr2iv1uo",
-	2020: "ggwqR2zV15Sn0MUJxbGcZmduDaDGYbtfPKNnqRwyTzE",
-	1238: "nsxHdj8b+FOby4YxrIW6un3oXqQ1+tXzd3S/DS8h0D4",
-	1888: "TDSJ9bTWzO4nunnA5eLOV8PM6YWMkQiRUFLWHS1BwK8",
-	2627: "En4ld0KHqX8p3EGiSMOvdczcLlCy6sDNDkVxsfwyH34",
-	3692: "wS9vgCZjGQ36La9WAVzBg+sDduywSKviRGWc67QOB4s",
-	1214: "KTPE63Z/b20sxkOyZsR7lJNR+cIxHh4i2pX0ZeELsFQ",
-	1081: "BSZ8DVLqqA7KPTi9TNsocktqycyVHKEk3YQNtJ79vTI",
-	2114: "sCqwXRle7mXjaQKQN3DUM0C17iBT9syLHNH03DNsg5A",
-	17:   "baL6RHAp0UAPczMJBmrFkzyZbF1vMzRTuXIn209b0xA",
-	834:  "8VOnkZsvSG5gFT8uMXy2HFU623ylxTrVAnE9Itg3jSw",
-	1736: "5Y3A5JvhEJ38QP+2Yua5SEIX7TVd+khuc2bxp5qlHrA",
-	2266: "KiK9QBdZhti9HQu6Dh7XTj3JMnY48y+ij5UFXCKbCjY",
-	862:  "u23NmVLA6/EBpaT3F1wvIDx3CElKZ33/7WW4iouy+Uk",
-	486:  "dubV71xcFlHInrXZuYBBtqvTUn8xfuG63XDqEJDwRhE",
-	3480: "Yl8gk1cbnRqserkQaRagYgHvyb4j+zEfxZC+dPFhh1U",
-	3659: "tWe1NdUl97M+Hz9ya9+Pf9y/a4rjOKxz14yi/vz0kQc",
-	3454: "sMQYzASbQ6hjmlXPpkfWnU95zI/9KwYSFP+NK9uH97U",
-	1267: "turqLpHEER+3evx/Uk+5nqTfaIOOBuYK+2BYkRytUk4",
-	2765: "Y9MI6/iRWCPkZJNTCcsQhpQeVpQi2uaDTJhyyMDuaGI",
-	1963: "6yMABT8FR2kFBAYySGaaY4qLBRp/p/2DnAj8zHJSeNM",
-	282:  "Pn/zfQVwgehFChnSoSLtGMdOM6YKYNnRMBnbCV/KRQg",
-	1481: "byAG5VplzfBRzU7toReD6IfEjTGU9LsANrpy1n8CVmw",
-	1184: "9yuwKhtsvbajl5zGZYVi7cYNqjNeVnsfNccqB78aQ+w",
-	1663: "o6kyq6rc28xYlsJBysg+SUp1ov6Z77+BJCckjxRdwsg",
-	1576: "qsMv7QEL+Xa2Nx5DvdJ43TwCVHWoxo/TVJB5sfwOQgU",
-	706:  "96vhcA720kEGze+UJ3sLS8DRwE5cmnSvPrQ190shTL0",
-	3496: "o8io0A8WSMcGucG743O/s/mTNDOMLbdJhy5bYyBVRrs",
-	1224: "+CghbL/c15ioJ4H/LxloTEyfGIM1Qs92/rbKkf/fntA",
-	1703: "rEvg1wF08eTxR8Ovf2a1WP0LyPVr8CMYef6uS73K+fA",
-	3029: "nf2sEOTsaevGhNcG55A6i86tJDhOFb5v6s1GT3weqK0",
-	1067: "/j4lll3J6mqQUQRTpX8hLpQiiaFfzjBI2TTGAb5hkeg",
-	959:  "clGx78O3wGmgkjSsnhY8ODVmyLJL4ksiqY7nNj3Rq1I",
-	416:  "ayWBVxxTc2rNSrB8l6oy3YsqEFsXow4Oo+e9kP9ejlM",
-	3042: "zyMKmgujbpvJ98wSx/dbPjy+mR85nuC+5SxlFHI6lDI",
-	1118: "zjLXVW82FFJRnKYH0X4icC6cuY1nzKVSj+/WH+xzeso",
-	1655: "JWUoNtKME0Mg45cIqYaKf8GpwwEn4hWY8tCFEYrBRCA",
-	3349: "jyKInFgT0KA9s6+rZRXi2sYh96MYp12RTtMA7Eoh7ag",
-	2560: "epOPNBK0+h+BnvScKDsvpXCwN7aMrT1Z3AphINhPk8w",
-	2970: "9RRSvQ4CW8SZsj7Dd6B6qPmFhIIAXLrdP3lFJ/+EZAg",
-	3117: "2z+wPpPUlA9fEuY8tcwiVHJD8y/8KEcZaMR/89El3P0",
-	3396: "DP+dZE0q6ov+yiy/tNZAk2oPTp6EMhjiDPffDH9dEc0",
-	1646: "4R4zGnbbsT0b10hCFormYjxKtEsMQEP6ES3OwdqvVIM",
-	3916: "GK27mtWLLuojQbkBKaSY4iWb64zAt8IA0stMNDSYIhQ",
-	1802: "kvpkcWDofrSZK6hN1deECgdJ6AOjZ/ZANYdjiAui/uU",
-	2660: "wNPmpNzio9tJqtOPAemUqGkoZ5R5WebVnZNLEdAB0r0",
-	694:  "ICbsIIIRMBSGaovHXQtOZaPe7PL5jWoy28V+0wFqQEI",
-	3135: "hj1e8fMIF2SW2ndRVzhNh4EkQWmmTZ4KX2gqD7BRUfo",
-	1498: "ObOAS6WJtw9Xh74+6AlSciqcJy4dp6yAqgq4Osd2bRw",
-	2253: "g4s0fRT2zjbr2p8pIPDsOow+CYIvK1CWV5zce+Hsba8",
-	863:  "DKx9zxpsUSCGCANftUUByn738bRXr83c41fukaIResc",
-	182:  "+6Lm6BgSAbBH7lIlHQRzAExHX2s7jFgC1BURIWN40mc",
-	1104: "y0TBXAZLqHPXahQdpQtuOuBB40ohX10G6sqqlKI67P8",
-	1002: "H07XU96cXvk9wfOsQ8ZoL4exBYY2uPes4eOLR1dIgGw",
-	3201: "xjyOJv9FfpS89sdi0Dttq+3WBbK2KFnceq5XfSsv98Y",
-	342:  "WXIYqlHEWu66vaMAXr9ii5md+RfBq9T86o/Fygsx1wc",
-	301:  "ejW09ZVr8bARAHJmjR9phEfN7zuLqf8+fgz0aZ+khdk",
-	3387: "Ve8fs7U0aDAl+WoDcyyUC9KjpnvJ+D6xhFDKoHKWgCk",
-	380:  "p78V22lrDeJIpubQtSIBfV4d1+2uMcZc2B7I4RA650s",
-	2879: "1GHHWqbY8LbTX86gWTjP9kETAWZ8r+x9PSNLcxTtKNY",
-	530:  "Vm/UsW1M53I1qRvNe5WgbyfB51feZqw2vWOAqN6iJ+w",
-	3887: "VJ/GKWd0ygZ9AkAliW3qBDvFVMlyP00sVdR23MVRDuk",
-	3448: "P6x2cZoZTD5477Rjqd8pEkJR1KKSat9Vf4CJWrjhLyk",
-	265:  "94EBXQ5H8b88hT3e1SA5IjVyDYPYhQv7sD0Jdr0X2Cs",
-	3483: "/OTUlkRmAPpe5cKSW9Ho2I3efrS7mSw3sJGhMN4N+Js",
-	1832: "Q/izzK+gVe5QI82lLtj8Akd/WCjlXpWHAWiiqg6rdxk",
-	1016: "LI2yIjTzje4utIX/stVxqUDYoi9jXMKQEFIMS5pPP4Q",
-	940:  "DDfEhrtNQMpTcIXP0J6Q+qYIpNzdIhXRGor+sHtBfM0",
-	2196: "F/d1DLlpR1EyX6J/VTp6fjknGVwKqBoV7U0qff+CW08",
-	1135: "gq16tdn20Z+kNj3/M57lhOI4PMNhlgCZWGAzesnNvYY",
-	1202: "cWOV764b8aEyw855A8sWu8lE91aAWpcby5R86v81Ids",
-	1721: "Uvl5ACAdO/68HRc4gDjPt5BsFVwM34ziEMb8IUbQQ/o",
-	1352: "tbkF7JhJ7Ng65XQnswbS3m9h8xc6nE8A/YM0q1Dl7i8",
-	3767: "xcngQ6t7IoEFvBpFHT5WGDHsdDhSStn9najoFXi5wPY",
-	1355: "W5xh5I9YE8vbB50LDH1Ezviay4fZ4n7jca0nUVUyPaE",
-	3263: "DaheCO5ySE/CqqRBK0GHJcJL0E5dUkmwc4HCb/qdUC8",
-	83:   "TJ5JSvBrLUtHb1OtYXiXXveCFE7mNCHS9XrXAuZCtbU",
-	2090: "whJYvaujIjHeWcBH28WLOnZDkHMZsf40i7eZiXV/+9Y",
-	3000: "gL1ZpgLPqBmfGUMJKQwpZa7BM/mIDRcBzuu5bxLiP+E",
-	573:  "8VDcyIqC3lH+j8rYlskCtfLgwx4ydDLa/ahH3GV9ZCk",
-	311:  "qf1Le+VmZ9MlVd9rZnHI3uCvT5METTnkIs+nhXGEJOg",
-	3529: "fnzci7/Pgs0C8fS98YYwUbF44QqG006pVT+Tko2w7fU",
-	3901: "GF25Yd32RCNR6kWyxYZQ5DJZsffkJCPit7E0Sh8QsZ4",
-	2562: "y5zhk++qZL4cvJ+emyzFZcaJYFZTaJgC7JdqN25eY0M",
-	1029: "scGxTV2FFGsS/SYfKH+h6El1/3/A7bHJ/Ga0dX+nLq0",
-	2170: "o/GpFx8F82xFgY5OciDt6fQni1QHCJJn7jjIbFr5ueA",
-	828:  "svXl6v6H3xdaIuMkuD2vl3sCgERA8Xw85TS5LrI14aY",
-	3338: "B/ZcP0sDPL3AUp7Lfrryxp/v4krpulT40D1MA9GFW/w",
-	2899: "K7oQnn7cI7Hv1FpGByOFj45rKPL0EquEG0ymAs++K10",
-	267:  "8ZLOuHdV31VRKOnYNN6EDCSIMoCQyqeMP1RhM4jUksA",
-	3544: "yXXJEA9aHO1xfgKTC4w7UuNUtE+mohlEx7ZfLqOi65I",
-	1303: "ZFlWjrqocc9TT2WO5ufcZofkIsu6geksgcW/Wifcqec",
-	2326: "jSH3X3tiYhxuiVxOpS8iuR25AU7zEj5r2ryJM7aTWmk",
-	2497: "Q3anbOsbXZxf8/coFXmlgHAkist0DguNW/VLT7TizPs",
-	508:  "diIjsrvTL/RJN8aCjn5tcifxERQKSZI6ApirnPHASow",
-	263:  "Nh4nU0HTtFpQHBOxFwUObrJVOUyoygFKB5oahXEP9B0",
-	2339: "N7jQ1hS7kEwb39apPIrLD43Ezbkn3EnPBCeeqVcS8V8",
-	718:  "esJZ2RUdIgFO6+tUVA/RQvgXguhoHZJv9Bke06vVe0A",
-	346:  "5O/o6V6PZx4g4N5KV436G+bMz/bghzG96bZr0UwyTIE",
-	3570: "X76szKeBpdtraVKCsks3L2qXdpy3qwnSmNyD7Wr6p7s",
-	3502: "b2auC1BTqX5aD2auiIeknGvReOLInNSqlUCsqNRiwhw",
-	2309: "okF4bmd+W4URUUsqyVvsenKESNfrFijceZ2xeUg8mTg",
-	1402: "WLWwFzp9xdu89uB/zwAqBN8j/eqoVFIVqGgPCCo+XnU",
-	2233: "IjC3fp17r1q1dFrKE/3GRfq0URSqsryMrPOqzxpBBSU",
-	78:   "1Pkxf5DDb90QDQeDLugP5G6eyzypGffxAXWrY9T4+0Q",
-	287:  "qZX2dd0W2q+xzI+J8zm7K0cOKgCeLLIeI5eDwayZkPY",
-	396:  "zFfgxXMvGyDL3/jWXAVC1iOk0Y2hC5Lf9tVgDsvz4iQ",
-	104:  "yfu+0NF3OUNOmKQeNAFDc95ClTIN8OZ5xQm69ef7kok",
-	1467: "igxxv4djhB/MPrHsQ3XmPjtSH2S3tLPHcukJESPvATU",
-	3626: "D9wDQWLzdGwHjASLVHXHG+a6yYLiZIBE7+s2AG1Aa9k",
-	3258: "IktAU54+r5w9yLT4RsrgSijmw8xcdoCrQqqzbeCe3Ww",
-	1227: "ug4ptBvD8uLYcxe+DtP+L1KJ+JnLtzHp4fQDu8Pffro",
-	1516: "9jqsX2xTcgfGEtV5GI0Xwc8QobZTwK6U/kgmBlQ1sN0",
-	721:  "geiyxR2moIpNT6W/XMfMLgA79k0eLP0NUFXueI7Oeck",
-	867:  "KFG6VD4nGHAvmieUhaxNLg0GYXUjKrtVxBMf72ALh+s",
-	123:  "GvL0yvTXvhz7a3P+z4EABg/HaPf8N94mvFI9q2yZr18",
-	3265: "yngl7GWiqCK2T01QczwKebp7buxj+SQqBfZP6WGUxyw",
-	1228: "by0i80ppH3NSxfx1PGRrbL0TKZ4rgnNYuvsDquFaRqo",
-	3057: "ZOvA0IDu7bkO9PBPkg/HjW6vvI6snAxQlBQ3mrPkABM",
-	1518: "3hpTGBAvo6TIRetiF4R2WxXFCLYJABIt0wvCnRMLVzk",
-	1866: "c/9miyLm+pB7FOnT+EoaAUUUEVbOH+i4FSXnFNPDnKo",
-	2707: "CcgVQZPoTUzG+TcCtQBeD5zkQ/YTOP5ygT+In4wcRbI",
-	3391: "C2XPRIEELaY4bhRODdY9mXtwT3Qot3jy6XjkByDaSVE",
-	759:  "lP/eJWVkUIvBu9lg6923sT1oHc5uCF2NZnYv6juqOcg",
-	720:  "f8YO8Lhr1VVZ6z+tUXvfqF3BKJWcPubmYCun10SQ5sM",
-	1172: "thA5Kd9drExs4bJjbXcysaeepoyd/dEnMA4ucU8jpUY",
-	3660: "QWpwmuDpq/mFoyPRI8za0srhvs+BXeVSGCh68Md2ISM",
-	3402: "wibEqOedSQ2NVxbyLw11yNYZcneYnQTb5kB4I2XUOMU",
-	1958: "ryhWCQ9Eo9/i+fZs9d0a0kJNFviheOkJIzj4ADOxQak",
-	3640: "36rtf1Vg5mAb4pB0zUOPoEJ9zmVnzVDxkudtq1HhpBY",
-	2716: "IC2qtqjRqGeJFFks9UZXm7YP/f/yymwG5MZcCYjvjeM",
-	761:  "7LtFjsqyUlT2WDKlgYd2szl9JO3Ros6XKYdda/fK0W8",
-	986:  "Ke6ucSl2aZYF75hM4XhzlViXZ5kbjZsprMIzsPeTnIE",
-	3867: "cTdQYKCFBfbXBuvC2YmlfQ6w1/u11wUfnuIUc3sJSRY",
-	2378: "uC975o0LlMr6bZCud/BoNmjZxqFGo5UyZxDumCJHNYg",
-	3997: "n2f9pVj59qCv4RIHrocAy3Cu29MROQq9FbdkqZeyfhU",
-	970:  "udzzzdbPBat8BuUKFs8MkmHRD8cN8QlhrttOSd7NoRM",
-	1163: "Jh6h46isHzwSHD5jDkBw1cslC2YIPgeqcBYZS6ASTQA",
-	3948: "gBhMSjlHYWnAE/Jw40vjOU+1PQsH2d1zd36Q0nQveXw",
-	3293: "xyjSgOffZ/nLWOsnANCVT2nD+Q/1m44kPaUxvAdPy3A",
-	958:  "2lwVlUG971KVMtjj8HIyzgmlpWrKlsOVinhR/w1515c",
-	2900: "IIUCtY6xqS8svzNTczF1CAoalYH2nxRyIkT528bbSZg",
-	2610: "iVPIAxQossPzQ+7wkJWN0PMc9vjHqjqkyhQ9Jhsy5KU",
-	3002: "2Aw0S+gaYDc9nnGgBrd8ab0dJgz41Tmdty6RJ2Wnm5E",
-	2787: "KzK0bHLM1yLvQZGqF25X7aF2mQWsgV3UjrNjclIkGkk",
-	2726: "UcEQ3+0ynMNHMH4zvG7EelDj6y9fHwfUk3WnEKSxH4g",
-	733:  "MQGL1WHVpR3ggxniL7qt77gCw10FuGrlxi7ffZOVui8",
-	3563: "8UzOXlbtKIeFtXzb0UedqFNklxMC4kkIb/4MToJRw70",
-	1709: "PbOOhWppnUG4CGkbZftwDq7LJicHf0zosxG48yJTOnw",
-	2994: "bnTqASEeQkHul0hlBM4qmHGRhavNBWaxNWNIzvmSVPw",
-	3700: "tq3hm67RMcJExSN2YFETPi5JI/SxyktGI3shxJ+vrLE",
-	3859: "gcVXrWZyZP7iQG2PP38Ho2WhCyhVGlxFvX0gvIvPzGM",
-	304:  "0hE7FViW71nvRxrYBRPk/ia8sp5a8eRB3JzImPXXD+U",
-	1566: "2BZjUvD02UpuTXbxp1IEYkqnSZMkAA0nvVP1Q5zXr7g",
-	454:  "oExjrmfZkngl7bbZTpP4m0AIlI4TBPh/x+E8/xDOWLE",
-	1776: "jPZpMQhyqwpuSvo84+fv9vHXU1gid5AO4cO0sQTIpd4",
-	1458: "d4HODn9ucYg0uVpefuC3A0dV4K4J+TGf7pSLEEGKmR0",
-	2054: "yZ+FIIyDzrt982ljJxKlM8jfx4aE/nx4ejqa4nApSfw",
-	2694: "mZhX3gHZyl4vYFmo58fiNkMPmuPEoab/KUkt58CdIX0",
-	1786: "2HtvGUS5uO8tnt6uHWE0smBZ1BFMp64WF7uKMu4dDaE",
-	1385: "L4Z97T31rXfjAO10LBlWU8Nm3/4SD83vu9+ZdY6o4R4",
-	3548: "Nt7TMvI/nYsvQ3y9f9F/q2aR9PKJ2dgdaHgmSr1RIhk",
-	1411: "IHjIjkd4jOPS5eCJNJgnM723bSk3/WdGbTfIuIwGYYY",
-	2249: "xlMUFalS+yAjfGM/nwIKGXcpONSIPWoMqjBbBwlMrZM",
-	2663: "6cSgB2jL7cHuxyd/jQQc0M0f7eHIYqBNJVLaP7CaYgc",
-	3788: "C254dCrW4wY3FlJczSKT3JU7fYxEm6Cy6R0lxC6Tatg",
-	135:  "RmQxadroYh/ip3X0DbpS+pXY0UFeQOdQH5w9sfE0MCs",
-	3620: "EpEklp0Pp8hHC/cjHjDUiNXGJvYXrdYI9Mmpl1ILEpM",
-	766:  "gdSvQN3P01aISxKyxaOlc3LnDXwhRrKpjDh/SxcxraM",
-	18:   "Clb1tYYV2W/YQ8EBJIM/OmeScb622IC4sP0q1CDRFBE",
-	655:  "Vj2BFGew+vFFD+ltTeWyCujzHtHYKKm6dSRFQ+3w2eE",
-	1945: "B4DGLrk/E6SMZscGMhePbFpyTVjJf8KyYLOeTT6Dfdg",
-	1649: "w/KiqRvZQvek+KnJQqNHl8CTAuiBHV+0YNLFDMEPK10",
-	1933: "srC/7XJTpns62KXsBkshyQXoOBTeuK4Bt1kjYp7TvyI",
-	2742: "97+zimBfDPf3ERcLHZwV7c2qSl2XjDQh4WfmVG6GZAY",
-	1317: "ilb+wCln0/GjuUNHF9MtFo5lyY0MYfjqISiC9GMpjQA",
-	2882: "F7JORfdJCOZHzh+RGNr0xtOWj1/V5SlV86xvgE0xfSU",
-	1876: "0GJMdaEekugHlCwuUazyGFAvRii8OQGCR5HXyV/CDpY",
-	3653: "mbHXbCw4qZd8SMXYOg7u1QVbN/KZoYbIhbp5EDfarQI",
-	2681: "SPypMpfRlxlaamAOYZ9KRQE3zOxyxLMs7DDQaqLkIKY",
-	3288: "G1eHainxlT61B9+94AGVMHneaN2PxAPIfBb0rdlmu1Y",
-	215:  "l8cy3L0M7m5iHfaOYHQqW4MaSsvAuGJKyZ0F8/1YAZU",
-	2833: "dACW/VPeqDRMmtesEVHpYQQukezClkiyM2GgTInNXk4",
-	3128: "DNMHnbYdnBuarsc1zemN890EAglHaxJrRx8dPff3PKw",
-	3720: "0KMd66WvysdER5dOMYD+yaW2EuyywoZOjQ04JSyynjw",
-	1977: "6x4fcm+z9fGsMge0gF4wvlOl4qwITefyKHJMoYpIx8s",
-	3651: "iCnJFeFVokdr5cIsLcVWJ3bOx7eoZVXvTKsnwm9p+Ok",
-	884:  "v4MSSGxBwkVM2pj7VLshEI12NVsAT+AujLKP8bdvKvU",
-	2154: "u4K0umdNPOtPOop7RLgibDWYMFfyezMzLUKi9tKeY1Q",
-	328:  "jM1P0Cy46aY/W+jBqXG2Bi8nMjpXa1T/pT0SL6oGZQU",
-	3826: "U3T6OswTS7WM73cRVYsyoJdTMIfr3U3SX+rgacm8Md0",
-	2304: "hDSOBYwDIfjGYgE1eImTMMQqVwt0mRcqLr8VyWToAH4",
-	1325: "1Hl410AcgFh/AdqvsIS6XjhYsV1JDd1qsbNeRUYOpiY",
-	1384: "shak3Jyd32QkK02n4D+f5o6eOARsXdhOMHelUAjXn74",
-	1550: "KDrvgeFIBLF2cx6ll9HuHoSpBvt3JPka4CU9WMHJYhI",
-	505:  "WaY9kKpRyY4iV/Otv0Ar0/klo+DcxEP7vhmp7bOqSRI",
-	727:  "IGXvhJq0n5jPntpsqEui+eP1KFHK55XrkmA3JcWaehU",
-	2986: "8KfW8GcJbILlx302SJtz+m1s1IUII2HeIuuyq979JCc",
-	1710: "UZpjJ+gIsAP+IOSvuN+5fs3widki9VwHD8BDZ+Q/ELo",
-	779:  "iu5let7yMkrey8ZygLPwqT4BwxNEjOel87B+ob4bibs",
-	13:   "8OcmzLhEnmVjBjWCnuxN7wB3X9mfcAFGH1AqiAA5qxU",
-	1496: "rFixqNifjFpZL4Iqzr1Vy/ks5a8fKOo9ESJXH2QfvrQ",
-	3996: "+HTKsU9dv8Ci31knrMa9DbEVMk5PQLc30EmKWxyxUJw",
-	1801: "LqxrANv6OwDhZYwm6mL44pwexIGew/+Cm+BPcn04CbM",
-	2372: "UcdVXIh9/oywTGtivxn6X/C66Q7fi49SWX2owjkmFRQ",
-	924:  "AkS3BacoYTwVZnUpdnGdWwR4lPn1g4cczYK/xtBpKZs",
-	214:  "GXDmrm2pW9TCZy8mt9a59gY0G/lw2p1FaMQQsVqDvCo",
-	3611: "/Xp/E47ycXDStD4GT9V2PdqzywbD/kEAS2RtVQ6r9YU",
-	1964: "74hJBXLByNBAsGiIqOV3izWjQTTc9k5SW33JLO1i2Wk",
-	3739: "/OyAOEuLoikv8YdPfNbLs+EOkN4vNo1vTtNRMotOoWw",
-	1738: "NmhMDhcc2EujPysSCo3ZCJa2PS2b/h4/ZNqwEnmAqgI",
-	3737: "o/2DZ20jLxVO4eyvGvbMNsXBkUL8TUPf7DGBEMujHng",
-	2998: "aFfJc3DNVk/9SX83UJh8gZVL44bC9jcLY2pf9KtE0sk",
-	2634: "PoN3sc9W8zjmKaZgboTlyvUwmTJV292WkGVxhSu5sr4",
-	1521: "5hJfAStv7P7kBgoupAQgL7N/8zuZ+NmVhBNiLxrbidI",
-	2843: "YJx8jO0hUeBYCFtrnakM2qhSQr6siK2+cvgTQtCkkJQ",
-	2584: "Okpfj7W8tj6phze31gpkqIS+5+F6WZzjt0T9DG9NCj8",
-	1624: "p11jFIKlRoLuAUYu0B0AG9S8kUCESN+9pogFavcAlAM",
-	2014: "tMhE1QUUCnMpm8lHRDkTrfWMD2F68rj//O0A4m840/0",
-	3124: "+OSjbyI9mff99T6I4QgJppUI0C29jBG5gGSPYwVZkpw",
-	1108: "Q8bMB4zrXiENUhCZXPD1FScv1QmHwZNPlrNc3K/K2Gw",
-	819:  "f4e8babJZF6xRRe0OIWNIQLaAWCK6zXbP0LeGsAYEAU",
-	2531: "l6etXiSEiexiSOlW3WjdcawnjDiEE+QcRAF/jzzYTeM",
-	1733: "L2QkTBYVE19gykyjtDlDgWRYGbxpAwFUCuJxEgpzQCQ",
-	832:  "sRFe7fSGJmw2qaOv3bIyegVn29fIBztFIKvfgjHbvww",
-	3160: "CAE8TYtVt3G/s4t2rhL+IB7pHEXacr1Sx85CQOE87iM",
-	1327: "WoqV4XM2KRm602iYh6jxxyDf8zvrfaJR6W9z36gVtDo",
-	2631: "jN0svc3J6GEEt//5m/rjPST0UnaUaKZFcqHBqM3ZQwg",
-	1241: "PIcrX24c3uMqXY8oaJ6F7Xyj9Zvn4a8goJBNY5WMvys",
-	2023: "tLZo4cpyDInA5xVu6Txja+k5IFA9js/2qIXLSIZTjTM",
-	3595: "6wh+nT+yKMjHnhORHvbT+N0PxHrlvTg73O2IxHMvsYc",
-	3650: "GgcVodkHchoV1UUpJJub8ACKTFNazauTY2VXsxlOnaw",
-	1150: "g329KDMBgZijcWGESzx5Y1hXmLf7EzS5xPNBe28eblI",
-	1506: "xLTDd9Xp7AZ3YBy77m4nXgSVMrCbO1zoa4vQbXKo4a4",
-	1998: "LT+vStkUztCXo0G93V27uosEkUf5nsyMH7SgufwAClo",
-	667:  "onDnagEsnMQuaW1JlHxxSVmJx/CacYF333F2fNoQvJg",
-	1745: "g+1LOB4K8kmlehy6FkwqmlsiGKcbw9duuSRXDgIXSqQ",
-	2746: "fJTfexgulRH0CDb0N5QnY2ZQOJM/AVM5H6U8GR8qwFQ",
-	1065: "oQ/Vpx4AFdqfbj0E/7wN2ZOQ81Wm/kh/81hN+eK7+AE",
-	3902: "rVaa47N4fv+v0cXmub6VwSTMEZfm05qmcVCF9IIfGXU",
-	3831: "OY2qwv/XRE2B4DdS8bMmDNiZTmiQk3zUM/gTH2pDITM",
-	1903: "D1f0MW9JiZE9coYCGbRYphCNpOmqMxlvVGJ1TgjUxiA",
-	2709: "FHT8kgTz56I4yPDcvTgtnmomLhOnD9PY790S4M3AJao",
-	3675: "Bm/n+JSYTd7tyh36Wtt/Sm8vBNw02LkKtkTmZL5/pwo",
-	2581: "cjl2roPt2/hKKf9hKzej/ccOhqqU76qO3KHGTdWaL5A",
-	3006: "/AcB+3M3xt/oZ7YF18YyXM9RF5r+pDoJG82qHpxIxjA",
-	2244: "w2+fTNG0c2A2vfDFzQxvnIoHJBm2KNcEJMqr0+fKgJc",
-	870:  "xhVvdHd7YeWaYNm5qLOLduMCiaNuConDDUJK04/o01o",
-	873:  "FjpswLZSW8SrECb/bZIv4rWaUmYO8/HIa2n5zqU4Vzo",
-	2369: "rgRBtUcSzBVu++5Ipo7vURu4syYPtT0gK34b1zMwJ7o",
-	1714: "hvRrWo4ppOnvaYWcYpnE2ny4P0Tr1+sPXR7h9Ynpfgc",
-	3022: "GmTBzKHuSuw5Huz3pifmi3Qa1dMNO284Iv0c7uqsz/M",
-	2784: "dyHtZ5OH5ubYKGZLmGkVZVJOWkFuIPAx67t2FnFT3LM",
-	1591: "smV9iH4MgGAoc26QKMy32LPrHvPwa3zZGobAaJtDYlY",
-	1439: "bzyS2ZzIdnqWHsF3S0ZcgUUTbEws5oalKBOCaZ1AESw",
-	2032: "YZ7hPo6nKx0xhoXPuKxvzz5NGeGpidnlpIMUEHdz4S4",
-	1337: "p4hPkf+PUsPg5BBtPJIg469V9hTZlC+oJ0pjZljzsdU",
-	3009: "CaqPJxMOeg4h7NzeBn/h0n04KeMQCTYDzwF0B4jetAg",
-	912:  "u3sfg8xMxCUI1Cacxyqz8xKQrOVjQcKmtkasad5Iyxg",
-	3357: "9WwX+CNRcxXBPSuZ+sJ4S51210nW68yJu8dqjaMl5Ug",
-	1931: "nDOSVCD5dLUs3Ygp69O9iuAULYrBbAI6f/GTK/1R1fY",
-	2344: "2FbnpQzPJCeMPU/9HJZKtDWblTGXpo5d+bd9NJDq7WQ",
-	1680: "NZNwN7BmK/4KQ+HTB8cjLFfrDicW7/WabrCSjgDOxjU",
-	3806: "lvXW+B836mlwrX/wyn/Qtc61i334rA7QShkXU8JsVrM",
-	2779: "2QK3+S7Gz+HXNnzj7X/mL/Bumu1FMRHBnZxeqHKvS2s",
-	1593: "z8pq3vrKdGJhtBQ7ORDgChOw1ShCmfyNDC8ZfKQ6WYc",
-	423:  "rNSEDSvq7oRZjNFWM9nJJ86FGUHhctde5gqjDVxaMeA",
-	1828: "YwNnR+kTSGXOEKV5AGhc62N3CYsfH8TLh++hH0J+6AI",
-	3947: "o07cz/hY32CfMkijBAgZZgq4Uk5E91G2AgV9NGdLn2M",
-	1119: "g+GHhnpqFjbs+uYHV3q14m4luk6mBQKg+6E9N/FUl9o",
-	3842: "IsLZI8ai07POA4jhf4dofHdO9gvIlAQTHcrjtxQ6xBs",
-	1222: "3t9MTKu5i3ieT3g5nchL5IzZtJ54HtKoJdnl9hYnA4A",
-	2432: "1MIuttbwQXZGqztcwyUR1fI4KcHi1C+jNrHFKOZXcXo",
-	134:  "Prz4OaP9vZnAhhjj7QfU1BPKCpyNsXGF1V3+B6Tv0AQ",
-	1395: "bbRky+lk+2zdbUJ6JB44gHeIqnL+uFEUBbr+0Pr5ypk",
-	81:   "pHdtcxeQgxlLgk9vKW5ZRiHTuGFRFapzOilcWbMd9pI",
-	2549: "zfPj5NqEwk0T4vIDzTkCZWw9KNh49aK4SMC5nQ6Kho0",
-	847:  "ohzES5rNN7Nlz/TEOU8/iRAdjRWSsyAT0zC2JDLI+cs",
-	1223: "ljW4JTSvm9xzOF+nQiTMFlMqah9npBz1PoqZKv/dkVc",
-	24:   "tQSAa1QRYKoiNrBvld1N/9s9D2REB+KcdETXgm8bLno",
-	1375: "DnKnmGk0lmnc+cDcL/llMymupt6czsArD0+yu4kyDFo",
-	1377: "DsXIXvF1RZXgn5U7/rzy7VD4lpM3N/MPNpmEPlWDZvY",
-	1183: "7amDh48QQdea+3L4ltwmc0ozBN1NWpAB++9seAOMHwE",
-	3399: "+2fC2p/svNLyghB5MxxLZ+IbNYQ1Z2r7AKvallM5SkA",
-	1261: "lgkib4NIv3DVsuv+GzJqFrkudbUwvFdemIuIAKLgTvc",
-	2390: "jFNBlUAxxgP4r5OCU6p/i7pSP8XP1WgDO0k5BhTEsYo",
-	1611: "YyzdgoNANzG2qA44SIsdQb3xfoQUQWbSHOXYSKe6FjU",
-	2394: "7e54ifwRKhEGTqLVPxyG8TwH3dfaxhNm4Sx1hrjYMII",
-	611:  "y5x6ZVNZgrB9GlPGMK3CpuH1DmHgYRAvAOZ4zrejWio",
-	3667: "nb0vUzGhAAQrm5TO6AKt1Z+BLgvNdIxdF7v2ik3DofE",
-	3429: "+qtqi4/9BBs6jbIyaoROT+UaJrtew00epOugUShP5mA",
-	826:  "i44IOwxfsgEAHFocuVclz9fW3w6Q3BlgzofUN/0qUAY",
-	1814: "itT/wbJx78afsR9OpRGGCrxNPKdOGH0xEDiUdkULMF0",
-	525:  "rmCjz+5NR5E1CBphovYcrrWws6/ZN+00Ua9mZncirXU",
-	2894: "JErDUjnXMPjlD6y5csxKg3kPigMzVSVEPnbJVLMuaCw",
-	2029: "622CV1q0Nao3NtlcGCqJlf8XiwbZBlM43GFseo8py8I",
-	3569: "L+082OIbDxptkiriN4gcrbfhDApAjNUdxvUQTbWhtaA",
-	517:  "vv/zdd4QyEgOYZqYY9quHuiYRSGZTrDrEkCm29mQTOI",
-	3185: "ugsvfaBVNVuzl+RvQN/BnO+LnHfjssLEutynt4bvDaw",
-	359:  "YxJgDyliNfoJMsX6ue2qvRTPWjFJfo5gG6kiZrV0FwE",
-	581:  "9vcFeysXfpOjfsDt/3Q+h1IALov5uiwD7sssgzc5IHM",
-	130:  "EsEYW8Ogdjq4NSa1SVprGcVhcCSvyW0xdyaemnBIUfc",
-	434:  "e0IxZYtKG2pkVBzWjroTW9jlMqLQnRXLudAEQhZH0x0",
-	3158: "eK9hQB6WdowSGg+qcoBuLhtAiCyAV2gb5fO7NR+X5UE",
-	2306: "EhhbIkKnYE2X8RlovTJeg4nYuE+LkDVrYFySR8aKshQ",
-	256:  "OD12XDIeDol7X/zr2CnQn4Dw2PYtZGDqyJTPtvd7f+M",
-	3436: "oqCgSR6LSDRHyg8JWDovfoSXYBrtVKm11HllEl2Q8FY",
-	2741: "sFJNLa0zNq8x8cZZTa/i7e7iHSwOMNeP+CG3wpco+ss",
-	1363: "fmLSiWh5MfTRfPi9iGEUxGu0ctrGTmy6XQi2rJMxzEQ",
-	1971: "zFQyxgBWnN0NyG5U/XZ65OfCq0D/wnh96At8CuV9PXU",
-	60:   "FkPjzJZil9vEF+geo4NeZ7zI3uixNFS1FnvZaFZDBtE",
-	1265: "hyBuIxL8FBMDvsOU7yRNjA12USF6pzWLIdLEQVyVFMo",
-	1625: "ngiohV+yOEHDjFqQ9CpCdoK8iWitncrqbkhhmLRhlKk",
-	1043: "87JEV7Fzu7OsU6gBZ9AV8y+DSTD/Zz1fwW6xqXu+Y2Y",
-	3230: "oaVocHzetacK1dog43e1AVhQpxKkb2Iekg1g0eH5x7U",
-	1320: "wRkcks9vHic5eBCJnFW9jJhpkbyjh81qeZ6+eL1etY0",
-	3122: "vPh6Ayj0r4oBFlyMHeABoFRnJeJJTfAH9+wvIrsnLJw",
-	1147: "PEKjX2k6erMgiECrNIZ9zbHV4+xwLPc46+0lM9Zigyk",
-	3120: "87x6Z6YwCXBk8xHapEnts0XnqG08bIsKoRjsFmRwcm4",
-	1639: "zHRnzEDVXPCWH6t4rMnPFRGhdzWPeXpRl7AkBbN6ZiU",
-	3073: "j1xBx65xoIULvcSPwQO5OPO1DpizWglnEOomT6P0YpI",
-	3176: "lApoxX3PFcpFo5KPr3mINMHBWwy1n9DXyBGbBuCm9Ag",
-	2139: "whefbB/Lc5KLaCxWnwHJCigc8zUDQwqpYCPxQWM3AlY",
-	2601: "bGigU1MhwqUyuLl1OMPe+DF2aOTOtK8/CHrocxNYirY",
-	2205: "/BDWIPLpXWhd/O0uvhr2Eqoh8gMf/PNBO5m21S0Q59Q",
-	1356: "LUvj3IX2/CbbmiVOpvm6TRXKiZx5Rv5BU1YWIqBj7Ik",
-	2433: "Cnaql8qaytxxkjXeqrH5Rv03nd5666E86YK5A58X9EU",
-	879:  "G9IycZ3EN4dTFFlzGQ0ZEsJRSmQlOhdYEwE9Qpsuako",
-	1989: "Ei6I6pDSVgNwv1arJ5R57u7O5iq3Fa4Su7J8R94qCZA",
-	1245: "u4tT/KhieHipAW6tIsbMQtugpQYHbBO9yq5gBpr3zpY",
-	3162: "lPwzzNHVmqNQDzI1jLTWjbI6+ARhCfHj6zbvaoV4Lqs",
-	2460: "lMnkLkxWjrSfGz7wd3d2Q7CQr9dIOJJyd0JPgURHxyU",
-	1867: "Qjxma62uVG5UNJZ6YdC4CcQFj8cPgY+JlEVvG9Z8tb8",
-	1216: "giC4iLw5DL5aK8ZsQp8f2rFf+7mNvh4D6SXkJRrrp6c",
-	1705: "lVESL6WbtqIKglDI8zSZ+yaYyAK8dC4Ocd8GkgYSG1I",
-	2710: "ift+H+B0L+DgrDTQSK1wH5lQS7b/50m4L5xz3FkH3Qg",
-	3228: "7xW9o28Pnx7bEm/aTAH3tots55B4wEbuz1hMJacDwBU",
-	3618: "UTM+hEGVBfPt8/uw8p+Dx9lssaafL894YRwYBcGusRU",
-	2381: "ohvDUDVT71AM9oxitEmfHAvP4k0Rh+xsSD5BaHyzFRQ",
-	669:  "Rb9mTfNvJFkjtsu1RckFaH/vpEULJPXyT1XGR1rqap0",
-	587:  "rY30/jtHqQZVtTTPDJ23tztTVQPt6/F91rwB5QnwI8Q",
-	3924: "SHbXyCqhnJdMLFxYoXmJi/v7fA6d2jqdZpQnh1AxSHM",
-	2201: "OgcxxkNl/pU3liQ/ZchP0OFTqgRer2wBCleyP8EpLd8",
-	3149: "ja1fdnwrsC7OTi8b/gnqw6kTM47VInXZNBg1gbYfGa4",
-	1540: "SPkRk3oWgVTEfimOKF/dx013FGIOaPli6Ou8kMXTc4Y",
-	3648: "HatlaUHRJLaEMyHbSvTubvZYtfsElFBIjRweDtNGh0Q",
-	524:  "6RJ3jpssIQkvb1+kOhIvTxAHR29svJUQbNkzJivksC4",
-	3321: "vknWu3smrMKUKMDCqomx5TKT93oEwItr63vwHRAvmSM",
-	1019: "jd4njyijRPEB/FSdNCjx4T20EqgUyRvzM8vQiS/7rJ0",
-	1084: "Np0pfj6rh7bCuEFieXVKdP5GyRxqX3mTfrNkf/oZHMY",
-	225:  "y689BeyAfZM+Hv7FBIYUEwlzS8AcA2hofeRSzHwdrS8",
-	2144: "mRFsuJDBQGArtPrbhkKek9gu+Qb1TzWn3NtDYI/PkGs",
-	410:  "BzMU87MD5k8QNFS0yVU55BB+f22+KUkQD3UmVLbA9Ug",
-	1072: "TnAwGf2DnW1tSF919VCU9k8mXBk4LJkeMGfpqOOyp0w",
-	2844: "syHUK38hPcb5VnQjiVe4I9Z35sLw6VPF8W7wu/8+Ars",
-	2678: "vSh/65dc0jjPb0Ie/GS387n88BDkvEQtKwG7Pm/d9Wo",
-	3290: "Ovj1dJCP07PQ7dQBFCljaXdRSHbJafbli9LlWRs/Nwc",
-	1397: "YAw2Yb24LnVvUHnLY0TPIpcenPLn3hXXpjSPOoo1Df4",
-	3358: "N8SF+ZkcTRPPXEZJ3yv5qFH4mxd1o3Y4ZpUnIUJUtzg",
-	1275: "XC36g/HNC4U+S1irEuH3H3MmxOmksgKJsmkhGSXUK2A",
-	1916: "0YQifRgq7cYdgIjg7VBjAcUfIwBdYQxzREG1WJ8png0",
-	2285: "xN5pHaKoszKGguVFwmj1qVK/S8wRz8frdnzQnxJBi5E",
-	3827: "O7lLQ3/+nYzh8VH0jKYgY9iCibmLXZvF8S+YZuNNyrM",
-	3430: "L7jPze5RGqeCCHLuRuUPJ14S6TXqyM8dOQ/DpVzi+wA",
-	660:  "OvG4/OHvndcFvXManfwCRJ1osbfYPVdd0UVW/ep65RE",
-	2477: "gkN3Ud9iMBlOentzkbe+Z5Z0ocdC7GHIfzoXin6PZw4",
-	2827: "pSZQudaNsyvDqdMwuYp2A6M97+QRmECGus46c1aS1g0",
-	234:  "xtqs3WpuLMwqSGgL0iAtiMghEEE+OiqW2Dap+Uw4kbA",
-	3530: "YXre8lXt6wl8E6e4dSd5+LWxMQJgr2GogItW7oWMiEU",
-	1161: "/L4Y+pJJ6KgEzaUCFKZQYApYDQiNpNY5i3A7rjsaBmk",
-	3241: "44FYRF4sZXRbbybko9mXPe0q+7jTLPYSKfIwoIHXMMw",
-	2874: "6+oVySid6rUmc0AaUPoX+oMgPxw0U2oB8N2koFAy6wY",
-	38:   "vq3rruhnZZZxy+TWdW6fmqSm50Dh4XfV9i/9QjhwMk0",
-	2859: "shu8nYGbeWFhveax4G5stAndfl1sI3tu7jj0DsCu5uk",
-	201:  "t0mGKHsTP/8tfxxkS2qXiiiElVMawS+OmbfPbefOc14",
-	551:  "0YQr+ZtKyHttG6tz6qlQ6wfW2KxCsLFC3gWBRFfMEqo",
-	2418: "OOmS6A1IXNWzHXBJ6s78RQPn2nAdrT1D9zePc3bi5kQ",
-	957:  "jcBvVYeG2+9I88VSf8cgX4tUs3WjuCn5fDbuAgiY31M",
-	1342: "UP3vvq3IgDK6R6BmEmIQEDVF1jdBgetelySlQtQVk8k",
-	103:  "StFTtTvkwEHlEJpaBhzZx9dS+DWwN3bKsVwlebeZbZ4",
-	3682: "vca5nnmh13+Hd++WjczZH1ZnlFCRkMYoG629iI+mSA8",
-	3897: "CXASkQlA0nQNPOVH7v7iYMeea9IqIMblIJnER996qbc",
-	1752: "g3w7z8djaaQV2dTVLugMmx+BP+qZyLJkxzDw8nYM2H8",
-	1350: "QREqC+92P+TumlIP76djvdH+PgbWvFTyOMXtkgMP6S0",
-	2580: "NpeDer77JOnY/3Yv8a+Gn4dW3n6soppxgD9bRWSOk5k",
-	2735: "Z9D8baCMw9TzzRySk++a/Am9o6Qzc3KIRKEguyZRkK4",
-	79:   "DGeXiP1FJzkHGIvm9ZMwqx5WnkKsTIhXYFuDm73avbs",
-	3267: "1t9F2jAglQHxXOb/WKQj10i9bp9Lxfe5WE+qbi7BpJI",
-	833:  "uK3LGcN9xLjSLWhlvOWovi72u3IFUiq/KfyeG9wn9gA",
-	3631: "yuhmIUzlyTlSCHcMeKUtzowHGzC08pFeSTz9X0PfmGc",
-	3911: "OdsArZB1bW9jt07AkGnry9u8KO/dltNThge8IaHICBw",
-	1515: "0y21ICn/NCvQK49OKd0SS8+7CVNkIecCUfvQ721cR0w",
-	1271: "sIa0DUdeABLrowr6Ey/kzgnoYXtTp+l/BMWLwA1Joaw",
-	1281: "BQjbjDLO+GjJEOPo5EO2kjvKPplnDiDMbjkXa6NjZkM",
-	3906: "zAf3dYcPtPC2FM2Qsrqb/Ck3Ctfwfc9l08IP2xXSfLQ",
-	3771: "gl/+zjN0uEtsJNcyEKldnqQU07/IXgH6rf1SI+HQXWE",
-	2598: "VDsqovMkn68MDu7ZL1qCZJWfdZftBAuqHPWG8D1m1C0",
-	549:  "hZWSoQXtSnalZkgJAQHbKEKv2WTuYbt5NAaKjI3B6qU",
-	132:  "bXrAWST70itiUypuhpSToSjnk6Pedq1T6nWjyhCkCds",
-	1875: "C8/O/hz9smCgyJJ3paLQGe5Ry9mPFXkQcgTif3jm13I",
-	394:  "PtY7BNQVU/0filvrJOGoqmiAyZtvxftBFtTBg93p0fc",
-	1373: "6IhJjdV/Or9ChexeUedkLhc5T+I2sz0Kids7tqR6HVQ",
-	823:  "xgLd+yWdTR4bUJ8OTcrY5PFe2wYonv+8TCMdZ3rKXn8",
-	3163: "voQKxOJC87J3lId1Ht5BgjAl5asr4tkr2pteijScPD4",
-	2699: "FbMm6uZIx0VwI7zQjILtxPSONeHMtOspkHWocrt3zdw",
-	2174: "gr2JkqyMd9FUGFmzi1GIBGC6khNc5gt0Y5MQvl7PCq0",
-	815:  "e/gV1ossmebdhQUK1JbAEe7D2J+L+88qvoZQPmEqdug",
-	1269: "9uEZVUXOS7V6KTrf9OpaHb6GVMieyDsDtHGhwayGy5I",
-	162:  "lyu5jZhnuny813XWVJLFsojsdiDPi/X7U0uKpTck1sI",
-	1984: "m3UunZHKutyow1vH9srruX1xSu9NV6QB3vS/brTEO/8",
-	922:  "r4WmCxKZyC/i0sQUwZSx37Z4XkEvCcCsiuUevZVZ6Rw",
-	2361: "2BKf98IoVAvIacKWek2JXsSU4vfWN5LXHYBLeq8VKWU",
-	2785: "0PIHHV2ETznJEdbv1NnbSMeqQ6U92P8/xlnibmBzUZk",
-	2927: "YtsdOMhMcFb34lhjRqJlyFl81F+ZXomPszcYcvg9rGY",
-	542:  "GC1tBOz8sSVsYl9dEhUdRHjIUKrD70I8AYwi1CanqQ8",
-	905:  "C7iImAj5TFc0J4hxQgSdYhThA4PhfM/RseUSzzC9xAk",
-	2017: "ND+SzncgUAq/dpmfqwZQOAscgEWNvs9GXOWAQ+9X6aQ",
-	1699: "0h4yGQaqi8l+7e6jTXOILKhILvxOD3e7myqNk99HLAw",
-	2705: "UpkswHN5HggHknP++Vef/eMGwdkr8l24/fvS96zOiBU",
-	2301: "Vs44foFdWgHH+hPd69BW1qTG567mHCFG+uQKKzNRyjo",
-	2570: "ECKouVlgTih/zT37O95yBotHzaf4kdv/cvDfZDiedaA",
-	2637: "mb+0fqspJjYvtnq6LwWz9wnPdpBI+OJwMtj4H3PGY84",
-	3822: "pwhLEXOLpgnksktWtcAqHJL8eLKNfTUPE8bJ5JsV2eY",
-	72:   "/wPZmC9JO2+osVjIDopCjODLB1mERvaIyQu1M7yP1rg",
-	161:  "mCRiaKZx31371dVb5XDGhxW9jDs5+G/gOfI123fPnuw",
-	1336: "6v0etNrRFgcfwEsjv1I8zq7J15taUemkha2HeLNQv2M",
-	3628: "PLxVqGBcZwOvQ994N3Vb+tcyT7c263NxuKqPqC92GYs",
-	2913: "tl7n135rBEB9VCgn4AZ/xYyWNoljmN3LQbYzeBrx0hs",
-	2391: "g2BkuwGhJ59MRhCsAyGJPso0pUPAya9YidrISgHF/vg",
-	1983: "Hd+Yi1q2UuF7NMcBHffQ1xOTbT6K6YIP21Xdos1r0q0",
-	962:  "swP8R6QjkYIOzrts0soKCVeomuM+Y36rBkPnaHI6FOM",
-	1234: "1u1vTGnRhXIoHRuQ5GmZEN7AU4WRX+Ig5Ld+S/UGVnQ",
-	2423: "15gK2C8tTTKwhiD4tfM5M7+4FeMJKkdoLzdFl/uS5Kg",
-	305:  "eNw6ViBkPM3LNwDbR1dHk7IZZNBcuL0juxATZafHmFU",
-	1555: "AqbOm2oPnQyBtAfMw7Exzp4+XbdWY5ZvmHZDjStELWs",
-	1307: "7Dfixg2gWB58anddcWoodrfxKVfWXMvOnqmqxI/TDlM",
-	2514: "0izbjSrCJ9JK+7ArxXdt2TpsM5VIDslq2NxO0Skex8g",
-	1549: "z/cejbAsSYagrQCo4ShpLuk0j58Jd2CwJmXZKgExh34",
-	507:  "/DfwN0owmEFcOSbhDd6eXzjkVt99pMWku4CzAfCkSLE",
-	1661: "a4tSof+D/GBbRExaAj0JNH+Oad4UgvTf/7VNawqsbnI",
-	1230: "vT3xix9yK1qUj5eIvHy9bF5SoFZnvPRuLd7x60LEW+U",
-	1409: "vsOTv9uAoujw6D6KC0a1TIPQE0yvWwI242qgUD3YgnY",
-	680:  "cL8FWLVhatudsckOv27Wxp0Q2Fn6htGKs3wyu0MTO6Q",
-	3761: "WgI9Eu5NoitTk95F4VqK5MLem6fVfwlMV29EDMpLVQk",
-	2156: "jsAQywf3nmbvnYaok4GH65N0ooZyAKtVOBjfZbrhvfo",
-	1583: "tm1w/HesOs9AnhdGpSKCmLUaiyxXxGbBbILRYtszixQ",
-	1034: "4/SbqZNXCzZATFOH1Rlwo4g+KS93X6q7XRrDGwvVw+0",
-	3386: "lZZsxL32T7eLBFgfjazqZOCZ0PwZfT78g6B9+ZRYRjU",
-	3833: "53am+zML8qZeg7UGjfpeTRVYCCrKJSyJMt2fGhIt+hA",
-	2360: "xV6GK6LT82WMYtEe2RGRq1qyw4r1lM8+PsGEBWSJebQ",
-	1868: "fxUCDRwMxIJH1ld+VER8I4P3kBW3uULKSvWrDfhpA68",
-	3292: "BibDWfyneDBXPds95eL0NWhCxiuIakzsAi02HP0Gtkc",
-	1505: "mgzgi7wk5tIzrLqudRNMZ8GR4fL6V6Iu4iAUJIE2khs",
-	3214: "QRDkpgj2iG5dp17I4dY0zaMIgRTxSzgnwk5iBEm1uO8",
-	2363: "+GPzn0T41rtPot9FIVL8DUO5aq/THJczHajFuZILqtA",
-	595:  "rR96PGRlR8Zeql3LsBxjqQ4A28oi10YKicCPIoUxF7Q",
-	149:  "Jp8qkXzeCSyYL9IOstXo5GTB2W6l5Po3fGq7BJd7R5g",
-	1033: "8F4CbAGlF9ne8Z8j0G/Qt3efxwUq9oQwcI2iexrGm9Q",
-	176:  "kZX8S0N5SBNTdZMXyLF6kZetfrAZbbHawC7KRCHabLE",
-	2792: "STkdggR8hQE4LQuT4CdLClXGr8pK7Pw4cG/sC2r53pM",
-	1024: "CqIyddEbBzwaAgJh0NCikIiN7ea+IbHMAvEQIw38+YM",
-	575:  "kI4qfz1Pacl/nVAJNa4zBOsl+a6Rvsw3mpLyJVv8ZR4",
-	139:  "JGq7gBxvIyMdqvss3gBbOoMG5bx21T1KVdQCDMUesew",
-	1708: "4Plf/EjqgjfvMc598Fb35qONz50v+XKp99hbVrYZ1j0",
-	1545: "cbBfBAuDC7yK7Pe/wPAiX36DvIAOQtOqVg4Z57ex/G8",
-	2506: "+xxocXB2kpTG2oudLltePCY0vqoueHdI1hOtUNSf4As",
-	926:  "TXNikQCX+4N6FZMG/RfU3SEBov37XgEnI97I/PqYkFk",
-	2105: "99CpGnZaHvSsj5Iwk3cnG5Fc2Q8EzjFU/cZtJg2s8ZI",
-	492:  "t2IrZRGaSpIi9IdHw2VLs8PoPHiyW64+nsbQ9FK6R8k",
-	2702: "iJlFEo43OVqoW90U1FIe72WmzZUmPcIWrDcNyAonHPY",
-	3922: "Iwe6UcCohF5RRz2QTJriB9K6B1G26mlbTUD6JOnp+o8",
-	2110: "Y2uIkY+KqGuWeVMnRl1cG21JthnOS6DaDzmpGsu+CQo",
-	1011: "pfHvyNKDvRDwQsBT9rCsxv2te8VTiOTh+J584cm8lRQ",
-	2365: "GZvpIP5ScKmx3sGG+XvbS8mKdy7oAmuG3ETmU2e2Z10",
-	633:  "yyJBRUzurU46/VS7KYm9VE1sp3jOVADnkRS0K1Pq/tk",
-	2791: "uSxXXWcJUtX/prGdvJSBCGxnqKiwbsHzUn70ygPo85k",
-	1884: "7rxMsI6BkOVVWxtEAX9xX+yL1Uoukm7faG5gNwMB2Q8",
-	1207: "myiXRD3TKIE0zWeqW0ErJiJ3uePmjahYOXmDDhwXum0",
-	1554: "vomumWtdqj0GsH5Trf3rUxKkdXq18DPPamS+ifRCQrA",
-	3333: "seG0jjA3AKSWsPq9r+mdy9W3dcDrjL6+zlYu8zvBQuQ",
-	1911: "gfZEXvONFwmbKC9Gd7O4yfOPd57MTb9lF3gm7xbm5m4",
-	2130: "08eSO5iOId9wKfK5l86Z/DvKxsPQiiYSQxvi40js/IM",
-	412:  "uVY1UcCByvX8eYWHfc26Sh+4DTkN2pMe9jUvM3KHRuI",
-	2814: "UrqRY4gj7emXc8Oe6HzksKG4xszeSKhMn5C5CaomhQw",
-	3951: "dKLdmxqjQmxnUZ8TK6jDc+3AncB0FU/tLyIfhlypxME",
-	3726: "GfUvDRjY62ycRPQhUber23UoAUok3OjGPI1IGHnnktI",
-	3517: "Q1gquk5Yi3LWHZT+8eGItEg05sRLkAaXZY98IssIqBs",
-	831:  "DquRLLqmvWSeT/lawHkVkqdoVqN4hLjmzAQynsBcVzU",
-	3694: "OmEPN3hqVwmjfoAgAcQG6mXlj17KeZo62S1TTvQr8fw",
-	1533: "bhxSyzrwbS5JH2iDyww1QmjEFPs50AdZQ5ruEo8z2+U",
-	23:   "/zxvnGQ7E87cAShr15elrXM6lTthKriSs0nfb9pWk4M",
-	3093: "CGjLpJ3M5a6mLef03oWDF36h4YKO5IFyNOEVT6rHsXU",
-	2932: "61qAZiYSSlgGkr4pbC5qz+Uyv06C0mrbrHVXv39VPo8",
-	3619: "EUv/olLn+iEQcBoDZMnXO+RPD9kZCf5fUzcKz8JxuHw",
-	2229: "7V3k0dDZLE7ZVZh82WQ9hEAvvPRnI1O9PJ6MmC7puNE",
-	1806: "GA6STo5nXg1Kx+k7+Y+gyUVwUgd3RYubr7ydCD4PZrY",
-	961:  "6V0Sl3lRaRm+hWuRbR2rZyFSUpj6mK5IxSndQ5xm7+Y",
-	666:  "I6K5Zm9oyQE+r4LwlOr5rKAj0V09oHFDwVAHiUe13fw",
-	580:  "5tEmt8Xpi2Ae66LNFvBWj+VC2mIgs9j31vnFGaieYpw",
-	2024: "m8/qG6YsmDH6LPeLQgJ1pxgcQGOmMqlXcs+sos86P2s",
-	2605: "bUAHagC2Y2b+vhfDU+OOG511GjBrkf3PRrW+0PtYpv8",
-	1864: "YSVHgmYL5cI4pVrDwT61waQ6xtRez9hamWr4YdUhFHI",
-	2064: "1MC6tzVIRLq5cz9G+KW+kHiYvWaKd002G4QAcYRoz3Y",
-	437:  "xLPcPAktd5oGqDGGSQemz3oZ2KtnRLPrj/wZhBC/6RU",
-	2404: "JrcopW7TiDfqt50C7AJ03C+7MJ/RhK8YTQc5xHFelqQ",
-	3670: "CLJm/BjhYpNGESZ7qnc8Nw4ZEcR9eb/v8xHMNwy//sw",
-	743:  "T9E1FJDjVwJPARdBVfolgUmkdZZt1e0WVP3DlmS9Shc",
-	3489: "9Eb1/5dfKoo3mM+NTJRVYp9RO2HrMi0SnoZwh2H8hmQ",
-	272:  "7AjrvdZMLGAyn0PRigMy9cgNbGZZtleM0EAXfkVDJAQ",
-	2925: "rUI4tYDx2DaPPFs9VGMibX/+JLvAVqX7mK5PBG8uGWo",
-	3105: "Zjfyl5WPlAquAEMBQQjygGjX45Vs84V5mHXr0Unm8x4",
-	467:  "LjK9FYay/vHMcqV6loJeC/UnGzvc1erdZDuaJfw/f0U",
-	3403: "lftn2wz9khZO70vwXvKfKrhYdUpADZVec005FgfWOT8",
-	211:  "QHRigUEdW/0MPMNWT8VEPDxm9UkW7RKJYr9u7KXNcD0",
-	2220: "0By+oQDOekTVk60x4VsVsNloDjVgjbGLrMgQvSoDjL0",
-	1133: "9CgecCRIS55cnvNffDAmaBSm2fEYsJXAuEtzNKMHW4Q",
-	1132: "nN7O3+FBLGg9h9q0pjjDxnb1O/bu3Zo+5yfIILwlaC4",
-	180:  "jTS98/QbOGymJhllL3g4caXGV27fixy3lnBkSCjSjSU",
-	2426: "3lqxKjc/V26pTBr1RWGeOWri8AzRNIe2YZ6UbNSYhIk",
-	756:  "m5/8uRPpZpshmvhBqbKIzBFvUZIbJBr9uOnfqItGGJM",
-	3251: "BSYD05vGEUAiFkaFhoU/DgC45u4lOhdi80J04RFL80M",
-	2749: "8d2n2WvU+RTSuiabrCFEKpJoaz+2XBBqcAZkcGoOMuo",
-	949:  "irnI64mTTj9G1VVPdbwz5f2soR3vQdS8z3J3L+dar0I",
-	2615: "PBN1wFeK5p9AClLkIHRLMarjR6uyTywRcWlBtuDqGyU",
-	2046: "+s0AqPNYnkqmkRgu2nzNGmIwFTXaU+NeimFAqd1OMi0",
-	1770: "hWU1Ai9CItLTG/eaPf8D+Dv3pja8RIb1tiKc4VFwmfY",
-	1347: "OjQXrnRVDZpf7h0WAwegPFV14EzFSFsnAwsxf5llZjA",
-	2223: "DiFLl3ogTMpDcF2rHYuRBtiDL3pTdNPdQM/FRrhpgWE",
-	728:  "TBK6/WjNVvfxDtZ0TOTq3A6KT6eRfo3shdYUT0TduVs",
-	681:  "2S8DBboaMMnodkCd+wwqcI6UgEzVsf1y2Mfgv27S6hY",
-	1982: "JeBJ05ddAo8HAHSs+F023uIXCJry5vxkZFt48jWIiP8",
-	3855: "yYYOHJ0hvPRg1WIynzUf7OTDdREKGjnTQH77CMOD/H8",
-	3503: "h3OnzBBPTnBxVqqZjxXw9u9fz30TNyU0WezbgDBGXmw",
-	1759: "sr0ZaDrT2P2RC9LVkxgffjqKJTZk4+Eh+cU0z/lcwro",
-	2677: "yAznKx9GjY8HOaw9B+BtXcSOzLIu17BFqblXGWT9Kug",
-	765:  "ch5iMn4z5n6KrhV3x6gb6Mjdr8K0PmjCWb2RqD51jdk",
-	913:  "ezFP/AJzOfjXCoJZm/OMc60STesoVjOMeoFTMehGwgs",
-	3939: "3eQjiwiigM64CadSiLFnW2Fjt1v99MFI1Ra4HbOT0WQ",
-	2031: "AFu+dowDWmVQ39tp4khVu4PK9xdnKEgSSzSunR6M0AQ",
-	118:  "DWFa6WbnrZRujV8BACRzQKGbEHZWvi5CLdyNMbi1I00",
-	468:  "kpAcq6OW1hzpUSd7LPsYeEYnPWHO4K1kQ/N0LCDC0UI",
-	3090: "zTPe+mZioIrXY6XxNMiShw7OwlxJysOMbNfZHXwxrZE",
-	2977: "YfRsZuUynlKokcwyyFkApO9H/ywAk/ySM0YLTSM1fo0",
-	429:  "x4TYpCYJ236nmKm4DcYBbcQ/K2AcA61TAq45Gckmlsc",
-	615:  "LJ0bzaS8k1gYPNx7OR0POA6hoQ1Z91q1K6zjWsKPOMs",
-	951:  "J3nF6AaTeypGDHE0kyKqsi9Y2auRe8kogA6SPCJqd1g",
-	466:  "r3F5rnmFJ9IGZhSDXDvmqiNY7qVWVfrGBztW0fdO6WM",
-	2884: "qtZ5dcmoYLgU7CUhg6Ux5lWc3BtZr62jqCs2abrVHkI",
-	2319: "5eryyR92NfS77j2sv6vY7lhqKiZNT8/8p+0AyVChWOE",
-	2592: "Xwk9OJK3L+gL/ZTQZl9ktlE3CfPK92MEAV6nllT7hbw",
-	1959: "5RqQrA5r1BmoxGFfWT2lnt0P/lfKKPSn/FHS0yonwjo",
-	2116: "x3R7fdziNfP72lJLfu4SYUR12kmGRK8o0eExRfn2qxY",
-	1155: "HFS+CcR04PigZuFfC5Gd8UKA6KUJcF7Ul3vuYp5OUfI",
-	3946: "QpBxD/fNReFGDkDBuyAMZHKHNqCnHuIBpWKqIBS7kGk",
-	2847: "dPb7MpfPeVOy7fczLbLnfJBDouOxzKEx2MtECIpA6/A",
-	371:  "Rc3DPP2m0UpjPktYHUS3nB5wq9pLaZe46grWO3TXwLo",
-	3049: "nzO9ZjlkaVVBh35CxUfbMns/hI7plvQqlu6SgXnxg6c",
-	876:  "SS0SgagR44AsD6qc2uIOe+mrWom94wsE2H+u9o5jasg",
-	2329: "GV/LBkHvyg/2el49fu90QADQOm79qy1ReOAstmKD3jc",
-	3796: "2BuoUMmmlbR1ppf8NeVE/BXppOKQ0OdXwtiDKtqQGu0",
-	2028: "jIRPxSlMrDZyzTpPTRQu5ow+FKWesK3bvDsoKVUdgGc",
-	415:  "NO8QHhI+MG/iFL+31RSXa+ngTVxqnQ6KqdPP3shBKic",
-	1009: "2ftSB80a08C+e51X7y4i8k9Bte8u9ampjbsEMMgcKwQ",
-	969:  "nspxygDYdUi9pESzo4UV4PAKBg/CVmucJ+7ilI/ZUoY",
-	2127: "oIlbJAjJxkAHWxVZS+F4cxvQic8uV+Zs0x5gZi86pRQ",
-	1937: "sCd/M7WwHJnLx5ade0Y88I7seRiEWBlXrqyOA45y/GA",
-	2543: "o2y2/5xqmbmjo8S+b3JTi/9b1vlgUMyUCmH5SdTqJ+k",
-	2920: "KD50mFmXFzwbE77nDtrFwmlVkgcmpf9T1uN3pJL56GI",
-	290:  "WAEZcjWttAXlm2IEkeE5VbH/sqTrHeclPUvJAGnnOY0",
-	664:  "A07q8WakRaCZR7lOQ4o/SN2TB0YhuMIvlYH8SuTApTE",
-	3597: "odzhL+HRyk5V5AcoXpNNAdlaG557MNpsJUWi0Ir2Los",
-	2425: "iGqaqu0wSa+if5T4Z8LozEt+SSU6TPL1VX/e9jj34w8",
-	3492: "G7Trw5U5sBjegmfzys1THCcBTT5zX0rxImhOxcPOQ4E",
-	7:    "lA0bKkIK+/LhhmkqVHMTZoeKZJLjlwhzE0o5mqq7FRg",
-	1025: "dLg0hRSm1ENq/9u2T9+6X8KVpzS/4N9as3wYlM03mt0",
-	3928: "0+Ut1tE0d7LVczLA9HdFJaYTMI7I0yrtZFPK4VVEC9Q",
-	1105: "RUFNwb89uWJargB+nify1fBxZp2Gm8nA0DW8giHPuiI",
-	3748: "LbGhbDxunXiCSQkHd82fvRw74w3GgHbp5Jyo2GJYets",
-	2877: "94PBtyVI5xBEEZt4m4WfCNL3v/40DS1h+UZ5kzdo2QA",
-	1817: "7dowipyUPvWh27+ZnVygCXwPRZArm9WTWKs1ugLmVp0",
-	2439: "w7CEZbfysFnRmdmRK74isY6LdvDm4e7E9YTIcNMj11c",
-	99:   "DIE9udMP/ZMnIiNcG6I54YBbsobe+jdH8PzWVMj+uZA",
-	1076: "hkZzRrsI5wbYgjSp80ZWnHG4b8VeZdq3BFq7Hol+3tk",
-	493:  "AB0PHTUl2Ub3qNkUB/Wx1DCL0Nzw3rj3qU30emvZqg4",
-	1215: "lQjlO5JiXVuohzo9IKMRIqDpCgGCCm5O9s2tfA5G1to",
-	2613: "ISpQImsLg4rfhPqdKPIHKT9wq4yCkyNfZh75XexK+Ic",
-	1845: "MB/fWXvrF1jAkLY0+/tRy8XqWCRh9KlwSMNX4kng9lA",
-	1308: "dsz23O5kIcPh9STKSClqHmmo25x5kRMOaTV0KPiIbz8",
-	3641: "3D6GS89X6YqIf3Qan09NEpnHcMA5TGQYA7ULfOkqy/k",
-	2268: "CcRcPfQhkQXLn1vuI2L/M+iRtLXOmeupnKfincObK7I",
-	1345: "Qw8U8HtvPHL7LS7GhG1Tcdxw9i7/YKonXAo5eS4xwPU",
-	3075: "uOaW75k4Kk17N48MW73jDkGyfD7oaEDXIdlj4y8NXy0",
-	584:  "vipmjEeCa8NUtsufaz260z8EGshCWtEJawK4WHHt1+4",
-	3309: "pkZaOQbj5qgSctLSj4Dy05FM5s924YfHkPO2Ns0zvt8",
-	3368: "kPSmarzKiO/AioYh6+oLiA5EQbQB6zrajU/WrdIjMeU",
-	2690: "O3MbxKy+hQmBRj9uE0H4nkz0RXX1f0afrzDTbyBVgxE",
-	116:  "DGvkQ3pRNcrklECEeePzcGUDubzWbfFfjksuhWBO+fo",
-	644:  "10+iTdF2BJm4n8CVAECiJN/UlY3y3Z7vXxIU9+aFS8A",
-	1586: "ChHHcqj6uXwqgC6Zex+21V7RxSYc5o8pmjV9zRdI3zg",
-	851:  "kLq8+aEigj8VlBy8e+keInpH7CehFSW4NAtS+s3vdmM",
-	3008: "DF9ygIWetUe7D/qdD4qlzIbuQfDP5d7wu2eQP6Oehqk",
-	2761: "xPImQjSVT0szNsBoZZOg/WRnKbd99oBxwdC9s30jBmw",
-	153:  "QtTHHVhefQflavAgr/5/+6zsrCMz7Kdgnzg3EgoHch0",
-	1470: "6n5G5/yS7Zyke93r/P75/mpODuRE1lE1AZkI56aXsl4",
-	1055: "XzrWVlDlnatvez4jSoOw3c1rUSSSgrJvCfHDm2dTQmQ",
-	596:  "VjUjZWkslqL5rEH/tJBvqgiNH2rxbMFb/57etqJZzk8",
-	3035: "8TODZut/sTSihtZ7fQk6fgrUrxB6dM+oFVs7gxxpejQ",
-	3829: "rUXBvFHrtBDqHw/kxwLaRTdGygVIy5cwOdiz1QjEjAA",
-	3157: "tZCDFYKkzvUoBcGXFCcrTb8WMwCoZGXOPxO/v95/I24",
-	3684: "fYKW5/HmIA3C6io03CgDMzCYNZQKZ7tfBgI987mg3FE",
-	678:  "A8yEAKiKqCM7W0Rmp2ATWOcIYrzaNgcyQvALqS/3Szc",
-	382:  "7jrrXWtgDlCeeJ/XcN8aksOcYDJh/qpJYQ6/FPzz7mY",
-	3581: "2qV7ThD18a9Gga8Yyw50obbaWtFalnE35MS7vNSN2kI",
-	2175: "4wt19up+ztKiTvThYtIgNNH1LMWDes5qGw9qsirlL80",
-	2288: "PbiteiMtmGOTLC9iKtHRqF5lIIb8yYodpqxFCikXAFk",
-	3950: "LrJVbNR5yJoZ44JmLcclXXu89Rh3QXej+n1GOzbtgPM",
-	2371: "1V3hg/OeQSkfmMxwLprsNdbjrWE4OcgBMnZYsqcPfKs",
-	1472: "hscdCZ3wEMFlp+fJFEiS/QcYjodB1EITJzi/IqoXyY8",
-	2299: "+NCVzrQTvsScKLgmFGjdZDyWpCc62zB9zsvuweL+Wn0",
-}
@@ -0,0 +1,132 @@
+package main
+
+// 取引ごとの決済の記録
+//
+// /buy では与信 (authorize) だけを取り、/complete で確定 (capture) し、/cancel では返金 (refund) する。
+// 与信を取ったあとに配送の予約に失敗した場合はその場で与信を取り消す。
+// 記録は paymentServer に取引 ID をキーにして保存し、payments テーブルには writeBehind で書く。
+// 初期データの取引や二段階にする前の取引は /token で決済済みなので記録が無い。
+// 確定はそのまま成功にするが、/token の決済 ID は残していないので返金はできず、キャンセルを断る。
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/isucon/isucon9-qualify/webapp/go/internal/kvtypes"
+)
+
+const (
+	PaymentStatusAuthorized = "authorized"
+	PaymentStatusCaptured   = "captured"
+	PaymentStatusRefunded   = "refunded"
+)
+
+var ErrPaymentNotRecorded = errors.New("payment is not recorded")
+
+type Payment = kvtypes.Payment
+
+func recordAuthorizedPayment(transactionEvidenceID int64, paymentID string, amount int, now time.Time) {
+	payment := Payment{
+		TransactionEvidenceID: transactionEvidenceID,
+		PaymentID:             paymentID,
+		Amount:                amount,
+		Status:                PaymentStatusAuthorized,
+		CreatedAt:             now,
+		UpdatedAt:             now,
+	}
+	paymentServer.Set(strconv.Itoa(int(transactionEvidenceID)), payment)
+	writeBehind("INSERT INTO `payments` (`transaction_evidence_id`, `payment_id`, `amount`, `status`, `created_at`, `updated_at`) VALUES (?, ?, ?, ?, ?, ?)",
+		payment.TransactionEvidenceID,
+		payment.PaymentID,
+		payment.Amount,
+		payment.Status,
+		now,
+		now,
+	)
+}
+
+// 与信を確定する。確定済みや記録が無いものはそのまま成功にする
+func capturePayment(transactionEvidenceID int64) error {
+	return changePaymentStatus(transactionEvidenceID, PaymentStatusCaptured, nil, func(payment Payment) error {
+		if payment.Status != PaymentStatusAuthorized {
+			return fmt.Errorf("payment %s is %s", payment.PaymentID, payment.Status)
+		}
+		return requestPaymentCapture(getPaymentServiceURL(), payment.PaymentID)
+	})
+}
+
+// 与信の取り消しか返金をする。返金済みのものはそのまま成功にし、記録が無いものは ErrPaymentNotRecorded を返す
+func refundPayment(transactionEvidenceID int64) error {
+	return changePaymentStatus(transactionEvidenceID, PaymentStatusRefunded, ErrPaymentNotRecorded, func(payment Payment) error {
+		return requestPaymentRefund(getPaymentServiceURL(), payment.PaymentID)
+	})
+}
+
+func requestPaymentCapture(paymentURL, paymentID string) error {
+	res, err := APIPaymentCapture(paymentURL, &APIPaymentOperationReq{
+		ShopID:    PaymentServiceIsucariShopID,
+		APIKey:    PaymentServiceIsucariAPIKey,
+		PaymentID: paymentID,
+	})
+	if err != nil {
+		return err
+	}
+	if res.Status != "ok" {
+		return fmt.Errorf("capture %s: %s", paymentID, res.Status)
+	}
+	return nil
+}
+
+func requestPaymentRefund(paymentURL, paymentID string) error {
+	res, err := APIPaymentRefund(paymentURL, &APIPaymentOperationReq{
+		ShopID:    PaymentServiceIsucariShopID,
+		APIKey:    PaymentServiceIsucariAPIKey,
+		PaymentID: paymentID,
+	})
+	if err != nil {
+		return err
+	}
+	if res.Status != "ok" {
+		return fmt.Errorf("refund %s: %s", paymentID, res.Status)
+	}
+	return nil
+}
+
+// 取引を作る前に失敗した時の与信の取り消し。記録が無いのでログに残すだけ
+func releaseUnrecordedPayment(paymentID string) {
+	if err := requestPaymentRefund(getPaymentServiceURL(), paymentID); err != nil {
+		log.Println("payment: failed to release authorization", paymentID, err)
+	}
+}
+
+// payment service を呼ぶ間はキーをロックして、同じ決済に二重に操作しないようにする。
+// 記録が無ければ notRecorded を返す
+func changePaymentStatus(transactionEvidenceID int64, to string, notRecorded error, request func(payment Payment) error) (err error) {
+	key := strconv.Itoa(int(transactionEvidenceID))
+	paymentServer.Transaction(key, func(tx KeyValueStoreConn) {
+		payment := Payment{}
+		if !tx.Get(key, &payment) {
+			err = notRecorded
+			return
+		}
+		if payment.Status == to {
+			return
+		}
+		err = request(payment)
+		if err != nil {
+			return
+		}
+		now := time.Now().Truncate(time.Second)
+		payment.Status = to
+		payment.UpdatedAt = now
+		tx.Set(key, payment)
+		writeBehind("UPDATE `payments` SET `status` = ?, `updated_at` = ? WHERE `transaction_evidence_id` = ?",
+			to,
+			now,
+			transactionEvidenceID,
+		)
+	})
+	return err
+}
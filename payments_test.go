@@ -0,0 +1,156 @@
+package main
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// cmd/fakepayment をビルドして空いているポートで起動する。止めるのは stop
+func startFakePayment(t *testing.T) (paymentURL string, stop func()) {
+	t.Helper()
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go command is not available")
+	}
+	dir, err := ioutil.TempDir("", "fakepayment")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bin := filepath.Join(dir, "fakepayment")
+	if out, err := exec.Command("go", "build", "-o", bin, "./cmd/fakepayment").CombinedOutput(); err != nil {
+		os.RemoveAll(dir)
+		t.Fatalf("build fakepayment: %v\n%s", err, out)
+	}
+	listener, err := net.Listen("tcp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := listener.Addr().String()
+	listener.Close()
+	cmd := exec.Command(bin, "-addr", addr, "-shop-id", PaymentServiceIsucariShopID, "-api-key", PaymentServiceIsucariAPIKey)
+	if err := cmd.Start(); err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	stop = func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+		os.RemoveAll(dir)
+	}
+	paymentURL = "http://" + addr
+	for i := 0; ; i++ {
+		res, err := http.Get(paymentURL + "/payments")
+		if err == nil {
+			res.Body.Close()
+			break
+		}
+		if i == 50 {
+			stop()
+			t.Fatalf("fakepayment did not start: %v", err)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return paymentURL, stop
+}
+
+func authorizeTestPayment(t *testing.T, paymentURL, token string) *APIPaymentAuthorizeRes {
+	t.Helper()
+	par, err := APIPaymentAuthorize(paymentURL, &APIPaymentAuthorizeReq{
+		ShopID: PaymentServiceIsucariShopID,
+		Token:  token,
+		APIKey: PaymentServiceIsucariAPIKey,
+		Price:  1000,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return par
+}
+
+// /buy で与信を取り、/complete で確定、/cancel で返金する流れを fakepayment に対して通す
+func TestPaymentFlow(t *testing.T) {
+	paymentURL, stop := startFakePayment(t)
+	defer stop()
+	recorder, restore := setupWriteBehindTest(t, nil)
+	defer restore()
+	savedPaymentServer := paymentServer
+	paymentServer = newTestSyncMapServerConn(t)
+	paymentServer.server.NewValueFunction = func() interface{} { return &Payment{} }
+	defer func() { paymentServer = savedPaymentServer }()
+
+	capture := func(payment Payment) error { return requestPaymentCapture(paymentURL, payment.PaymentID) }
+	refund := func(payment Payment) error { return requestPaymentRefund(paymentURL, payment.PaymentID) }
+	status := func(transactionEvidenceID int64) string {
+		payment := Payment{}
+		paymentServer.Get(strconv.Itoa(int(transactionEvidenceID)), &payment)
+		return payment.Status
+	}
+	now := time.Now().Truncate(time.Second)
+
+	// カードが使えなければ決済 ID は無い
+	if par := authorizeTestPayment(t, paymentURL, "fail-card"); par.Status != "fail" || par.PaymentID != "" {
+		t.Errorf("authorize with fail-card = %+v", par)
+	}
+
+	// 与信 → 確定 → 返金
+	par := authorizeTestPayment(t, paymentURL, "card")
+	if par.Status != "ok" || par.PaymentID == "" {
+		t.Fatalf("authorize = %+v", par)
+	}
+	recordAuthorizedPayment(1, par.PaymentID, 1000, now)
+	if err := changePaymentStatus(1, PaymentStatusCaptured, nil, capture); err != nil || status(1) != PaymentStatusCaptured {
+		t.Fatalf("capture: %v, status = %s", err, status(1))
+	}
+	// 確定済みならもう payment service は呼ばない
+	if err := changePaymentStatus(1, PaymentStatusCaptured, nil, capture); err != nil {
+		t.Errorf("capture twice: %v", err)
+	}
+	if err := changePaymentStatus(1, PaymentStatusRefunded, ErrPaymentNotRecorded, refund); err != nil || status(1) != PaymentStatusRefunded {
+		t.Fatalf("refund: %v, status = %s", err, status(1))
+	}
+	// 返金したものは確定できない
+	if err := requestPaymentCapture(paymentURL, par.PaymentID); err == nil {
+		t.Error("captured a refunded payment")
+	}
+
+	// 与信のまま取り消す
+	par = authorizeTestPayment(t, paymentURL, "card")
+	recordAuthorizedPayment(2, par.PaymentID, 1000, now)
+	if err := changePaymentStatus(2, PaymentStatusRefunded, ErrPaymentNotRecorded, refund); err != nil || status(2) != PaymentStatusRefunded {
+		t.Fatalf("release: %v, status = %s", err, status(2))
+	}
+
+	// payment service が断ったら状態を変えない
+	recordAuthorizedPayment(3, "unknown", 1000, now)
+	if err := changePaymentStatus(3, PaymentStatusCaptured, nil, capture); err == nil || status(3) != PaymentStatusAuthorized {
+		t.Errorf("capture unknown payment: %v, status = %s", err, status(3))
+	}
+
+	// 記録の無い (/token で決済済みの) 取引は確定はそのまま通し、返金は断る
+	called := false
+	request := func(payment Payment) error {
+		called = true
+		return nil
+	}
+	if err := changePaymentStatus(4, PaymentStatusCaptured, nil, request); err != nil {
+		t.Errorf("capture without a record: %v", err)
+	}
+	if err := changePaymentStatus(4, PaymentStatusRefunded, ErrPaymentNotRecorded, request); err != ErrPaymentNotRecorded {
+		t.Errorf("refund without a record: %v", err)
+	}
+	if called {
+		t.Error("payment service was called without a record")
+	}
+
+	applyWriteBehindEncoded(popWriteBehindBatch(-1))
+	// INSERT 3 件と、状態を変えた 3 回の UPDATE
+	if got := len(recorder.Applied()); got != 6 {
+		t.Errorf("applied %d queries, want 6: %v", got, recorder.Applied())
+	}
+}
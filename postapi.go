@@ -10,14 +10,54 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strconv"
-	"strings"
 	"sync"
 	"time"
 
+	"github.com/isucon/isucon9-qualify/webapp/go/internal/kvtypes"
 	"golang.org/x/crypto/bcrypt"
 )
 
+// 各ストアに保存している型と codec を設定する(サブコマンドからも呼ぶ)
+func configureSyncMapStores() {
+	// Export / Import 用に保存している型を登録しておく
+	accountNameToIDServer.server.NewValueFunction = func() interface{} { return new(string) }
+	idToUserServer.server.NewValueFunction = func() interface{} { return &User{} }
+	idToItemServer.server.NewValueFunction = func() interface{} { return &Item{} }
+	itemIdToTransactionEvidenceServer.server.NewValueFunction = func() interface{} { return &TransactionEvidence{} }
+	transactionEvidenceToShippingsServer.server.NewValueFunction = func() interface{} { return &Shipping{} }
+	sessionServer.server.NewValueFunction = func() interface{} { return &serverSession{} }
+	loginAttemptServer.server.NewValueFunction = func() interface{} { return &loginAttempt{} }
+	twoFactorServer.server.NewValueFunction = func() interface{} { return &twoFactor{} }
+	apiTokenServer.server.NewValueFunction = func() interface{} { return &apiToken{} }
+	paymentServer.server.NewValueFunction = func() interface{} { return &Payment{} }
+	campaignServer.server.NewValueFunction = func() interface{} { return &campaign{} }
+	pointServer.server.NewValueFunction = func() interface{} { return &userPoints{} }
+	reviewServer.server.NewValueFunction = func() interface{} { return &Review{} }
+	likeServer.server.NewValueFunction = func() interface{} { return &itemLike{} }
+	counterServer.server.NewValueFunction = func() interface{} { return new(int) }
+	commentServer.server.NewValueFunction = func() interface{} { return &itemComment{} }
+	messageServer.server.NewValueFunction = func() interface{} { return &transactionMessage{} }
+	notificationServer.server.NewValueFunction = func() interface{} { return &notification{} }
+	// 数が多くてよく読む Item / User は専用のバイナリ形式で保存する (internal/kvtypes/codec_test.go のベンチマーク参照)
+	idToUserServer.server.SetCodec(kvtypes.BinaryCodec{})
+	idToItemServer.server.SetCodec(kvtypes.BinaryCodec{})
+}
+
 func setInitializeFunction() {
+	configureSyncMapStores()
+	registerSyncMapProcedures()
+	registerSyncMapIndexes()
+	subscribeLocalCacheReset()
+	startShipmentStatusWorkers()
+	startWriteBehindWorker()
+	startReconcileTask()
+	startSessionSweeper()
+	startLoginAttemptSweeper()
+	scrubStoredPlainPasswords()
+	migrateKVOnlyUsersOnStartup()
+	ensureDefaultCampaign()
+	startLatencyReporter()
+	startCampaignController()
 	idToUserServer.server.InitializeFunction = func() {
 		log.Println("idToUserServer init")
 		err := dbx.Select(&users, "SELECT * FROM `users`")
@@ -25,7 +65,6 @@ func setInitializeFunction() {
 			panic(err)
 		}
 		for _, u := range users {
-			u.PlainPassword = userIdToPlainPassword[int(u.ID)]
 			key := strconv.Itoa(int(u.ID))
 			idToUserServerMap[key] = u
 			accountNameToIDServerMap[u.AccountName] = key
@@ -85,14 +124,39 @@ var users = make([]User, 0)
 var idToUserServerMap = map[string]interface{}{}
 var accountNameToIDServerMap = map[string]interface{}{}
 var isBoughtByKey = sync.Map{} // rb.ItemID -> (chan int)
-var sessionCache = sync.Map{}  //[string]sessions.Session{}
+
+// 台毎に持っているキャッシュを捨てる。/initialize で全台に通知される
+// 購読の goroutine から呼ばれ、リクエストの処理と並行するので、変数は差し替えずに中身を消す
+func resetLocalCaches() {
+	clearSyncMap(&isBoughtByKey)
+	clearSyncMap(&shipmentStatusRefreshQueued)
+	clearSyncMap(&passwordVerifierCache)
+}
+func clearSyncMap(m *sync.Map) {
+	m.Range(func(key, _ interface{}) bool {
+		m.Delete(key)
+		return true
+	})
+}
+func subscribeLocalCacheReset() {
+	subscription := eventServer.Subscribe(channelCacheReset)
+	go func() {
+		for {
+			select {
+			case <-subscription.Messages():
+				resetLocalCaches()
+			case <-subscription.Done():
+				return
+			}
+		}
+	}()
+}
 
 func initializeDBtoOnMemory() {
 	users = make([]User, 0)
 	idToUserServerMap = map[string]interface{}{}
 	accountNameToIDServerMap = map[string]interface{}{}
-	isBoughtByKey = sync.Map{}
-	sessionCache = sync.Map{}
+	resetLocalCaches()
 	// 1台目にこれが呼ばれてるけど...
 	// 複数台から同時に呼ばないように注意
 	var wg sync.WaitGroup
@@ -115,6 +179,24 @@ func initializeDBtoOnMemory() {
 		wg.Done()
 	}()
 	wg.Wait()
+	// 初期化前の取引のジョブは要らない
+	eventServer.Del(queueShipmentStatus)
+	// 初期データの取引は決済の記録を持たない (payments テーブルも init.sh で作り直される)
+	paymentServer.FlushAll()
+	// ユーザーも作り直すので、ID が同じ別のユーザーとしてログインしたままにならないように
+	sessionServer.FlushAll()
+	loginAttemptServer.FlushAll()
+	twoFactorServer.FlushAll()
+	apiTokenServer.FlushAll()
+	pointServer.FlushAll()
+	reviewServer.FlushAll()
+	likeServer.FlushAll()
+	counterServer.FlushAll()
+	commentServer.FlushAll()
+	messageServer.FlushAll()
+	notificationServer.FlushAll()
+	eventServer.Del(notificationIDKey)
+	eventServer.Publish(channelCacheReset, nil)
 }
 
 func postInitialize(w http.ResponseWriter, r *http.Request) {
@@ -126,6 +208,7 @@ func postInitialize(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	discardWriteBehind()
 	cmd := exec.Command("../sql/init.sh")
 	cmd.Stderr = os.Stderr
 	cmd.Stdout = os.Stderr
@@ -156,10 +239,14 @@ func postInitialize(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	initializeDBtoOnMemory()
+	scrubStoredPlainPasswords()
+	ensureDefaultCampaign()
 
 	res := resInitialize{
 		// キャンペーン実施時には還元率の設定を返す。詳しくはマニュアルを参照のこと。
-		Campaign: 4,
+		// 段階は /debug/campaigns で変えられる (campaigns.go)
+		// 負荷を見て自動で変える時はその段階から始める (campaigncontroller.go)
+		Campaign: resetCampaignController(currentCampaignLevel(time.Now())),
 		// 実装言語を返す
 		Language: "Go",
 	}
@@ -180,7 +267,7 @@ func postItemEdit(w http.ResponseWriter, r *http.Request) {
 	itemID := rie.ItemID
 	price := rie.ItemPrice
 
-	if csrfToken != getCSRFToken(r) {
+	if !csrfTokenOK(r, csrfToken) {
 		outputErrorMsg(w, http.StatusUnprocessableEntity, "csrf token error")
 
 		return
@@ -197,27 +284,19 @@ func postItemEdit(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	now := time.Now().Truncate(time.Second)
-	targetItem := Item{}
 	itemIDStr := strconv.Itoa(int(itemID))
-	idToItemServer.Transaction(itemIDStr, func(tx KeyValueStoreConn) {
-		ok := tx.Get(itemIDStr, &targetItem)
-		if !ok {
-			outputErrorMsg(w, http.StatusNotFound, "item not found")
-			return
-		}
-		if targetItem.SellerID != seller.ID {
-			outputErrorMsg(w, http.StatusForbidden, "自分の商品以外は編集できません")
-			return
-		}
-		if targetItem.Status != ItemStatusOnSale {
-			outputErrorMsg(w, http.StatusForbidden, "販売中の商品以外編集できません")
-			return
-		}
-		targetItem.Price = price
-		targetItem.UpdatedAt = now
-		tx.Set(itemIDStr, targetItem)
-		dbx.Exec("UPDATE `items` SET `price` = ?, `updated_at` = ? WHERE `id` = ?", price, now, itemID)
-	})
+	result, err := callItemProcedure(procedureItemEdit, itemIDStr, int(seller.ID), price, int(now.Unix()))
+	if err != nil {
+		log.Print(err)
+		outputErrorMsg(w, http.StatusInternalServerError, "kvs error")
+		return
+	}
+	if result.Status != http.StatusOK {
+		outputErrorMsg(w, result.Status, result.Message)
+		return
+	}
+	targetItem := result.Item
+	writeBehind("UPDATE `items` SET `price` = ?, `updated_at` = ? WHERE `id` = ?", price, now, itemID)
 	w.Header().Set("Content-Type", "application/json;charset=utf-8")
 	json.NewEncoder(w).Encode(&resItemEdit{
 		ItemID:        targetItem.ID,
@@ -236,7 +315,7 @@ func postBuy(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if rb.CSRFToken != getCSRFToken(r) {
+	if !csrfTokenOK(r, rb.CSRFToken) {
 		outputErrorMsg(w, http.StatusUnprocessableEntity, "csrf token error")
 
 		return
@@ -264,6 +343,7 @@ func postBuy(w http.ResponseWriter, r *http.Request) {
 	itemIdStr := strconv.Itoa(int(rb.ItemID))
 	successed := false
 	var transactionEvidenceID int64
+	transactionEvidence := TransactionEvidence{}
 	idToItemServer.Transaction(itemIdStr, func(tx KeyValueStoreConn) {
 		ok := tx.Get(itemIdStr, &targetItem)
 		if !ok {
@@ -294,9 +374,9 @@ func postBuy(w http.ResponseWriter, r *http.Request) {
 			scr *APIShipmentCreateRes
 			err error
 		}
-		type PstrErr struct {
-			pstr *APIPaymentServiceTokenRes
-			err  error
+		type ParErr struct {
+			par *APIPaymentAuthorizeRes
+			err error
 		}
 		chScrErr := make(chan ScrErr, 1)
 		go func() {
@@ -308,43 +388,47 @@ func postBuy(w http.ResponseWriter, r *http.Request) {
 			})
 			chScrErr <- ScrErr{scr, err}
 		}()
-		chPstrErr := make(chan PstrErr, 1)
+		chParErr := make(chan ParErr, 1)
 		go func() {
-			pstr, err := APIPaymentToken(getPaymentServiceURL(), &APIPaymentServiceTokenReq{
+			// ここでは与信だけ取る。確定は /complete (payments.go)
+			par, err := APIPaymentAuthorize(getPaymentServiceURL(), &APIPaymentAuthorizeReq{
 				ShopID: PaymentServiceIsucariShopID,
 				Token:  rb.Token,
 				APIKey: PaymentServiceIsucariAPIKey,
 				Price:  targetItem.Price,
 			})
-			chPstrErr <- PstrErr{pstr, err}
+			chParErr <- ParErr{par, err}
 		}()
 		scrErr := <-chScrErr
 		scr, err := scrErr.scr, scrErr.err
+		parErr := <-chParErr
+		par := parErr.par
 		if err != nil {
+			if parErr.err == nil && par.Status == "ok" {
+				releaseUnrecordedPayment(par.PaymentID)
+			}
 			outputErrorMsg(w, http.StatusInternalServerError, "failed to request to shipment service")
 			return
 		}
-		pstrErr := <-chPstrErr
-		pstr, err := pstrErr.pstr, pstrErr.err
-		if err != nil {
+		if parErr.err != nil {
 			outputErrorMsg(w, http.StatusInternalServerError, "payment service is failed")
 			return
 		}
-		if pstr.Status == "invalid" {
+		if par.Status == "invalid" {
 			outputErrorMsg(w, http.StatusBadRequest, "カード情報に誤りがあります")
 			return
 		}
-		if pstr.Status == "fail" {
+		if par.Status == "fail" {
 			outputErrorMsg(w, http.StatusBadRequest, "カードの残高が足りません")
 			return
 		}
-		if pstr.Status != "ok" {
+		if par.Status != "ok" {
 			outputErrorMsg(w, http.StatusBadRequest, "想定外のエラー")
 			return
 		}
 		// 成功する(itemkeyでロックしているので)
 		now := time.Now().Truncate(time.Second)
-		transactionEvidence := TransactionEvidence{
+		transactionEvidence = TransactionEvidence{
 			// ID
 			SellerID:           targetItem.SellerID,
 			BuyerID:            buyer.ID,
@@ -358,7 +442,7 @@ func postBuy(w http.ResponseWriter, r *http.Request) {
 			CreatedAt:          now, // WARN: 多分行ける
 			UpdatedAt:          now,
 		}
-		result, _ := dbx.Exec("INSERT INTO `transaction_evidences` (`seller_id`, `buyer_id`, `status`, `item_id`, `item_name`, `item_price`, `item_description`,`item_category_id`,`item_root_category_id`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		result, err := dbx.Exec("INSERT INTO `transaction_evidences` (`seller_id`, `buyer_id`, `status`, `item_id`, `item_name`, `item_price`, `item_description`,`item_category_id`,`item_root_category_id`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
 			transactionEvidence.SellerID,
 			transactionEvidence.BuyerID,
 			transactionEvidence.Status,
@@ -369,40 +453,48 @@ func postBuy(w http.ResponseWriter, r *http.Request) {
 			transactionEvidence.ItemCategoryID,
 			transactionEvidence.ItemRootCategoryID,
 		)
+		if err != nil {
+			log.Print(err)
+			releaseUnrecordedPayment(par.PaymentID)
+			outputErrorMsg(w, http.StatusInternalServerError, "db error")
+			return
+		}
 		transactionEvidenceID, _ = result.LastInsertId()
 		transactionEvidence.ID = transactionEvidenceID
+		recordAuthorizedPayment(transactionEvidenceID, par.PaymentID, targetItem.Price, now)
 		itemIdToTransactionEvidenceServer.Set(itemIdStr, transactionEvidence)
 		targetItem.BuyerID = buyer.ID
 		targetItem.Status = ItemStatusTrading
 		targetItem.UpdatedAt = now
 		// ロールバックされうるので遅延
 		tx.Set(itemIdStr, targetItem)
-		dbx.Exec("UPDATE `items` SET `buyer_id` = ?, `status` = ?, `updated_at` = ? WHERE `id` = ?",
+		writeBehind("UPDATE `items` SET `buyer_id` = ?, `status` = ?, `updated_at` = ? WHERE `id` = ?",
 			buyer.ID,
 			ItemStatusTrading,
 			now,
 			targetItem.ID,
 		)
 		ship := Shipping{
-			transactionEvidenceID,
-			ShippingsStatusInitial,
-			targetItem.Name,
-			targetItem.ID,
-			scr.ReserveID,
-			scr.ReserveTime,
-			buyer.Address,
-			buyer.AccountName,
-			seller.Address,
-			seller.AccountName,
-			[]byte{},
-			now,
-			now,
+			TransactionEvidenceID: transactionEvidenceID,
+			Status:                ShippingsStatusInitial,
+			ItemName:              targetItem.Name,
+			ItemID:                targetItem.ID,
+			ReserveID:             scr.ReserveID,
+			ReserveTime:           scr.ReserveTime,
+			ToAddress:             buyer.Address,
+			ToName:                buyer.AccountName,
+			FromAddress:           seller.Address,
+			FromName:              seller.AccountName,
+			ImgBinary:             []byte{},
+			CreatedAt:             now,
+			UpdatedAt:             now,
 		}
 		transactionEvidenceToShippingsServer.Set(strconv.Itoa(int(transactionEvidenceID)), ship)
 		successed = true
 	})
 	*chanBoughtExistance <- successed
 	if successed {
+		emitTransactionEvent(TransactionEventBuy, transactionEvidence, buyer.ID)
 		w.Header().Set("Content-Type", "application/json;charset=utf-8")
 		json.NewEncoder(w).Encode(resBuy{TransactionEvidenceID: transactionEvidenceID})
 	}
@@ -417,7 +509,7 @@ func postShip(w http.ResponseWriter, r *http.Request) {
 	}
 	csrfToken := reqps.CSRFToken
 	itemID := reqps.ItemID
-	if csrfToken != getCSRFToken(r) {
+	if !csrfTokenOK(r, csrfToken) {
 		outputErrorMsg(w, http.StatusUnprocessableEntity, "csrf token error")
 		return
 	}
@@ -427,37 +519,50 @@ func postShip(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	transactionEvidence := TransactionEvidence{}
-	ok := itemIdToTransactionEvidenceServer.Get(strconv.Itoa(int(itemID)), &transactionEvidence)
-	if !ok {
-		outputErrorMsg(w, http.StatusNotFound, "transaction_evidences not found")
-		return
-	}
-	if transactionEvidence.SellerID != seller.ID {
-		outputErrorMsg(w, http.StatusForbidden, "権限がありません")
-		return
-	}
-	if transactionEvidence.Status != TransactionEvidenceStatusWaitShipping {
-		outputErrorMsg(w, http.StatusForbidden, "準備ができていません")
-		return
-	}
 	shipping := Shipping{}
-	trIdStr := strconv.Itoa(int(transactionEvidence.ID))
-	ok = transactionEvidenceToShippingsServer.Get(trIdStr, &shipping)
-	if !ok {
-		outputErrorMsg(w, http.StatusNotFound, "shippings not found")
-		return
-	}
-	img, err := APIShipmentRequest(getShipmentServiceURL(), &APIShipmentRequestReq{
-		ReserveID: shipping.ReserveID,
+	itemIDStr := strconv.Itoa(int(itemID))
+	successed := false
+	// キャンセルと重ならないように商品のロックの中で確かめてから配送を依頼する
+	idToItemServer.Transaction(itemIDStr, func(tx KeyValueStoreConn) {
+		if !itemIdToTransactionEvidenceServer.Get(itemIDStr, &transactionEvidence) {
+			outputErrorMsg(w, http.StatusNotFound, "transaction_evidences not found")
+			return
+		}
+		if transactionEvidence.SellerID != seller.ID {
+			outputErrorMsg(w, http.StatusForbidden, "権限がありません")
+			return
+		}
+		if transactionEvidence.Status != TransactionEvidenceStatusWaitShipping {
+			outputErrorMsg(w, http.StatusForbidden, "準備ができていません")
+			return
+		}
+		trIdStr := strconv.Itoa(int(transactionEvidence.ID))
+		if !transactionEvidenceToShippingsServer.Get(trIdStr, &shipping) {
+			outputErrorMsg(w, http.StatusNotFound, "shippings not found")
+			return
+		}
+		if shipping.Status != ShippingsStatusInitial && shipping.Status != ShippingsStatusWaitPickup {
+			outputErrorMsg(w, http.StatusForbidden, "準備ができていません")
+			return
+		}
+		img, err := APIShipmentRequest(getShipmentServiceURL(), &APIShipmentRequestReq{
+			ReserveID: shipping.ReserveID,
+		})
+		if err != nil {
+			outputErrorMsg(w, http.StatusInternalServerError, "failed to request to shipment service")
+			return
+		}
+		shipping.ImgBinary = img
+		shipping.Status = ShippingsStatusWaitPickup
+		shipping.UpdatedAt = time.Now().Truncate(time.Second)
+		transactionEvidenceToShippingsServer.Set(trIdStr, shipping)
+		successed = true
 	})
-	if err != nil {
-		outputErrorMsg(w, http.StatusInternalServerError, "failed to request to shipment service")
+	if !successed {
 		return
 	}
-	shipping.ImgBinary = img
-	shipping.Status = ShippingsStatusWaitPickup
-	shipping.UpdatedAt = time.Now().Truncate(time.Second)
-	transactionEvidenceToShippingsServer.Set(trIdStr, shipping)
+	enqueueShipmentStatusRefresh(transactionEvidence.ID, shipping.ReserveID)
+	emitTransactionEvent(TransactionEventShip, transactionEvidence, seller.ID)
 	rps := resPostShip{
 		Path:      fmt.Sprintf("/transactions/%d.png", transactionEvidence.ID),
 		ReserveID: shipping.ReserveID,
@@ -477,7 +582,7 @@ func postShipDone(w http.ResponseWriter, r *http.Request) {
 	csrfToken := reqpsd.CSRFToken
 	itemID := reqpsd.ItemID
 
-	if csrfToken != getCSRFToken(r) {
+	if !csrfTokenOK(r, csrfToken) {
 		outputErrorMsg(w, http.StatusUnprocessableEntity, "csrf token error")
 
 		return
@@ -530,7 +635,7 @@ func postShipDone(w http.ResponseWriter, r *http.Request) {
 		transactionEvidence.Status = TransactionEvidenceStatusWaitDone
 		transactionEvidence.UpdatedAt = now
 		itemIdToTransactionEvidenceServer.Set(itemIDStr, transactionEvidence)
-		dbx.Exec("UPDATE `transaction_evidences` SET `status` = ?, `updated_at` = ? WHERE `id` = ?",
+		writeBehind("UPDATE `transaction_evidences` SET `status` = ?, `updated_at` = ? WHERE `id` = ?",
 			TransactionEvidenceStatusWaitDone,
 			now,
 			transactionEvidence.ID,
@@ -541,6 +646,7 @@ func postShipDone(w http.ResponseWriter, r *http.Request) {
 		successed = true
 	})
 	if successed {
+		emitTransactionEvent(TransactionEventShipDone, transactionEvidence, seller.ID)
 		w.Header().Set("Content-Type", "application/json;charset=utf-8")
 		json.NewEncoder(w).Encode(resBuy{TransactionEvidenceID: transactionEvidence.ID})
 	}
@@ -555,7 +661,7 @@ func postComplete(w http.ResponseWriter, r *http.Request) {
 	}
 	csrfToken := reqpc.CSRFToken
 	itemID := reqpc.ItemID
-	if csrfToken != getCSRFToken(r) {
+	if !csrfTokenOK(r, csrfToken) {
 		outputErrorMsg(w, http.StatusUnprocessableEntity, "csrf token error")
 
 		return
@@ -606,6 +712,12 @@ func postComplete(w http.ResponseWriter, r *http.Request) {
 			outputErrorMsg(w, http.StatusBadRequest, "shipment service側で配送完了になっていません")
 			return
 		}
+		err = capturePayment(transactionEvidence.ID)
+		if err != nil {
+			log.Println(err)
+			outputErrorMsg(w, http.StatusInternalServerError, "payment service is failed")
+			return
+		}
 		// 楽観
 		now := time.Now().Truncate(time.Second)
 		shipping.Status = ShippingsStatusDone
@@ -614,7 +726,7 @@ func postComplete(w http.ResponseWriter, r *http.Request) {
 		transactionEvidence.Status = TransactionEvidenceStatusDone
 		transactionEvidence.UpdatedAt = now
 		itemIdToTransactionEvidenceServer.Set(itemIdStr, transactionEvidence)
-		dbx.Exec("UPDATE `transaction_evidences` SET `status` = ?, `updated_at` = ? WHERE `id` = ?",
+		writeBehind("UPDATE `transaction_evidences` SET `status` = ?, `updated_at` = ? WHERE `id` = ?",
 			TransactionEvidenceStatusDone,
 			now,
 			transactionEvidence.ID,
@@ -622,14 +734,16 @@ func postComplete(w http.ResponseWriter, r *http.Request) {
 		item.UpdatedAt = now
 		item.Status = ItemStatusSoldOut
 		tx.Set(itemIdStr, item)
-		dbx.Exec("UPDATE `items` SET `status` = ?, `updated_at` = ? WHERE `id` = ?",
+		writeBehind("UPDATE `items` SET `status` = ?, `updated_at` = ? WHERE `id` = ?",
 			ItemStatusSoldOut,
 			now,
 			itemID,
 		)
+		grantCampaignPoints(transactionEvidence, now)
 		successed = true
 	})
 	if successed {
+		emitTransactionEvent(TransactionEventComplete, transactionEvidence, buyer.ID)
 		w.Header().Set("Content-Type", "application/json;charset=utf-8")
 		json.NewEncoder(w).Encode(resBuy{TransactionEvidenceID: transactionEvidence.ID})
 	}
@@ -650,7 +764,7 @@ func postSell(w http.ResponseWriter, r *http.Request) {
 	}
 	defer f.Close()
 
-	if csrfToken != getCSRFToken(r) {
+	if !csrfTokenOK(r, csrfToken) {
 		outputErrorMsg(w, http.StatusUnprocessableEntity, "csrf token error")
 		return
 	}
@@ -800,7 +914,7 @@ func postBump(w http.ResponseWriter, r *http.Request) {
 	}
 	csrfToken := rb.CSRFToken
 	itemID := rb.ItemID
-	if csrfToken != getCSRFToken(r) {
+	if !csrfTokenOK(r, csrfToken) {
 		outputErrorMsg(w, http.StatusUnprocessableEntity, "csrf token error")
 		return
 	}
@@ -840,7 +954,7 @@ func postBump(w http.ResponseWriter, r *http.Request) {
 			targetItem.UpdatedAt = now
 			targetItem.TimeDateID = now.Format("20060102150405") + fmt.Sprintf("%08d", targetItem.ID)
 			itx.Set(itemIDStr, targetItem)
-			dbx.Exec("UPDATE `items` SET `created_at`=?, `updated_at`=?, `timedateid`=? WHERE id=?",
+			writeBehind("UPDATE `items` SET `created_at`=?, `updated_at`=?, `timedateid`=? WHERE id=?",
 				targetItem.CreatedAt,
 				targetItem.UpdatedAt,
 				targetItem.TimeDateID,
@@ -877,7 +991,13 @@ func postLogin(w http.ResponseWriter, r *http.Request) {
 		outputErrorMsg(w, http.StatusBadRequest, "all parameters are required")
 		return
 	}
+	reservation, wait := reserveLoginAttempt(accountName, requestRemoteAddr(r))
+	if wait > 0 {
+		outputLoginRetryAfter(w, wait)
+		return
+	}
 	if !accountNameToIDServer.Exists(accountName) {
+		reservation.fail()
 		outputErrorMsg(w, http.StatusUnauthorized, "アカウント名かパスワードが間違えています")
 		return
 	}
@@ -885,11 +1005,27 @@ func postLogin(w http.ResponseWriter, r *http.Request) {
 	accountNameToIDServer.Get(accountName, &idStr)
 	u := User{}
 	idToUserServer.Get(idStr, &u)
-	if strings.Compare(u.PlainPassword, password) != 0 {
+	if !verifyPassword(&u, password) {
+		reservation.fail()
 		outputErrorMsg(w, http.StatusUnauthorized, "アカウント名かパスワードが間違えています")
 		return
 	}
+	reservation.release()
+	rehashPasswordIfNeeded(&u, password)
 	session := getSession(r)
+	if startPendingTwoFactor(session, u.ID) {
+		// 失敗の数は二段階目が通ってから戻す
+		if err = session.Save(r, w); err != nil {
+			log.Print(err)
+			outputErrorMsg(w, http.StatusInternalServerError, "session error")
+			return
+		}
+		w.Header().Set("Content-Type", "application/json;charset=utf-8")
+		json.NewEncoder(w).Encode(resLoginTwoFactor{TwoFactorRequired: true})
+		return
+	}
+	recordLoginSuccess(accountName)
+	renewSession(session)
 	session.Values["user_id"] = u.ID
 	session.Values["csrf_token"] = secureRandomStr(4)
 	if err = session.Save(r, w); err != nil {
@@ -926,23 +1062,45 @@ func postRegister(w http.ResponseWriter, r *http.Request) {
 		outputErrorMsg(w, http.StatusInternalServerError, "error")
 		return
 	}
-	// WARN: 合ってる？
-	// トランザクションが必要？(ID)
 	var newUser User
-	newUser.ID = int64(idToUserServer.DBSize() + 1)
 	newUser.AccountName = accountName
 	newUser.HashedPassword = hashedPassword
 	newUser.Address = address
-	// WARN
 	newUser.NumSellItems = 0
 	t, _ := time.Parse("2006-01-02 15:04:05", "2000-01-01 00:00:00")
 	newUser.LastBump = t
 	newUser.CreatedAt = time.Now().Truncate(time.Second) // CURRENT_TIMESTAMP
-	newUser.PlainPassword = password
-	idStr := strconv.Itoa(int(newUser.ID))
-	idToUserServer.Set(idStr, newUser)
-	accountNameToIDServer.Set(newUser.AccountName, idStr)
+	// アカウント名をロックしている間に MySQL に INSERT して、採番された ID で KV に入れる
+	accountNameToIDServer.Transaction(accountName, func(tx KeyValueStoreConn) {
+		if tx.Exists(accountName) {
+			err = ErrAccountNameTaken
+			return
+		}
+		newUser, err = insertUser(newUser)
+		if err != nil {
+			return
+		}
+		idStr := strconv.Itoa(int(newUser.ID))
+		if idToUserServer.Exists(idStr) {
+			// まだ移行していない KV だけのユーザーとぶつかった (migrate-users)
+			dbx.Exec("DELETE FROM `users` WHERE `id` = ?", newUser.ID)
+			err = fmt.Errorf("user id conflict: %s", idStr)
+			return
+		}
+		idToUserServer.Set(idStr, newUser)
+		tx.Set(accountName, idStr)
+	})
+	if err == ErrAccountNameTaken {
+		outputErrorMsg(w, http.StatusConflict, "アカウント名が既に使われています")
+		return
+	}
+	if err != nil {
+		log.Print(err)
+		outputErrorMsg(w, http.StatusInternalServerError, "db error")
+		return
+	}
 	session := getSession(r)
+	renewSession(session)
 	session.Values["user_id"] = newUser.ID
 	session.Values["csrf_token"] = secureRandomStr(4)
 	if err = session.Save(r, w); err != nil {
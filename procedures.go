@@ -0,0 +1,147 @@
+package main
+
+// SyncMapServer に登録する名前付きの手続き(master 上でキーをロックしたまま 1 往復で実行される)とインデックス
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const (
+	procedureItemEdit   = "item.edit"
+	procedureItemStatus = "item.status"
+)
+
+const ( // idToItemServer のインデックス (sortKey は TimeDateID)
+	indexItemsBySeller          = "items.seller"            // seller_id -> 全ての商品
+	indexItemsByBuyer           = "items.buyer"             // buyer_id -> 買った商品
+	indexItemsOnDisplayBySeller = "items.seller.on_display" // seller_id -> 出品一覧に出す商品 (on_sale / trading / sold_out)
+)
+
+// 手続きの結果。失敗した時は Status に HTTP のステータスを入れる
+type itemProcedureResult struct {
+	Status  int
+	Message string
+	Item    Item
+}
+
+func registerSyncMapProcedures() {
+	// keys: [itemID] args: [sellerID, price, now(unix)]
+	// 出品者本人の販売中の商品なら価格を変更する
+	idToItemServer.server.RegisterProcedure(procedureItemEdit, func(conn *SyncMapServerConn, keys []string, args [][]byte) ([]byte, error) {
+		sellerID := int64(decodeInt(args[0]))
+		price := decodeInt(args[1])
+		now := time.Unix(int64(decodeInt(args[2])), 0)
+		result := itemProcedureResult{Status: http.StatusOK}
+		if !conn.Get(keys[0], &result.Item) {
+			result.Status, result.Message = http.StatusNotFound, "item not found"
+			return encodeToBytes(result), nil
+		}
+		if result.Item.SellerID != sellerID {
+			result.Status, result.Message = http.StatusForbidden, "自分の商品以外は編集できません"
+			return encodeToBytes(result), nil
+		}
+		if result.Item.Status != ItemStatusOnSale {
+			result.Status, result.Message = http.StatusForbidden, "販売中の商品以外編集できません"
+			return encodeToBytes(result), nil
+		}
+		result.Item.Price = price
+		result.Item.UpdatedAt = now
+		conn.Set(keys[0], result.Item)
+		return encodeToBytes(result), nil
+	})
+	// keys: [itemID] args: [sellerID, from, to, now(unix)]
+	// 出品者本人の商品で状態が from なら to にする(出品の停止 / 再開)
+	idToItemServer.server.RegisterProcedure(procedureItemStatus, func(conn *SyncMapServerConn, keys []string, args [][]byte) ([]byte, error) {
+		sellerID := int64(decodeInt(args[0]))
+		from, to := "", ""
+		decodeFromBytes(args[1], &from)
+		decodeFromBytes(args[2], &to)
+		now := time.Unix(int64(decodeInt(args[3])), 0)
+		result := itemProcedureResult{Status: http.StatusOK}
+		if !conn.Get(keys[0], &result.Item) {
+			result.Status, result.Message = http.StatusNotFound, "item not found"
+			return encodeToBytes(result), nil
+		}
+		if result.Item.SellerID != sellerID {
+			result.Status, result.Message = http.StatusForbidden, "自分の商品以外は編集できません"
+			return encodeToBytes(result), nil
+		}
+		if result.Item.Status != from {
+			result.Status, result.Message = http.StatusForbidden, "商品の状態が変わっています"
+			return encodeToBytes(result), nil
+		}
+		result.Item.Status = to
+		result.Item.UpdatedAt = now
+		conn.Set(keys[0], result.Item)
+		return encodeToBytes(result), nil
+	})
+}
+
+func callItemProcedure(name string, itemIDStr string, args ...interface{}) (itemProcedureResult, error) {
+	encodedArgs := make([][]byte, len(args))
+	for i, arg := range args {
+		encodedArgs[i] = encodeToBytes(arg)
+	}
+	result := itemProcedureResult{}
+	encoded, err := idToItemServer.Call(name, []string{itemIDStr}, encodedArgs...)
+	if err != nil {
+		return result, err
+	}
+	err = decodeFromBytes(encoded, &result)
+	return result, err
+}
+
+func registerSyncMapIndexes() {
+	idToItemServer.server.RegisterIndex(indexItemsBySeller, func(value interface{}) ([]string, string) {
+		item := value.(*Item)
+		return []string{strconv.Itoa(int(item.SellerID))}, item.TimeDateID
+	})
+	idToItemServer.server.RegisterIndex(indexItemsByBuyer, func(value interface{}) ([]string, string) {
+		item := value.(*Item)
+		if item.BuyerID == 0 {
+			return nil, ""
+		}
+		return []string{strconv.Itoa(int(item.BuyerID))}, item.TimeDateID
+	})
+	idToItemServer.server.RegisterIndex(indexItemsOnDisplayBySeller, func(value interface{}) ([]string, string) {
+		item := value.(*Item)
+		switch item.Status {
+		case ItemStatusOnSale, ItemStatusTrading, ItemStatusSoldOut:
+			return []string{strconv.Itoa(int(item.SellerID))}, item.TimeDateID
+		}
+		return nil, ""
+	})
+	registerSessionIndexes()
+	registerAPITokenIndexes()
+	registerReviewIndexes()
+	registerLikeIndexes()
+	registerCommentIndexes()
+	registerMessageIndexes()
+	registerNotificationIndexes()
+}
+
+// インデックスから新しい順に limit 件の商品を取得する。cursor(TimeDateID) が空でなければそれより古いものだけ
+func queryItemsByIndex(index string, value int64, cursor string, limit int) ([]Item, error) {
+	keys, err := idToItemServer.IQuery(index, strconv.Itoa(int(value)), cursor, limit, true)
+	if err != nil {
+		return nil, err
+	}
+	mGot := idToItemServer.MGet(keys)
+	items := make([]Item, 0, len(keys))
+	for _, key := range keys {
+		item := Item{}
+		if mGot.Get(key, &item) {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+// 新しい順(created_at DESC, id DESC と同じ)に並べる
+func sortItemsByTimeDateIDDesc(items []Item) {
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].TimeDateID > items[j].TimeDateID
+	})
+}
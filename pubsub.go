@@ -0,0 +1,257 @@
+package main
+
+// SyncMapServer の Publish / Subscribe
+//
+// チャンネル名は任意の文字列で、Subscribe は path.Match 形式のパターンで受け取る。
+// NotifyKeyspaceEvents でパターンを登録しておくと、それにマッチするキーが Set / Del された時に
+//   channel: "__keyspace__:" + key   payload: "set" | "del"
+// が publish される(List の更新も "set")。
+// slave の Subscribe はリクエスト用のコネクションプールとは別の専用コネクションを使う。
+//   SUBSCRIBE packet : join(["SUBSCRIBE", joinStrs(patterns)]) -> 空の応答 -> 以後 master から join([channel, payload]) が届き続ける
+// 受け取る側が詰まっている時のメッセージは捨てる(Publish をブロックしない)。再接続中のメッセージも届かない。
+import (
+	"io"
+	"io/ioutil"
+	"log"
+	"net"
+	"path"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	KeyspaceChannelPrefix = "__keyspace__:"
+	KeyspaceEventSet      = "set"
+	KeyspaceEventDel      = "del"
+)
+
+// Subscribe 1つ毎に溜めておけるメッセージの数
+const subscriptionBufferSize = 1024
+
+type SyncMapMessage struct {
+	Channel string
+	Payload []byte
+}
+
+type SyncMapSubscription struct {
+	patterns []string
+	messages chan SyncMapMessage
+	done     chan struct{}
+	once     sync.Once
+	server   *SyncMapServer // master 上での購読なら登録先
+	mutex    sync.Mutex
+	conn     net.Conn // slave 上での購読なら専用コネクション
+	dropped  int64    // 詰まっていて捨てたメッセージの数
+}
+
+func newSyncMapSubscription(patterns []string) *SyncMapSubscription {
+	return &SyncMapSubscription{
+		patterns: patterns,
+		messages: make(chan SyncMapMessage, subscriptionBufferSize),
+		done:     make(chan struct{}),
+	}
+}
+
+// 受け取ったメッセージ。Close しても close されないので Done と一緒に select すること
+func (this *SyncMapSubscription) Messages() <-chan SyncMapMessage {
+	return this.messages
+}
+func (this *SyncMapSubscription) Done() <-chan struct{} {
+	return this.done
+}
+func (this *SyncMapSubscription) Close() {
+	this.once.Do(func() {
+		close(this.done)
+		if this.server != nil {
+			this.server.subscriptions.Delete(this)
+		}
+		this.mutex.Lock()
+		if this.conn != nil {
+			this.conn.Close()
+		}
+		this.mutex.Unlock()
+	})
+}
+func (this *SyncMapSubscription) matches(channel string) bool {
+	for _, pattern := range this.patterns {
+		if matched, _ := path.Match(pattern, channel); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// 詰まっていたら捨てる
+func (this *SyncMapSubscription) deliver(message SyncMapMessage) bool {
+	select {
+	case this.messages <- message:
+		return true
+	default:
+		return false
+	}
+}
+
+// PUBLISH : 受け取った購読の数を返す
+func (this *SyncMapServerConn) Publish(channel string, payload []byte) int {
+	if this.IsMasterServer() {
+		return this.server.publish(channel, payload)
+	}
+	return decodeInt(this.send(syncMapCommandPublish, []byte(channel), payload))
+}
+func (this *SyncMapServerConn) parsePublish(input [][]byte) []byte {
+	// input は読み込みバッファを指しているのでコピーしてから渡す
+	payload := make([]byte, len(input[2]))
+	copy(payload, input[2])
+	return encodeToBytes(this.Publish(string(input[1]), payload))
+}
+func (this *SyncMapServer) publish(channel string, payload []byte) int {
+	message := SyncMapMessage{Channel: channel, Payload: payload}
+	received := 0
+	this.subscriptions.Range(func(key, value interface{}) bool {
+		subscription := key.(*SyncMapSubscription)
+		if !subscription.matches(channel) {
+			return true
+		}
+		if subscription.deliver(message) {
+			received++
+		} else if dropped := atomic.AddInt64(&subscription.dropped, 1); dropped%1000 == 1 {
+			log.Println("SyncMapServer subscription is full. dropped:", this.masterPort, channel, dropped)
+		}
+		return true
+	})
+	return received
+}
+
+// SUBSCRIBE : patterns (path.Match 形式) にマッチするチャンネルのメッセージを受け取る
+func (this *SyncMapServerConn) Subscribe(patterns ...string) *SyncMapSubscription {
+	subscription := newSyncMapSubscription(patterns)
+	if this.IsMasterServer() {
+		subscription.server = this.server
+		this.server.subscriptions.Store(subscription, true)
+		return subscription
+	}
+	go this.server.receiveSubscription(subscription)
+	return subscription
+}
+
+// slave: 専用コネクションで受け取り続ける。切れたら繋ぎ直す
+func (this *SyncMapServer) receiveSubscription(subscription *SyncMapSubscription) {
+	for {
+		err := this.receiveSubscriptionOnce(subscription)
+		select {
+		case <-subscription.done:
+			return
+		default:
+		}
+		log.Println("SyncMapServer subscription disconnected. reconnecting:", this.substanceAddress, err)
+		time.Sleep(1 * time.Second)
+	}
+}
+func (this *SyncMapServer) receiveSubscriptionOnce(subscription *SyncMapSubscription) error {
+	conn, err := net.DialTimeout("tcp", this.substanceAddress, 3*time.Second)
+	if err != nil {
+		return err
+	}
+	subscription.mutex.Lock()
+	select {
+	case <-subscription.done:
+		subscription.mutex.Unlock()
+		conn.Close()
+		return nil
+	default:
+	}
+	subscription.conn = conn
+	subscription.mutex.Unlock()
+	defer conn.Close()
+	if err := writePacket(conn, join([][]byte{[]byte(syncMapCommandSubscribe), joinStrsToBytes(subscription.patterns)})); err != nil {
+		return err
+	}
+	if _, err := readPacket(conn); err != nil { // 購読開始の応答
+		return err
+	}
+	for {
+		packet, err := readPacket(conn)
+		if err != nil {
+			return err
+		}
+		splitted := split(packet)
+		message := SyncMapMessage{Channel: string(splitted[0]), Payload: splitted[1]}
+		select {
+		case subscription.messages <- message:
+		case <-subscription.done:
+			return nil
+		}
+	}
+}
+
+// master: SUBSCRIBE を受け取ったコネクションはこれ以降メッセージを送るだけにする
+func (this *SyncMapServer) serveSubscriber(conn net.Conn, input []byte) {
+	subscription := newSyncMapSubscription(splitBytesToStrs(split(input)[1]))
+	subscription.server = this
+	this.subscriptions.Store(subscription, true)
+	defer subscription.Close()
+	if err := writePacket(conn, []byte("")); err != nil {
+		return
+	}
+	go func() {
+		// 相手からは何も送られてこない。閉じられたら終了
+		io.Copy(ioutil.Discard, conn)
+		subscription.Close()
+	}()
+	for {
+		select {
+		case message := <-subscription.messages:
+			if err := writePacket(conn, join([][]byte{[]byte(message.Channel), message.Payload})); err != nil {
+				return
+			}
+		case <-subscription.done:
+			return
+		}
+	}
+}
+
+// readAll とは違い途中で切れてもエラーを返すだけにする(購読用のコネクションは落ちることがあるため)
+func readPacket(conn net.Conn) ([]byte, error) {
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return nil, err
+	}
+	packet := make([]byte, parse32bit(header))
+	if _, err := io.ReadFull(conn, packet); err != nil {
+		return nil, err
+	}
+	return packet, nil
+}
+func writePacket(conn net.Conn, content []byte) error {
+	_, err := conn.Write(append(format32bit(len(content)), content...))
+	return err
+}
+
+// join の先頭要素が SUBSCRIBE か (split せずに調べる)
+func isSubscribePacket(input []byte) bool {
+	if len(input) < 8 {
+		return false
+	}
+	commandLen := parse32bit(input[4:8])
+	return commandLen == len(syncMapCommandSubscribe) && len(input) >= 8+commandLen &&
+		string(input[8:8+commandLen]) == syncMapCommandSubscribe
+}
+
+// キースペース通知をするキーのパターン(path.Match 形式)を設定する。空なら通知しない。
+// 通知は master の storeDirect / deleteDirect で行うので、master 側で設定されている必要がある
+func (this *SyncMapServer) NotifyKeyspaceEvents(patterns ...string) {
+	this.keyspacePatterns.Store(patterns)
+}
+func (this *SyncMapServer) notifyKeyspaceEvent(key string, event string) {
+	patterns, ok := this.keyspacePatterns.Load().([]string)
+	if !ok || len(patterns) == 0 {
+		return
+	}
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, key); matched {
+			this.publish(KeyspaceChannelPrefix+key, []byte(event))
+			return
+		}
+	}
+}
@@ -0,0 +1,296 @@
+package main
+
+// SyncMapServer のブロッキングするリスト操作と、それを使った信頼できるキュー(ReliableQueue)
+//
+// timeout は 0 なら要素が来るまで待ち続け、負なら待たない(1回試すだけ)。
+// master 上ではキー毎の待ち合わせ用 channel を RPush / LPush / BRPopLPush が close して起こす。
+// slave からはその間 1 本のコネクションを占有するので、待つのは少数のワーカーだけにすること。
+// トランザクション中に自分がロックしているキーに対しては待たない(待つとロックを握ったままになるため)。
+import (
+	"bytes"
+	"sort"
+	"time"
+)
+
+// LPUSH :: List の先頭に要素を追加したのち最後の要素の index を返す (values は順に先頭へ積まれる)
+func (this *SyncMapServerConn) lpushImpl(key string, joinedValues []byte, needLock bool) int {
+	conn := this
+	if needLock {
+		conn = this.New()
+		conn.lockKeysDirect([]string{key})
+		defer conn.unlockKeysDirect([]string{key})
+	}
+	values := split(joinedValues)
+	list := make([][]byte, 0, len(values))
+	for i := len(values) - 1; i >= 0; i-- {
+		list = append(list, values[i])
+	}
+	if elist, ok := conn.loadDirect(key); ok {
+		list = append(list, elist.([][]byte)...)
+	}
+	conn.storeDirect(key, list)
+	this.server.wakeListWaiters(key)
+	return len(list) - 1
+}
+func (this *SyncMapServerConn) LPush(key string, values ...interface{}) int {
+	needLock := !this.myConnectionIsLocking(key)
+	joiningValues := make([][]byte, 0)
+	for _, value := range values {
+		joiningValues = append(joiningValues, this.server.encodeValue(value))
+	}
+	if this.IsMasterServer() {
+		return this.lpushImpl(key, join(joiningValues), needLock)
+	} else {
+		command := syncMapCommandLPush
+		if needLock {
+			command = syncMapCommandLPushWithLock
+		}
+		return decodeInt(this.send(command, []byte(key), join(joiningValues)))
+	}
+}
+func (this *SyncMapServerConn) parseLPush(input [][]byte) []byte {
+	return encodeToBytes(this.lpushImpl(string(input[1]), input[2], false))
+}
+func (this *SyncMapServerConn) parseLPushWithLock(input [][]byte) []byte {
+	return encodeToBytes(this.lpushImpl(string(input[1]), input[2], true))
+}
+
+// BLPOP : 先頭から取り出す。timeout までに要素が無ければ false
+func (this *SyncMapServerConn) BLPop(key string, timeout time.Duration, value interface{}) bool {
+	var encoded []byte
+	if this.IsMasterServer() {
+		encoded = this.blpopImpl(key, timeout)
+	} else {
+		encoded = this.send(syncMapCommandBLPop, []byte(key), encodeTimeout(timeout))
+	}
+	if len(encoded) == 0 {
+		return false
+	}
+	return this.server.decodeValue(key, encoded, value)
+}
+func (this *SyncMapServerConn) blpopImpl(key string, timeout time.Duration) []byte {
+	if this.myConnectionIsLocking(key) {
+		return this.popImpl(key, false, true)
+	}
+	return this.server.waitList(key, timeout, func() []byte {
+		return this.popImpl(key, true, true)
+	})
+}
+func (this *SyncMapServerConn) parseBLPop(input [][]byte) []byte {
+	return this.blpopImpl(string(input[1]), decodeTimeout(input[2]))
+}
+
+// BRPOPLPUSH : source の末尾から取り出して destination の先頭に入れる(取り出した値も返す)。
+// LPush で積んで BRPopLPush で取り出せば FIFO になる
+func (this *SyncMapServerConn) BRPopLPush(source, destination string, timeout time.Duration, value interface{}) bool {
+	var encoded []byte
+	if this.IsMasterServer() {
+		encoded = this.brpoplpushImpl(source, destination, timeout)
+	} else {
+		encoded = this.send(syncMapCommandBRPopLPush, []byte(source), []byte(destination), encodeTimeout(timeout))
+	}
+	if len(encoded) == 0 {
+		return false
+	}
+	return this.server.decodeValue(source, encoded, value)
+}
+func (this *SyncMapServerConn) brpoplpushImpl(source, destination string, timeout time.Duration) []byte {
+	keys := []string{source}
+	if destination != source {
+		keys = append(keys, destination)
+		sort.Strings(keys)
+	}
+	lockingKeys := []string{}
+	for _, key := range keys {
+		if !this.myConnectionIsLocking(key) {
+			lockingKeys = append(lockingKeys, key)
+		}
+	}
+	move := func() []byte {
+		conn := this.New()
+		conn.lockKeysDirect(lockingKeys)
+		defer conn.unlockKeysDirect(lockingKeys)
+		encoded := conn.popImpl(source, false, false)
+		if len(encoded) == 0 {
+			return encoded
+		}
+		conn.lpushImpl(destination, join([][]byte{encoded}), false)
+		return encoded
+	}
+	if len(lockingKeys) < len(keys) {
+		return move()
+	}
+	return this.server.waitList(source, timeout, move)
+}
+func (this *SyncMapServerConn) parseBRPopLPush(input [][]byte) []byte {
+	return this.brpoplpushImpl(string(input[1]), string(input[2]), decodeTimeout(input[3]))
+}
+
+// LREM : value と同じ要素を count 個削除して削除した数を返す。
+// count > 0 なら先頭から, < 0 なら末尾から, 0 なら全て。
+// 比較はエンコード後のバイト列で行うので、Push した時と同じ Codec でエンコードされる値であること
+func (this *SyncMapServerConn) LRem(key string, count int, value interface{}) int {
+	encoded := this.server.encodeValue(value)
+	needLock := !this.myConnectionIsLocking(key)
+	if this.IsMasterServer() {
+		return this.lremImpl(key, count, encoded, needLock)
+	}
+	command := syncMapCommandLRem
+	if needLock {
+		command = syncMapCommandLRemWithLock
+	}
+	return decodeInt(this.send(command, []byte(key), encodeToBytes(count), encoded))
+}
+func (this *SyncMapServerConn) lremImpl(key string, count int, encoded []byte, needLock bool) int {
+	conn := this
+	if needLock {
+		conn = this.New()
+		conn.lockKeysDirect([]string{key})
+		defer conn.unlockKeysDirect([]string{key})
+	}
+	elist, ok := conn.loadDirect(key)
+	if !ok {
+		return 0
+	}
+	list, isList := elist.([][]byte)
+	if !isList {
+		return 0
+	}
+	removed := 0
+	removing := make([]bool, len(list))
+	for i := range list {
+		index := i
+		if count < 0 {
+			index = len(list) - 1 - i
+		}
+		if count != 0 && removed >= abs(count) {
+			break
+		}
+		if bytes.Equal(list[index], encoded) {
+			removing[index] = true
+			removed++
+		}
+	}
+	if removed == 0 {
+		return 0
+	}
+	result := make([][]byte, 0, len(list)-removed)
+	for i, bs := range list {
+		if !removing[i] {
+			result = append(result, bs)
+		}
+	}
+	conn.storeDirect(key, result)
+	return removed
+}
+func (this *SyncMapServerConn) parseLRem(input [][]byte) []byte {
+	return encodeToBytes(this.lremImpl(string(input[1]), decodeInt(input[2]), input[3], false))
+}
+func (this *SyncMapServerConn) parseLRemWithLock(input [][]byte) []byte {
+	return encodeToBytes(this.lremImpl(string(input[1]), decodeInt(input[2]), input[3], true))
+}
+
+// timeout はミリ秒で送る(1ms 未満の値が 0 = 無限 にならないようにする)
+func encodeTimeout(timeout time.Duration) []byte {
+	millis := int(timeout / time.Millisecond)
+	if timeout > 0 && millis == 0 {
+		millis = 1
+	} else if timeout < 0 {
+		millis = -1
+	}
+	return encodeToBytes(millis)
+}
+func decodeTimeout(input []byte) time.Duration {
+	return time.Duration(decodeInt(input)) * time.Millisecond
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// pop が空でない値を返すか timeout するまで待つ
+func (this *SyncMapServer) waitList(key string, timeout time.Duration, pop func() []byte) []byte {
+	var timeoutChannel <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		timeoutChannel = timer.C
+	}
+	for {
+		// 先に待ち合わせ用の channel を取ってから試すことで、その間の Push を取りこぼさない
+		waiter := this.listWaiter(key)
+		if encoded := pop(); len(encoded) > 0 {
+			return encoded
+		}
+		if timeout < 0 {
+			return []byte{}
+		}
+		select {
+		case <-waiter:
+		case <-timeoutChannel:
+			return []byte{}
+		}
+	}
+}
+func (this *SyncMapServer) listWaiter(key string) chan struct{} {
+	this.listWaitersMutex.Lock()
+	defer this.listWaitersMutex.Unlock()
+	if this.listWaiters == nil {
+		this.listWaiters = map[string]chan struct{}{}
+	}
+	waiter, ok := this.listWaiters[key]
+	if !ok {
+		waiter = make(chan struct{})
+		this.listWaiters[key] = waiter
+	}
+	return waiter
+}
+func (this *SyncMapServer) wakeListWaiters(key string) {
+	this.listWaitersMutex.Lock()
+	defer this.listWaitersMutex.Unlock()
+	if waiter, ok := this.listWaiters[key]; ok {
+		delete(this.listWaiters, key)
+		close(waiter)
+	}
+}
+
+// 信頼できるキュー。Pop した要素は処理中リストに移り、Ack するまで残る。
+// 処理中に落ちた場合は Requeue で処理中リストの要素をキューに戻す
+type ReliableQueue struct {
+	conn          KeyValueStoreConn
+	key           string
+	processingKey string
+}
+
+func NewReliableQueue(conn KeyValueStoreConn, key, processingKey string) *ReliableQueue {
+	return &ReliableQueue{conn: conn, key: key, processingKey: processingKey}
+}
+func (this *ReliableQueue) Push(value interface{}) {
+	this.conn.LPush(this.key, value)
+}
+
+// timeout までに要素が無ければ false
+func (this *ReliableQueue) Pop(timeout time.Duration, value interface{}) bool {
+	return this.conn.BRPopLPush(this.key, this.processingKey, timeout, value)
+}
+func (this *ReliableQueue) Ack(value interface{}) bool {
+	return this.conn.LRem(this.processingKey, -1, value) > 0
+}
+
+// 処理中リストの要素を全てキューに戻して戻した数を返す(キューの一番後ろに並ぶ)
+func (this *ReliableQueue) Requeue() int {
+	requeued := 0
+	for {
+		// デコードせずに移すだけ
+		if !this.conn.BRPopLPush(this.processingKey, this.key, -1, nil) {
+			return requeued
+		}
+		requeued++
+	}
+}
+func (this *ReliableQueue) Len() int {
+	return this.conn.LLen(this.key)
+}
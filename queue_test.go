@@ -0,0 +1,222 @@
+package main
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func listValues(t *testing.T, conn KeyValueStoreConn, key string) []string {
+	t.Helper()
+	result := conn.LRange(key, 0, -1)
+	values := make([]string, result.Len())
+	for i := range values {
+		if err := result.Get(i, &values[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return values
+}
+
+func pushStrings(conn KeyValueStoreConn, key string, values []string) {
+	for _, value := range values {
+		conn.RPush(key, value)
+	}
+}
+
+func TestLRem(t *testing.T) {
+	tests := []struct {
+		name    string
+		count   int
+		value   string
+		removed int
+		want    []string
+	}{
+		{"first one", 1, "a", 1, []string{"b", "a", "c", "a"}},
+		{"first two", 2, "a", 2, []string{"b", "c", "a"}},
+		{"last one", -1, "a", 1, []string{"a", "b", "a", "c"}},
+		{"all", 0, "a", 3, []string{"b", "c"}},
+		{"more than exists", 10, "a", 3, []string{"b", "c"}},
+		{"not found", 0, "z", 0, []string{"a", "b", "a", "c", "a"}},
+	}
+	forEachTestConn(t, func(t *testing.T, conn *SyncMapServerConn) {
+		for _, test := range tests {
+			conn.Del("list")
+			pushStrings(conn, "list", []string{"a", "b", "a", "c", "a"})
+			if removed := conn.LRem("list", test.count, test.value); removed != test.removed {
+				t.Errorf("%s: removed %d, want %d", test.name, removed, test.removed)
+			}
+			if got := listValues(t, conn, "list"); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("%s: list = %v, want %v", test.name, got, test.want)
+			}
+		}
+		if removed := conn.LRem("no-such-list", 0, "a"); removed != 0 {
+			t.Errorf("LRem on missing key = %d", removed)
+		}
+	})
+}
+
+func TestBLPop(t *testing.T) {
+	forEachTestConn(t, func(t *testing.T, conn *SyncMapServerConn) {
+		pushStrings(conn, "list", []string{"a", "b"})
+		for _, want := range []string{"a", "b"} {
+			got := ""
+			if !conn.BLPop("list", -1, &got) || got != want {
+				t.Errorf("BLPop = %q, want %q", got, want)
+			}
+		}
+		// 負なら待たない
+		start := time.Now()
+		if conn.BLPop("list", -1, new(string)) {
+			t.Error("BLPop on empty list returned a value")
+		}
+		// timeout まで待つ
+		if conn.BLPop("list", 50*time.Millisecond, new(string)) {
+			t.Error("BLPop on empty list returned a value")
+		}
+		if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+			t.Errorf("BLPop returned after %v, want >= 50ms", elapsed)
+		}
+	})
+}
+
+// 待っている間に Push されたら起きる
+func TestBLPopWakesOnPush(t *testing.T) {
+	forEachTestConn(t, func(t *testing.T, conn *SyncMapServerConn) {
+		pusher := conn.New()
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			pusher.RPush("list", "late")
+		}()
+		got := ""
+		if !conn.BLPop("list", 5*time.Second, &got) || got != "late" {
+			t.Errorf("BLPop = %q, want late", got)
+		}
+	})
+}
+
+func TestBRPopLPush(t *testing.T) {
+	forEachTestConn(t, func(t *testing.T, conn *SyncMapServerConn) {
+		pushStrings(conn, "source", []string{"a", "b", "c"})
+		got := ""
+		if !conn.BRPopLPush("source", "destination", -1, &got) || got != "c" {
+			t.Errorf("BRPopLPush = %q, want c", got)
+		}
+		conn.BRPopLPush("source", "destination", -1, &got)
+		if want := []string{"a"}; !reflect.DeepEqual(listValues(t, conn, "source"), want) {
+			t.Errorf("source = %v, want %v", listValues(t, conn, "source"), want)
+		}
+		if want := []string{"b", "c"}; !reflect.DeepEqual(listValues(t, conn, "destination"), want) {
+			t.Errorf("destination = %v, want %v", listValues(t, conn, "destination"), want)
+		}
+		// 同じキーなら末尾を先頭に回す
+		conn.BRPopLPush("destination", "destination", -1, &got)
+		if want := []string{"c", "b"}; !reflect.DeepEqual(listValues(t, conn, "destination"), want) {
+			t.Errorf("rotated = %v, want %v", listValues(t, conn, "destination"), want)
+		}
+		if conn.BRPopLPush("empty", "destination", -1, &got) {
+			t.Error("BRPopLPush on empty list returned a value")
+		}
+	})
+}
+
+// トランザクション中に自分がロックしているキーは待たずに返す
+func TestBLPopInTransactionDoesNotWait(t *testing.T) {
+	conn := newTestSyncMapServerConn(t)
+	start := time.Now()
+	conn.Transaction("list", func(tx KeyValueStoreConn) {
+		if tx.BLPop("list", time.Second, new(string)) {
+			t.Error("BLPop on empty list returned a value")
+		}
+	})
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("BLPop in transaction waited %v", elapsed)
+	}
+}
+
+func TestReliableQueue(t *testing.T) {
+	forEachTestConn(t, func(t *testing.T, conn *SyncMapServerConn) {
+		queue := NewReliableQueue(conn, "queue", "queue:processing")
+		for _, value := range []string{"1", "2", "3"} {
+			queue.Push(value)
+		}
+		// FIFO
+		first, second := "", ""
+		if !queue.Pop(-1, &first) || first != "1" {
+			t.Fatalf("Pop = %q, want 1", first)
+		}
+		if !queue.Pop(-1, &second) || second != "2" {
+			t.Fatalf("Pop = %q, want 2", second)
+		}
+		if queue.Len() != 1 || conn.LLen("queue:processing") != 2 {
+			t.Errorf("Len = %d, processing = %d", queue.Len(), conn.LLen("queue:processing"))
+		}
+		// Ack したものは処理中から消える。2 回目は false
+		if !queue.Ack(first) {
+			t.Error("Ack returned false")
+		}
+		if queue.Ack(first) {
+			t.Error("second Ack returned true")
+		}
+		// Ack していないものは Requeue でキューの後ろに戻る
+		if n := queue.Requeue(); n != 1 {
+			t.Errorf("Requeue = %d, want 1", n)
+		}
+		if conn.LLen("queue:processing") != 0 {
+			t.Errorf("processing = %d after Requeue", conn.LLen("queue:processing"))
+		}
+		var order []string
+		for {
+			value := ""
+			if !queue.Pop(-1, &value) {
+				break
+			}
+			order = append(order, value)
+			queue.Ack(value)
+		}
+		if want := []string{"3", "2"}; !reflect.DeepEqual(order, want) {
+			t.Errorf("order after Requeue = %v, want %v", order, want)
+		}
+		if n := queue.Requeue(); n != 0 {
+			t.Errorf("Requeue after all acked = %d", n)
+		}
+	})
+}
+
+// 同時に Pop しても同じ要素を 2 回取り出さない
+func TestReliableQueueConcurrentPop(t *testing.T) {
+	master := newTestSyncMapServerConn(t)
+	queue := NewReliableQueue(master, "queue", "queue:processing")
+	const n = 100
+	for i := 0; i < n; i++ {
+		queue.Push(i)
+	}
+	var mutex sync.Mutex
+	seen := map[int]bool{}
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			worker := NewReliableQueue(newTestSlaveConn(master), "queue", "queue:processing")
+			for {
+				value := 0
+				if !worker.Pop(-1, &value) {
+					return
+				}
+				mutex.Lock()
+				if seen[value] {
+					t.Errorf("%d popped twice", value)
+				}
+				seen[value] = true
+				mutex.Unlock()
+				worker.Ack(value)
+			}
+		}()
+	}
+	wg.Wait()
+	if len(seen) != n || master.LLen("queue:processing") != 0 {
+		t.Errorf("popped %d, processing %d", len(seen), master.LLen("queue:processing"))
+	}
+}
@@ -0,0 +1,474 @@
+package main
+
+// KV と MySQL の食い違いを調べて(必要なら)直す
+//
+//	./isucari reconcile                       # 調べるだけ
+//	./isucari reconcile -repair kv-to-db      # KV に合わせて MySQL を直す
+//	./isucari reconcile -repair db-to-kv -tables items
+//
+// 定期的にも調べていて(eventServer の master の台だけ)、結果は /debug/reconcile で見られる。
+// write-behind の途中の書き込みも差分として出るので、db-to-kv の修復は write-behind が空の時にしか行わない。
+// 消す方向の修復はしない(片方にしか無いものは、もう片方に足す)。
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	reconcileInterval  = 10 * time.Minute
+	reconcileMGetChunk = 1000
+)
+
+const ( // reconcileFinding.Kind
+	findingFieldDiff       = "field_diff"       // 両方にあるが値が違う
+	findingMissingInDB     = "missing_in_db"    // KV にしか無い
+	findingMissingInKV     = "missing_in_kv"    // MySQL にしか無い
+	findingUnpersistedUser = "unpersisted_user" // 以前の postRegister で KV にだけ登録されたユーザー (migrate-users)
+)
+
+const ( // 修復の方向
+	reconcileRepairNone   = ""
+	reconcileRepairKVToDB = "kv-to-db"
+	reconcileRepairDBToKV = "db-to-kv"
+)
+
+type reconcileFinding struct {
+	Kind     string `json:"kind"`
+	Table    string `json:"table"`
+	Key      string `json:"key"`
+	Field    string `json:"field,omitempty"`
+	KV       string `json:"kv,omitempty"`
+	DB       string `json:"db,omitempty"`
+	Repaired bool   `json:"repaired"`
+}
+
+type reconcileReport struct {
+	StartedAt time.Time          `json:"started_at"`
+	Duration  string             `json:"duration"`
+	Repair    string             `json:"repair,omitempty"`
+	Counts    map[string]int     `json:"counts"` // table/kind -> 数
+	Findings  []reconcileFinding `json:"findings"`
+	Errors    []string           `json:"errors,omitempty"`
+}
+
+func (this *reconcileReport) add(finding reconcileFinding) {
+	this.Counts[finding.Table+"/"+finding.Kind]++
+	this.Findings = append(this.Findings, finding)
+}
+
+// 比べるテーブルと KV のストア。KV のキーは keyField の値
+type reconcileTarget struct {
+	table    string
+	conn     *SyncMapServerConn
+	rowType  reflect.Type
+	keyField string
+}
+
+var reconcileTargets = []reconcileTarget{
+	{"items", idToItemServer, reflect.TypeOf(Item{}), "ID"},
+	{"transaction_evidences", itemIdToTransactionEvidenceServer, reflect.TypeOf(TransactionEvidence{}), "ItemID"},
+	{"shippings", transactionEvidenceToShippingsServer, reflect.TypeOf(Shipping{}), "TransactionEvidenceID"},
+	{"payments", paymentServer, reflect.TypeOf(Payment{}), "TransactionEvidenceID"},
+}
+
+// 最後に定期実行した結果
+var lastReconcileReport struct {
+	mutex  sync.Mutex
+	report *reconcileReport
+}
+
+// tables が空なら全て (users も含む)
+func runReconcile(tables []string, repair string) *reconcileReport {
+	report := &reconcileReport{
+		StartedAt: time.Now(),
+		Repair:    repair,
+		Counts:    map[string]int{},
+		Findings:  []reconcileFinding{},
+	}
+	if repair == reconcileRepairDBToKV && writeBehindQueue.Len() > 0 {
+		report.Errors = append(report.Errors, "write-behind has backlog. skip db-to-kv repair")
+		repair = reconcileRepairNone
+	}
+	selected := func(table string) bool {
+		if len(tables) == 0 {
+			return true
+		}
+		for _, t := range tables {
+			if t == table {
+				return true
+			}
+		}
+		return false
+	}
+	for _, target := range reconcileTargets {
+		if !selected(target.table) {
+			continue
+		}
+		if err := target.reconcile(report, repair); err != nil {
+			report.Errors = append(report.Errors, target.table+": "+err.Error())
+		}
+	}
+	if selected("users") {
+		if err := reconcileUsers(report, repair); err != nil {
+			report.Errors = append(report.Errors, "users: "+err.Error())
+		}
+	}
+	report.Duration = time.Since(report.StartedAt).String()
+	return report
+}
+
+func (this reconcileTarget) reconcile(report *reconcileReport, repair string) error {
+	dbRows, err := this.loadDB()
+	if err != nil {
+		return err
+	}
+	kvRows := this.loadKV()
+	keys := make([]string, 0, len(dbRows)+len(kvRows))
+	for key := range kvRows {
+		keys = append(keys, key)
+	}
+	for key := range dbRows {
+		if _, ok := kvRows[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sortNumericKeys(keys)
+	for _, key := range keys {
+		kvRow, inKV := kvRows[key]
+		dbRow, inDB := dbRows[key]
+		switch {
+		case !inDB:
+			finding := reconcileFinding{Kind: findingMissingInDB, Table: this.table, Key: key}
+			if repair == reconcileRepairKVToDB {
+				finding.Repaired = this.logRepair(key, this.insertDB(kvRow))
+			}
+			report.add(finding)
+		case !inKV:
+			finding := reconcileFinding{Kind: findingMissingInKV, Table: this.table, Key: key}
+			if repair == reconcileRepairDBToKV {
+				finding.Repaired = this.logRepair(key, this.repairKV(key, dbRow))
+			}
+			report.add(finding)
+		default:
+			diffs := diffRowFields(kvRow, dbRow)
+			if len(diffs) == 0 {
+				continue
+			}
+			repaired := false
+			switch repair {
+			case reconcileRepairKVToDB:
+				repaired = this.logRepair(key, this.updateDB(kvRow, diffs))
+			case reconcileRepairDBToKV:
+				repaired = this.logRepair(key, this.repairKV(key, dbRow))
+			}
+			for _, diff := range diffs {
+				diff.Table, diff.Key, diff.Repaired = this.table, key, repaired
+				report.add(diff)
+			}
+		}
+	}
+	return nil
+}
+func (this reconcileTarget) logRepair(key string, err error) bool {
+	if err != nil {
+		log.Println("reconcile: repair failed", this.table, key, err)
+		return false
+	}
+	return true
+}
+
+func (this reconcileTarget) keyOf(row reflect.Value) string {
+	return strconv.FormatInt(row.FieldByName(this.keyField).Int(), 10)
+}
+func (this reconcileTarget) loadDB() (map[string]reflect.Value, error) {
+	rows := reflect.New(reflect.SliceOf(this.rowType))
+	if err := dbx.Select(rows.Interface(), "SELECT * FROM `"+this.table+"`"); err != nil {
+		return nil, err
+	}
+	result := map[string]reflect.Value{}
+	for i := 0; i < rows.Elem().Len(); i++ {
+		row := rows.Elem().Index(i)
+		result[this.keyOf(row)] = row
+	}
+	return result, nil
+}
+func (this reconcileTarget) loadKV() map[string]reflect.Value {
+	keys := this.conn.AllKeys()
+	result := map[string]reflect.Value{}
+	for start := 0; start < len(keys); start += reconcileMGetChunk {
+		chunk := keys[start:min(start+reconcileMGetChunk, len(keys))]
+		mGot := this.conn.MGet(chunk)
+		for _, key := range chunk {
+			row := reflect.New(this.rowType)
+			if mGot.Get(key, row.Interface()) {
+				result[key] = row.Elem()
+			}
+		}
+	}
+	return result
+}
+
+// MySQL の値で KV を上書きする(ロックしてから読み直し、もう同じなら書かない)
+func (this reconcileTarget) repairKV(key string, dbRow reflect.Value) error {
+	this.conn.Transaction(key, func(tx KeyValueStoreConn) {
+		current := reflect.New(this.rowType)
+		if tx.Get(key, current.Interface()) && len(diffRowFields(current.Elem(), dbRow)) == 0 {
+			return
+		}
+		tx.Set(key, dbRow.Interface())
+	})
+	return nil
+}
+func (this reconcileTarget) updateDB(kvRow reflect.Value, diffs []reconcileFinding) error {
+	sets := make([]string, 0, len(diffs))
+	args := make([]interface{}, 0, len(diffs)+1)
+	for _, diff := range diffs {
+		sets = append(sets, "`"+diff.Field+"` = ?")
+		args = append(args, kvRow.FieldByIndex(dbFieldIndex(this.rowType, diff.Field)).Interface())
+	}
+	keyField, _ := this.rowType.FieldByName(this.keyField)
+	args = append(args, kvRow.FieldByName(this.keyField).Interface())
+	_, err := dbx.Exec("UPDATE `"+this.table+"` SET "+strings.Join(sets, ", ")+" WHERE `"+keyField.Tag.Get("db")+"` = ?", args...)
+	return err
+}
+func (this reconcileTarget) insertDB(kvRow reflect.Value) error {
+	columns := []string{}
+	args := []interface{}{}
+	for i := 0; i < this.rowType.NumField(); i++ {
+		column := this.rowType.Field(i).Tag.Get("db")
+		if column == "" || column == "-" {
+			continue
+		}
+		columns = append(columns, "`"+column+"`")
+		args = append(args, kvRow.Field(i).Interface())
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", ")
+	_, err := dbx.Exec("INSERT INTO `"+this.table+"` ("+strings.Join(columns, ", ")+") VALUES ("+placeholders+")", args...)
+	return err
+}
+
+// db タグのある列を比べる。時刻は MySQL の DATETIME に合わせて秒単位で比べる
+func diffRowFields(kvRow, dbRow reflect.Value) []reconcileFinding {
+	diffs := []reconcileFinding{}
+	rowType := kvRow.Type()
+	for i := 0; i < rowType.NumField(); i++ {
+		column := rowType.Field(i).Tag.Get("db")
+		if column == "" || column == "-" {
+			continue
+		}
+		kv, db := kvRow.Field(i).Interface(), dbRow.Field(i).Interface()
+		if reconcileValueEqual(kv, db) {
+			continue
+		}
+		diffs = append(diffs, reconcileFinding{
+			Kind:  findingFieldDiff,
+			Field: column,
+			KV:    formatReconcileValue(kv),
+			DB:    formatReconcileValue(db),
+		})
+	}
+	return diffs
+}
+func reconcileValueEqual(a, b interface{}) bool {
+	switch a := a.(type) {
+	case time.Time:
+		return a.Unix() == b.(time.Time).Unix()
+	case []byte:
+		return bytes.Equal(a, b.([]byte))
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
+func formatReconcileValue(value interface{}) string {
+	switch value := value.(type) {
+	case time.Time:
+		return value.Format("2006-01-02 15:04:05")
+	case []byte:
+		return fmt.Sprintf("(%d bytes)", len(value))
+	default:
+		return fmt.Sprint(value)
+	}
+}
+func dbFieldIndex(rowType reflect.Type, column string) []int {
+	for i := 0; i < rowType.NumField(); i++ {
+		if rowType.Field(i).Tag.Get("db") == column {
+			return rowType.Field(i).Index
+		}
+	}
+	log.Panic("unknown column: ", column)
+	return nil
+}
+
+// 数字のキーは数字として並べる
+func sortNumericKeys(keys []string) {
+	sort.Slice(keys, func(i, j int) bool {
+		a, errA := strconv.ParseInt(keys[i], 10, 64)
+		b, errB := strconv.ParseInt(keys[j], 10, 64)
+		if errA != nil || errB != nil {
+			return keys[i] < keys[j]
+		}
+		return a < b
+	})
+}
+
+// ユーザーは有るか無いかだけ見る(num_sell_items などはまだ KV にしか書いていないため)。
+// KV にしか無いユーザーは別の種類として報告し、kv-to-db なら migrate-users と同じように移行する
+func reconcileUsers(report *reconcileReport, repair string) error {
+	dbIDs := []int64{}
+	if err := dbx.Select(&dbIDs, "SELECT `id` FROM `users`"); err != nil {
+		return err
+	}
+	inDB := map[string]bool{}
+	for _, id := range dbIDs {
+		inDB[strconv.FormatInt(id, 10)] = true
+	}
+	kvKeys := idToUserServer.AllKeys()
+	inKV := map[string]bool{}
+	for _, key := range kvKeys {
+		inKV[key] = true
+	}
+	sortNumericKeys(kvKeys)
+	for _, key := range kvKeys {
+		if inDB[key] {
+			continue
+		}
+		finding := reconcileFinding{Kind: findingUnpersistedUser, Table: "users", Key: key}
+		if repair == reconcileRepairKVToDB {
+			if err := migrateKVOnlyUser(key); err != nil {
+				log.Println("reconcile: repair failed users", key, err)
+			} else {
+				finding.Repaired = true
+			}
+		}
+		report.add(finding)
+	}
+	dbKeys := make([]string, 0, len(inDB))
+	for key := range inDB {
+		if !inKV[key] {
+			dbKeys = append(dbKeys, key)
+		}
+	}
+	sortNumericKeys(dbKeys)
+	for _, key := range dbKeys {
+		report.add(reconcileFinding{Kind: findingMissingInKV, Table: "users", Key: key})
+	}
+	return nil
+}
+
+func writeReconcileReport(w io.Writer, report *reconcileReport, asJSON bool) {
+	if asJSON {
+		json.NewEncoder(w).Encode(report)
+		return
+	}
+	for _, finding := range report.Findings {
+		fmt.Fprintf(w, "%s\t%s\t%s", finding.Table, finding.Key, finding.Kind)
+		if finding.Kind == findingFieldDiff {
+			fmt.Fprintf(w, "\t%s\tkv=%q\tdb=%q", finding.Field, finding.KV, finding.DB)
+		}
+		if finding.Repaired {
+			fmt.Fprint(w, "\trepaired")
+		}
+		fmt.Fprintln(w)
+	}
+	counts := make([]string, 0, len(report.Counts))
+	for key, count := range report.Counts {
+		counts = append(counts, fmt.Sprintf("%s=%d", key, count))
+	}
+	sort.Strings(counts)
+	fmt.Fprintf(w, "# %d findings in %s %s\n", len(report.Findings), report.Duration, strings.Join(counts, " "))
+	for _, err := range report.Errors {
+		fmt.Fprintln(w, "# error:", err)
+	}
+}
+
+// ./isucari reconcile [-repair kv-to-db|db-to-kv] [-tables items,...] [-json]
+func runReconcileCommand(args []string) int {
+	flags := flag.NewFlagSet("reconcile", flag.ContinueOnError)
+	repair := flags.String("repair", "", "修復する方向 ("+reconcileRepairKVToDB+"|"+reconcileRepairDBToKV+"). 空なら調べるだけ")
+	tables := flags.String("tables", "", "調べるテーブル (カンマ区切り。items,transaction_evidences,shippings,payments,users). 空なら全て")
+	asJSON := flags.Bool("json", false, "JSON で出力")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if err := validateReconcileRepair(*repair); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	configureSyncMapStores()
+	report := runReconcile(splitTables(*tables), *repair)
+	writeReconcileReport(os.Stdout, report, *asJSON)
+	if len(report.Errors) > 0 {
+		return 1
+	}
+	return 0
+}
+func validateReconcileRepair(repair string) error {
+	switch repair {
+	case reconcileRepairNone, reconcileRepairKVToDB, reconcileRepairDBToKV:
+		return nil
+	}
+	return errors.New("unknown repair direction: " + repair)
+}
+func splitTables(tables string) []string {
+	if tables == "" {
+		return nil
+	}
+	return strings.Split(tables, ",")
+}
+
+// 定期的に調べるだけ(修復はしない)。1 台で十分なので eventServer の master の台で動かす
+func startReconcileTask() {
+	if !isMasterServerIP {
+		return
+	}
+	go func() {
+		for {
+			time.Sleep(reconcileInterval)
+			report := runReconcile(nil, reconcileRepairNone)
+			lastReconcileReport.mutex.Lock()
+			lastReconcileReport.report = report
+			lastReconcileReport.mutex.Unlock()
+			log.Println("reconcile:", len(report.Findings), "findings", report.Counts, report.Errors)
+		}
+	}()
+}
+
+// GET : 最後に定期実行した結果 (まだなら 404)
+// POST : その場で実行する (?repair=kv-to-db|db-to-kv&tables=items,...)
+//
+//	curl localhost:9876/debug/reconcile
+//	curl -XPOST 'localhost:9876/debug/reconcile?repair=db-to-kv&tables=items'
+func getDebugReconcile(w http.ResponseWriter, r *http.Request) {
+	var report *reconcileReport
+	if r.Method == http.MethodPost {
+		repair := r.URL.Query().Get("repair")
+		if err := validateReconcileRepair(repair); err != nil {
+			outputErrorMsg(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		report = runReconcile(splitTables(r.URL.Query().Get("tables")), repair)
+	} else {
+		lastReconcileReport.mutex.Lock()
+		report = lastReconcileReport.report
+		lastReconcileReport.mutex.Unlock()
+		if report == nil {
+			outputErrorMsg(w, http.StatusNotFound, "not yet")
+			return
+		}
+	}
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	writeReconcileReport(w, report, true)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"time"
 
 	"github.com/go-redis/redis"
 )
@@ -80,7 +81,10 @@ func (this *RedisWrapper) Get(key string, value interface{}) bool {
 	if err != nil {
 		return false
 	}
-	decodeFromBytes(bs, value)
+	if err := decodeFromBytes(bs, value); err != nil {
+		fmt.Println("Redis Decode Error", key, err)
+		return false
+	}
 	return true
 }
 func (this *RedisWrapper) Set(key string, value interface{}) {
@@ -205,6 +209,77 @@ func (this *RedisWrapper) RPush(key string, values ...interface{}) int {
 	return int(size) - 1
 }
 
+func (this *RedisWrapper) LPush(key string, values ...interface{}) int {
+	this.SetSet()
+	encodeds := make([]interface{}, len(values))
+	for i, value := range values {
+		encodeds[i] = encodeToBytes(value)
+	}
+	var size int64
+	if this.IsTransactionNow() {
+		size = (*this.pipe).LPush(key, encodeds...).Val()
+	} else {
+		size = this.Redis.LPush(key, encodeds...).Val()
+	}
+	return int(size) - 1
+}
+func (this *RedisWrapper) LRem(key string, count int, value interface{}) int {
+	this.SetSet()
+	var removed int64
+	if this.IsTransactionNow() {
+		removed = (*this.pipe).LRem(key, int64(count), encodeToBytes(value)).Val()
+	} else {
+		removed = this.Redis.LRem(key, int64(count), encodeToBytes(value)).Val()
+	}
+	return int(removed)
+}
+
+// ブロッキングする操作はトランザクション(pipeline)中には使えない
+func (this *RedisWrapper) BLPop(key string, timeout time.Duration, value interface{}) bool {
+	if this.IsTransactionNow() {
+		log.Panic("BLPop in Transaction Error")
+	}
+	var loads string
+	if timeout < 0 {
+		res, err := this.Redis.LPop(key).Result()
+		if err != nil {
+			return false
+		}
+		loads = res
+	} else {
+		res, err := this.Redis.BLPop(timeout, key).Result()
+		if err != nil || len(res) != 2 {
+			return false
+		}
+		loads = res[1]
+	}
+	if err := decodeFromBytes([]byte(loads), value); err != nil {
+		fmt.Println("Redis Decode Error", key, err)
+		return false
+	}
+	return true
+}
+func (this *RedisWrapper) BRPopLPush(source, destination string, timeout time.Duration, value interface{}) bool {
+	if this.IsTransactionNow() {
+		log.Panic("BRPopLPush in Transaction Error")
+	}
+	var res *redis.StringCmd
+	if timeout < 0 {
+		res = this.Redis.RPopLPush(source, destination)
+	} else {
+		res = this.Redis.BRPopLPush(source, destination, timeout)
+	}
+	loads, err := res.Result()
+	if err != nil {
+		return false
+	}
+	if err := decodeFromBytes([]byte(loads), value); err != nil {
+		fmt.Println("Redis Decode Error", source, err)
+		return false
+	}
+	return true
+}
+
 // LPop
 func (this *RedisWrapper) LPop(key string, value interface{}) bool {
 	this.SetSet()
@@ -218,7 +293,10 @@ func (this *RedisWrapper) LPop(key string, value interface{}) bool {
 	if err != nil {
 		return false
 	}
-	decodeFromBytes([]byte(loads), value)
+	if err := decodeFromBytes([]byte(loads), value); err != nil {
+		fmt.Println("Redis Decode Error", key, err)
+		return false
+	}
 	return true
 }
 
@@ -235,7 +313,10 @@ func (this *RedisWrapper) RPop(key string, value interface{}) bool {
 	if err != nil {
 		return false
 	}
-	decodeFromBytes([]byte(loads), value)
+	if err := decodeFromBytes([]byte(loads), value); err != nil {
+		fmt.Println("Redis Decode Error", key, err)
+		return false
+	}
 	return true
 }
 func (this *RedisWrapper) LLen(key string) int {
@@ -260,7 +341,10 @@ func (this *RedisWrapper) LIndex(key string, index int, value interface{}) bool
 	if err != nil {
 		return false
 	}
-	decodeFromBytes([]byte(loads), value)
+	if err := decodeFromBytes([]byte(loads), value); err != nil {
+		fmt.Println("Redis Decode Error", key, err)
+		return false
+	}
 	return true
 }
 func (this *RedisWrapper) LSet(key string, index int, value interface{}) {
@@ -0,0 +1,237 @@
+package main
+
+// 取引が終わった後の評価
+//
+// 取引 (TransactionEvidence) が done になったら、購入者と出品者がそれぞれ 1 回だけ相手を評価 (1 - 5) してコメントを残せる。
+// reviewServer に "取引ID:評価した側" をキーにして保存し、評価された側の User に数と合計を足していく
+// (UserSimple の rating_count / rating_average はそこから出す)。MySQL には writeBehind で書く。
+// /users/:user_id/reviews.json は受け取った評価を getUserItems と同じ created_at / item_id のカーソルで新しい順に返す。
+import (
+	"encoding/json"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/isucon/isucon9-qualify/webapp/go/internal/kvtypes"
+	"goji.io/pat"
+)
+
+const (
+	ReviewRoleBuyer  = "buyer"  // 購入者が出品者を評価した
+	ReviewRoleSeller = "seller" // 出品者が購入者を評価した
+
+	reviewMinRating        = 1
+	reviewMaxRating        = 5
+	reviewMaxCommentLength = 1000 // 文字数
+	indexReviewsByReviewee = "reviews.reviewee"
+)
+
+type Review = kvtypes.Review
+
+type reqReview struct {
+	CSRFToken string `json:"csrf_token"`
+	ItemID    int64  `json:"item_id"`
+	Rating    int    `json:"rating"`
+	Comment   string `json:"comment"`
+}
+
+type resReview struct {
+	TransactionEvidenceID int64       `json:"transaction_evidence_id"`
+	ItemID                int64       `json:"item_id"`
+	ItemName              string      `json:"item_name"`
+	Role                  string      `json:"role"`
+	Reviewer              *UserSimple `json:"reviewer"`
+	Rating                int         `json:"rating"`
+	Comment               string      `json:"comment"`
+	CreatedAt             int64       `json:"created_at"`
+}
+
+type resUserReviews struct {
+	User    *UserSimple `json:"user"`
+	Reviews []resReview `json:"reviews"`
+	HasNext bool        `json:"has_next"`
+}
+
+// 評価の平均。小数第 2 位まで。評価が無ければ 0
+func ratingAverage(user User) float64 {
+	if user.RatingCount == 0 {
+		return 0
+	}
+	return math.Round(float64(user.RatingSum)*100/float64(user.RatingCount)) / 100
+}
+
+func reviewKey(transactionEvidenceID int64, role string) string {
+	return strconv.Itoa(int(transactionEvidenceID)) + ":" + role
+}
+
+func registerReviewIndexes() {
+	reviewServer.server.RegisterIndex(indexReviewsByReviewee, func(value interface{}) ([]string, string) {
+		review := value.(*Review)
+		return []string{strconv.Itoa(int(review.RevieweeID))}, formatTimeDateID(review.CreatedAt.Unix(), review.ItemID)
+	})
+}
+
+func postReview(w http.ResponseWriter, r *http.Request) {
+	rr := reqReview{}
+	err := json.NewDecoder(r.Body).Decode(&rr)
+	if err != nil {
+		outputErrorMsg(w, http.StatusBadRequest, "json decode error")
+		return
+	}
+	if !csrfTokenOK(r, rr.CSRFToken) {
+		outputErrorMsg(w, http.StatusUnprocessableEntity, "csrf token error")
+		return
+	}
+	user, errCode, errMsg := getUser(r)
+	if errMsg != "" {
+		outputErrorMsg(w, errCode, errMsg)
+		return
+	}
+	if rr.Rating < reviewMinRating || rr.Rating > reviewMaxRating {
+		outputErrorMsg(w, http.StatusBadRequest, "rating must be 1-5")
+		return
+	}
+	if utf8.RuneCountInString(rr.Comment) > reviewMaxCommentLength {
+		outputErrorMsg(w, http.StatusBadRequest, "コメントが長すぎます")
+		return
+	}
+	transactionEvidence := TransactionEvidence{}
+	if !itemIdToTransactionEvidenceServer.Get(strconv.Itoa(int(rr.ItemID)), &transactionEvidence) {
+		outputErrorMsg(w, http.StatusNotFound, "transaction_evidences not found")
+		return
+	}
+	review := Review{
+		TransactionEvidenceID: transactionEvidence.ID,
+		ItemID:                transactionEvidence.ItemID,
+		ItemName:              transactionEvidence.ItemName,
+		ReviewerID:            user.ID,
+		Rating:                rr.Rating,
+		Comment:               rr.Comment,
+		CreatedAt:             time.Now().Truncate(time.Second),
+	}
+	switch user.ID {
+	case transactionEvidence.BuyerID:
+		review.Role, review.RevieweeID = ReviewRoleBuyer, transactionEvidence.SellerID
+	case transactionEvidence.SellerID:
+		review.Role, review.RevieweeID = ReviewRoleSeller, transactionEvidence.BuyerID
+	default:
+		outputErrorMsg(w, http.StatusForbidden, "権限がありません")
+		return
+	}
+	if transactionEvidence.Status != TransactionEvidenceStatusDone {
+		outputErrorMsg(w, http.StatusForbidden, "取引が完了していません")
+		return
+	}
+	key := reviewKey(review.TransactionEvidenceID, review.Role)
+	created := false
+	reviewServer.Transaction(key, func(tx KeyValueStoreConn) {
+		if tx.Exists(key) {
+			outputErrorMsg(w, http.StatusForbidden, "既に評価しています")
+			return
+		}
+		tx.Set(key, review)
+		writeBehind("INSERT INTO `reviews` (`transaction_evidence_id`, `reviewer_role`, `item_id`, `item_name`, `reviewer_id`, `reviewee_id`, `rating`, `comment`, `created_at`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+			review.TransactionEvidenceID,
+			review.Role,
+			review.ItemID,
+			review.ItemName,
+			review.ReviewerID,
+			review.RevieweeID,
+			review.Rating,
+			review.Comment,
+			review.CreatedAt,
+		)
+		created = true
+	})
+	if !created {
+		return
+	}
+	revieweeIDStr := strconv.Itoa(int(review.RevieweeID))
+	idToUserServer.Transaction(revieweeIDStr, func(tx KeyValueStoreConn) {
+		reviewee := User{}
+		if !tx.Get(revieweeIDStr, &reviewee) {
+			return
+		}
+		reviewee.RatingCount++
+		reviewee.RatingSum += review.Rating
+		tx.Set(revieweeIDStr, reviewee)
+		writeBehind("UPDATE `users` SET `rating_count` = ?, `rating_sum` = ? WHERE `id` = ?",
+			reviewee.RatingCount,
+			reviewee.RatingSum,
+			reviewee.ID,
+		)
+	})
+	reviewer, _ := getUserSimpleByID(dbx, user.ID)
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	json.NewEncoder(w).Encode(newResReview(review, &reviewer))
+}
+
+func newResReview(review Review, reviewer *UserSimple) resReview {
+	return resReview{
+		TransactionEvidenceID: review.TransactionEvidenceID,
+		ItemID:                review.ItemID,
+		ItemName:              review.ItemName,
+		Role:                  review.Role,
+		Reviewer:              reviewer,
+		Rating:                review.Rating,
+		Comment:               review.Comment,
+		CreatedAt:             review.CreatedAt.Unix(),
+	}
+}
+
+func getUserReviews(w http.ResponseWriter, r *http.Request) {
+	userIDStr := pat.Param(r, "user_id")
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if err != nil || userID <= 0 {
+		outputErrorMsg(w, http.StatusBadRequest, "incorrect user id")
+		return
+	}
+
+	userSimple, err := getUserSimpleByID(dbx, userID)
+	if err != nil {
+		outputErrorMsg(w, http.StatusNotFound, "user not found")
+		return
+	}
+
+	query := r.URL.Query()
+	cursor, errMsg := parsePagingCursor(query, "item_id")
+	if errMsg != "" {
+		outputErrorMsg(w, http.StatusBadRequest, errMsg)
+		return
+	}
+	keys, err := reviewServer.IQuery(indexReviewsByReviewee, strconv.Itoa(int(userID)), cursor, ItemsPerPage+1, true)
+	if err != nil {
+		log.Print(err)
+		outputErrorMsg(w, http.StatusInternalServerError, "kvs error")
+		return
+	}
+	mGot := reviewServer.MGet(keys)
+	reviews := make([]Review, 0, len(keys))
+	for _, key := range keys {
+		review := Review{}
+		if mGot.Get(key, &review) {
+			reviews = append(reviews, review)
+		}
+	}
+	reviewerIDs := make([]string, len(reviews))
+	for i, review := range reviews {
+		reviewerIDs[i] = strconv.Itoa(int(review.ReviewerID))
+	}
+	mGotReviewers := idToUserServer.MGet(reviewerIDs)
+	res := resUserReviews{User: &userSimple, Reviews: []resReview{}}
+	for _, review := range reviews {
+		var reviewer User
+		mGotReviewers.Get(strconv.Itoa(int(review.ReviewerID)), &reviewer)
+		simpleReviewer := newUserSimple(reviewer)
+		res.Reviews = append(res.Reviews, newResReview(review, &simpleReviewer))
+	}
+	if len(res.Reviews) > ItemsPerPage {
+		res.HasNext = true
+		res.Reviews = res.Reviews[0:ItemsPerPage]
+	}
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	json.NewEncoder(w).Encode(res)
+}
@@ -0,0 +1,422 @@
+package main
+
+// サーバー側に保存するセッション
+//
+// cookie には署名したセッション ID だけを入れて、中身は sessionServer に保存する(どの台でも同じセッションを読める)。
+// 署名の鍵は環境変数 ISUCARI_SESSION_KEYS (カンマ区切り、必須。conf/isucari.env)。先頭の鍵で署名し、残りの鍵は検証にだけ使うので、
+// 新しい鍵を先頭に足して全台を再起動し、古い cookie が期限切れになったら古い鍵を外せばよい。
+// 期限切れのセッションは読む時に無視し、master で定期的に消す。
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/securecookie"
+	"github.com/gorilla/sessions"
+	"github.com/isucon/isucon9-qualify/webapp/go/internal/kvtypes"
+)
+
+const (
+	sessionMaxAge        = 86400 * 30 // 秒
+	sessionTouchInterval = time.Minute
+	sessionSweepInterval = 10 * time.Minute
+	sessionKeysEnv       = "ISUCARI_SESSION_KEYS"
+	devModeEnv           = "ISUCARI_DEV"
+	indexSessionsByUser  = "sessions.user"
+)
+
+var errSessionRevoked = errors.New("session has been revoked")
+
+// session.Values の "user_id" / "csrf_token" / "pending_2fa_user_id" / "pending_2fa_at" をフィールドにして保存する
+type serverSession kvtypes.Session
+
+func (this *serverSession) expired(now time.Time) bool {
+	return !now.Before(this.ExpiresAt)
+}
+
+// 一覧で見せる ID。セッション ID そのものは見せない
+func (this *serverSession) handle() string {
+	sum := sha256.Sum256([]byte(this.ID))
+	return hex.EncodeToString(sum[:8])
+}
+
+type kvSessionStore struct {
+	Codecs  []securecookie.Codec
+	Options *sessions.Options
+}
+
+func newKVSessionStore(keys [][]byte) *kvSessionStore {
+	keyPairs := make([][]byte, 0, len(keys)*2)
+	for _, key := range keys {
+		keyPairs = append(keyPairs, key, nil) // 署名だけ(中身は ID だけなので暗号化はしない)
+	}
+	store := &kvSessionStore{
+		Codecs: securecookie.CodecsFromPairs(keyPairs...),
+		Options: &sessions.Options{
+			Path:     "/",
+			MaxAge:   sessionMaxAge,
+			HttpOnly: true,
+		},
+	}
+	for _, codec := range store.Codecs {
+		if sc, ok := codec.(*securecookie.SecureCookie); ok {
+			sc.MaxAge(sessionMaxAge)
+		}
+	}
+	return store
+}
+
+// 鍵が無ければ起動させない。全台で同じ鍵が要るので勝手には作れず、誰でも知っている鍵で署名すると cookie を偽造できる。
+// 手元で動かす時だけ ISUCARI_DEV=1 で固定の鍵を使える
+func sessionKeysFromEnv() ([][]byte, error) {
+	keys := [][]byte{}
+	for _, key := range strings.Split(os.Getenv(sessionKeysEnv), ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, []byte(key))
+		}
+	}
+	if len(keys) == 0 {
+		if os.Getenv(devModeEnv) != "1" {
+			return nil, fmt.Errorf("%s is not set (set %s=1 to use the insecure development key)", sessionKeysEnv, devModeEnv)
+		}
+		log.Println("WARNING:", sessionKeysEnv, "is not set. using the insecure development session key")
+		keys = append(keys, []byte("abc"))
+	}
+	return keys, nil
+}
+
+func (this *kvSessionStore) Get(r *http.Request, name string) (*sessions.Session, error) {
+	return sessions.GetRegistry(r).Get(this, name)
+}
+
+// cookie が無い・壊れている・期限切れ・失効済みなら新しいセッションを返す
+func (this *kvSessionStore) New(r *http.Request, name string) (*sessions.Session, error) {
+	session := sessions.NewSession(this, name)
+	opts := *this.Options
+	session.Options = &opts
+	session.IsNew = true
+	cookie, err := r.Cookie(name)
+	if err != nil {
+		return session, nil
+	}
+	id := ""
+	if err := securecookie.DecodeMulti(name, cookie.Value, &id, this.Codecs...); err != nil {
+		return session, err
+	}
+	stored := serverSession{}
+	now := time.Now()
+	if !sessionServer.Get(id, &stored) || stored.expired(now) {
+		return session, nil
+	}
+	session.ID = id
+	session.IsNew = false
+	if stored.UserID != 0 {
+		session.Values["user_id"] = stored.UserID
+	}
+	if stored.CSRFToken != "" {
+		session.Values["csrf_token"] = stored.CSRFToken
+	}
+	if stored.PendingTwoFactorUserID != 0 {
+		session.Values["pending_2fa_user_id"] = stored.PendingTwoFactorUserID
+		session.Values["pending_2fa_at"] = stored.PendingTwoFactorAt
+	}
+	if now.Sub(stored.LastSeenAt) > sessionTouchInterval {
+		touchSession(id, now)
+	}
+	return session, nil
+}
+
+// Options.MaxAge < 0 なら消す。session.ID が空なら新しい ID で作る
+func (this *kvSessionStore) Save(r *http.Request, w http.ResponseWriter, session *sessions.Session) error {
+	if session.Options.MaxAge < 0 {
+		if session.ID != "" {
+			deleteSession(session.ID)
+		}
+		http.SetCookie(w, sessions.NewCookie(session.Name(), "", session.Options))
+		return nil
+	}
+	now := time.Now()
+	isNewID := session.ID == ""
+	if isNewID {
+		session.ID = secureRandomStr(32)
+	}
+	userID, _ := session.Values["user_id"].(int64)
+	csrfToken, _ := session.Values["csrf_token"].(string)
+	pendingUserID, _ := session.Values["pending_2fa_user_id"].(int64)
+	pendingAt, _ := session.Values["pending_2fa_at"].(int64)
+	var err error
+	sessionServer.Transaction(session.ID, func(tx KeyValueStoreConn) {
+		stored := serverSession{}
+		if !tx.Get(session.ID, &stored) {
+			if !isNewID {
+				// 読んでから保存するまでの間に失効した
+				err = errSessionRevoked
+				return
+			}
+			stored = serverSession{
+				ID:         session.ID,
+				CreatedAt:  now,
+				UserAgent:  r.UserAgent(),
+				RemoteAddr: requestRemoteAddr(r),
+			}
+		}
+		stored.UserID = userID
+		stored.CSRFToken = csrfToken
+		stored.PendingTwoFactorUserID = pendingUserID
+		stored.PendingTwoFactorAt = pendingAt
+		stored.LastSeenAt = now
+		stored.ExpiresAt = now.Add(time.Duration(session.Options.MaxAge) * time.Second)
+		tx.Set(session.ID, stored)
+	})
+	if err != nil {
+		return err
+	}
+	encoded, err := securecookie.EncodeMulti(session.Name(), session.ID, this.Codecs...)
+	if err != nil {
+		return err
+	}
+	http.SetCookie(w, sessions.NewCookie(session.Name(), encoded, session.Options))
+	return nil
+}
+
+// ログインした時に呼ぶ。ログイン前のセッション ID を使い続けないように、保存すると新しい ID になる
+func renewSession(session *sessions.Session) {
+	if session.ID != "" {
+		deleteSession(session.ID)
+		session.ID = ""
+	}
+}
+
+func touchSession(id string, now time.Time) {
+	sessionServer.Transaction(id, func(tx KeyValueStoreConn) {
+		stored := serverSession{}
+		if !tx.Get(id, &stored) {
+			return
+		}
+		stored.LastSeenAt = now
+		tx.Set(id, stored)
+	})
+}
+
+func deleteSession(id string) {
+	sessionServer.Del(id)
+}
+
+// 接続元の IP。nginx を通ってくる時は nginx が付け直す X-Real-IP を使う (conf/isucari.conf)。
+// X-Forwarded-For はクライアントがそのまま送れるので見ない。X-Real-IP も直接つながれると偽れるので、
+// 接続元が nginx のいる内側 (loopback かプライベートアドレス) の時だけ信じる
+func requestRemoteAddr(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if realIP := strings.TrimSpace(r.Header.Get("X-Real-IP")); realIP != "" && isInternalIP(net.ParseIP(host)) {
+		return realIP
+	}
+	return host
+}
+
+var internalIPNets = []*net.IPNet{
+	{IP: net.IPv4(10, 0, 0, 0), Mask: net.CIDRMask(8, 32)},
+	{IP: net.IPv4(172, 16, 0, 0), Mask: net.CIDRMask(12, 32)},
+	{IP: net.IPv4(192, 168, 0, 0), Mask: net.CIDRMask(16, 32)},
+	{IP: net.ParseIP("fc00::"), Mask: net.CIDRMask(7, 128)},
+}
+
+func isInternalIP(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	if ip.IsLoopback() {
+		return true
+	}
+	for _, ipNet := range internalIPNets {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func registerSessionIndexes() {
+	sessionServer.server.RegisterIndex(indexSessionsByUser, func(value interface{}) ([]string, string) {
+		session := value.(*serverSession)
+		if session.UserID == 0 {
+			return nil, ""
+		}
+		return []string{strconv.Itoa(int(session.UserID))}, fmt.Sprintf("%019d", session.CreatedAt.UnixNano())
+	})
+}
+
+// ユーザーの期限内のセッションを新しい順に
+func userSessions(userID int64) ([]serverSession, error) {
+	keys, err := sessionServer.IQuery(indexSessionsByUser, strconv.Itoa(int(userID)), "", 0, true)
+	if err != nil {
+		return nil, err
+	}
+	mGot := sessionServer.MGet(keys)
+	now := time.Now()
+	result := make([]serverSession, 0, len(keys))
+	for _, key := range keys {
+		session := serverSession{}
+		if !mGot.Get(key, &session) || session.expired(now) {
+			continue
+		}
+		result = append(result, session)
+	}
+	return result, nil
+}
+
+func startSessionSweeper() {
+	if !isMasterServerIP {
+		return
+	}
+	go func() {
+		for {
+			time.Sleep(sessionSweepInterval)
+			if swept := sweepExpiredSessions(); swept > 0 {
+				log.Println("sessions: swept", swept)
+			}
+		}
+	}()
+}
+func sweepExpiredSessions() int {
+	keys := sessionServer.AllKeys()
+	mGot := sessionServer.MGet(keys)
+	now := time.Now()
+	swept := 0
+	for _, key := range keys {
+		session := serverSession{}
+		if mGot.Get(key, &session) && session.expired(now) {
+			sessionServer.Del(key)
+			swept++
+		}
+	}
+	return swept
+}
+
+type resSession struct {
+	ID         string `json:"id"`
+	Current    bool   `json:"current"`
+	CreatedAt  int64  `json:"created_at"`
+	LastSeenAt int64  `json:"last_seen_at"`
+	ExpiresAt  int64  `json:"expires_at"`
+	UserAgent  string `json:"user_agent"`
+	RemoteAddr string `json:"remote_addr"`
+}
+
+type reqLogout struct {
+	CSRFToken string `json:"csrf_token"`
+}
+
+type reqRevokeSessions struct {
+	CSRFToken string `json:"csrf_token"`
+	SessionID string `json:"session_id"` // 一覧の id
+	Others    bool   `json:"others"`     // 今のセッション以外を全て
+}
+
+type resRevokeSessions struct {
+	Revoked int `json:"revoked"`
+}
+
+func postLogout(w http.ResponseWriter, r *http.Request) {
+	rl := reqLogout{}
+	err := json.NewDecoder(r.Body).Decode(&rl)
+	if err != nil {
+		outputErrorMsg(w, http.StatusBadRequest, "json decode error")
+		return
+	}
+	if rl.CSRFToken != getCSRFToken(r) {
+		outputErrorMsg(w, http.StatusUnprocessableEntity, "csrf token error")
+		return
+	}
+	session := getSession(r)
+	session.Options.MaxAge = -1
+	if err = session.Save(r, w); err != nil {
+		log.Print(err)
+		outputErrorMsg(w, http.StatusInternalServerError, "session error")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	w.Write([]byte("{}"))
+}
+
+func getSessions(w http.ResponseWriter, r *http.Request) {
+	user, errCode, errMsg := getUser(r)
+	if errMsg != "" {
+		outputErrorMsg(w, errCode, errMsg)
+		return
+	}
+	current := getSession(r)
+	stored, err := userSessions(user.ID)
+	if err != nil {
+		log.Print(err)
+		outputErrorMsg(w, http.StatusInternalServerError, "kvs error")
+		return
+	}
+	res := make([]resSession, 0, len(stored))
+	for _, s := range stored {
+		res = append(res, resSession{
+			ID:         s.handle(),
+			Current:    s.ID == current.ID,
+			CreatedAt:  s.CreatedAt.Unix(),
+			LastSeenAt: s.LastSeenAt.Unix(),
+			ExpiresAt:  s.ExpiresAt.Unix(),
+			UserAgent:  s.UserAgent,
+			RemoteAddr: s.RemoteAddr,
+		})
+	}
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	json.NewEncoder(w).Encode(res)
+}
+
+func postRevokeSessions(w http.ResponseWriter, r *http.Request) {
+	rr := reqRevokeSessions{}
+	err := json.NewDecoder(r.Body).Decode(&rr)
+	if err != nil {
+		outputErrorMsg(w, http.StatusBadRequest, "json decode error")
+		return
+	}
+	if rr.CSRFToken != getCSRFToken(r) {
+		outputErrorMsg(w, http.StatusUnprocessableEntity, "csrf token error")
+		return
+	}
+	if rr.SessionID == "" && !rr.Others {
+		outputErrorMsg(w, http.StatusBadRequest, "session_id or others is required")
+		return
+	}
+	user, errCode, errMsg := getUser(r)
+	if errMsg != "" {
+		outputErrorMsg(w, errCode, errMsg)
+		return
+	}
+	current := getSession(r)
+	stored, err := userSessions(user.ID)
+	if err != nil {
+		log.Print(err)
+		outputErrorMsg(w, http.StatusInternalServerError, "kvs error")
+		return
+	}
+	revoked := 0
+	for _, s := range stored {
+		if (rr.Others && s.ID != current.ID) || s.handle() == rr.SessionID {
+			deleteSession(s.ID)
+			revoked++
+		}
+	}
+	if rr.SessionID != "" && !rr.Others && revoked == 0 {
+		outputErrorMsg(w, http.StatusNotFound, "session not found")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	json.NewEncoder(w).Encode(resRevokeSessions{Revoked: revoked})
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"os"
+	"testing"
+)
+
+func setTestEnv(name, value string) (restore func()) {
+	saved, ok := os.LookupEnv(name)
+	os.Setenv(name, value)
+	return func() {
+		if ok {
+			os.Setenv(name, saved)
+		} else {
+			os.Unsetenv(name)
+		}
+	}
+}
+
+// 鍵が無ければ ISUCARI_DEV=1 の時以外は起動させない
+func TestSessionKeysFromEnv(t *testing.T) {
+	defer setTestEnv(sessionKeysEnv, "")()
+	defer setTestEnv(devModeEnv, "")()
+	if keys, err := sessionKeysFromEnv(); err == nil {
+		t.Errorf("no keys: got %q", keys)
+	}
+	os.Setenv(devModeEnv, "1")
+	if keys, err := sessionKeysFromEnv(); err != nil || len(keys) != 1 {
+		t.Errorf("dev: got %q, %v", keys, err)
+	}
+	os.Setenv(sessionKeysEnv, " new, old ,")
+	os.Setenv(devModeEnv, "")
+	keys, err := sessionKeysFromEnv()
+	if err != nil || len(keys) != 2 || string(keys[0]) != "new" || string(keys[1]) != "old" {
+		t.Errorf("got %q, %v", keys, err)
+	}
+}
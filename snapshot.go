@@ -0,0 +1,386 @@
+package main
+
+// SyncMapServer のスナップショット(.sm) と NDJSON での Export / Import
+//
+// .sm のフォーマット (version 2)
+//   magic "SMAP" 4B | version 2B | キー数 4B | payload 長 8B | payload の CRC32(IEEE) 4B | payload
+//   payload は [][][]byte ([key, "1", value] か [key, "2", values...]) を msgpack にしたもの
+// version 1 はヘッダなしで payload のみ。読み込みだけ対応している。
+//
+// NDJSON は 1行 1キーで、値は NewValueFunction の型でデコードして JSON にする。
+//   {"key":"1","type":"value","value":{...}}
+//   {"key":"queue","type":"list","value":[{...},{...}]}
+// `json:"-"` のフィールドを持つ型 (User の HashedPassword など) は json タグを使うと欠落するので、
+// Go のフィールド名をキーにして全てのフィールドを書く (kvtypes.ExportFields / ImportFields)。
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+
+	"github.com/isucon/isucon9-qualify/webapp/go/internal/kvtypes"
+	"github.com/shamaton/msgpack"
+)
+
+const (
+	snapshotMagic           = "SMAP"
+	snapshotVersion         = 2
+	snapshotHeaderSize      = 4 + 2 + 4 + 8 + 4
+	snapshotRecordValue     = "1"
+	snapshotRecordList      = "2"
+	SnapshotRecordTypeValue = "value"
+	SnapshotRecordTypeList  = "list"
+)
+
+var (
+	ErrSnapshotBroken      = errors.New("snapshot is broken")
+	ErrSnapshotUnsupported = errors.New("snapshot version is not supported")
+)
+
+// NDJSON の 1行
+type SnapshotRecord struct {
+	Key   string          `json:"key"`
+	Type  string          `json:"type"` // value | list
+	Value json.RawMessage `json:"value"`
+}
+
+// 一時ファイルに書いてから rename するので、書き込み途中で落ちても前のスナップショットは壊れない
+func (this *SyncMapServer) writeFile(path string) error {
+	if !this.IsMasterServer() {
+		return nil
+	}
+	// Lock が必要？
+	var result [][][]byte
+	this.SyncMap.Range(func(key, value interface{}) bool {
+		var here [][]byte
+		here = append(here, []byte(key.(string)))
+		if bs, ok := value.([]byte); ok {
+			here = append(here, []byte(snapshotRecordValue))
+			here = append(here, bs)
+		} else if bss, ok := value.([][]byte); ok {
+			here = append(here, []byte(snapshotRecordList))
+			here = append(here, bss...)
+		} else {
+			panic(nil)
+		}
+		result = append(result, here)
+		return true
+	})
+	payload, err := msgpack.Encode(result)
+	if err != nil {
+		return err
+	}
+	header := make([]byte, snapshotHeaderSize)
+	copy(header[0:4], snapshotMagic)
+	binary.LittleEndian.PutUint16(header[4:6], snapshotVersion)
+	binary.LittleEndian.PutUint32(header[6:10], uint32(len(result)))
+	binary.LittleEndian.PutUint64(header[10:18], uint64(len(payload)))
+	binary.LittleEndian.PutUint32(header[18:22], crc32.ChecksumIEEE(payload))
+
+	file, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp-")
+	if err != nil {
+		return err
+	}
+	tmpPath := file.Name()
+	_, err = file.Write(header)
+	if err == nil {
+		_, err = file.Write(payload)
+	}
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, path)
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+	}
+	return err
+}
+
+// 壊れていればエラーを返し、データはそのまま
+func (this *SyncMapServer) readFile(path string) error {
+	if !this.IsMasterServer() {
+		return nil
+	}
+	encoded, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	decoded, err := decodeSnapshot(encoded)
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	for _, here := range decoded {
+		if len(here) < 2 || (string(here[1]) == snapshotRecordValue && len(here) != 3) {
+			return fmt.Errorf("%s: %v", path, ErrSnapshotBroken)
+		}
+		if t := string(here[1]); t != snapshotRecordValue && t != snapshotRecordList {
+			return fmt.Errorf("%s: %v: unknown record type %q", path, ErrSnapshotBroken, t)
+		}
+	}
+	// Lock ?
+	conn := this.GetConn()
+	conn.FlushAll()
+	for _, here := range decoded {
+		key := string(here[0])
+		if string(here[1]) == snapshotRecordValue {
+			conn.storeDirect(key, here[2])
+		} else {
+			conn.storeDirect(key, here[2:])
+		}
+	}
+	return nil
+}
+
+func decodeSnapshot(encoded []byte) ([][][]byte, error) {
+	var decoded [][][]byte
+	if !bytes.HasPrefix(encoded, []byte(snapshotMagic)) {
+		// version 1 (ヘッダなし)
+		if err := msgpack.Decode(encoded, &decoded); err != nil {
+			return nil, fmt.Errorf("%v: %v", ErrSnapshotBroken, err)
+		}
+		return decoded, nil
+	}
+	if len(encoded) < snapshotHeaderSize {
+		return nil, ErrSnapshotBroken
+	}
+	version := binary.LittleEndian.Uint16(encoded[4:6])
+	if version != snapshotVersion {
+		return nil, fmt.Errorf("%v: %d", ErrSnapshotUnsupported, version)
+	}
+	count := binary.LittleEndian.Uint32(encoded[6:10])
+	size := binary.LittleEndian.Uint64(encoded[10:18])
+	checksum := binary.LittleEndian.Uint32(encoded[18:22])
+	payload := encoded[snapshotHeaderSize:]
+	if uint64(len(payload)) != size {
+		return nil, fmt.Errorf("%v: size %d != %d", ErrSnapshotBroken, len(payload), size)
+	}
+	if crc32.ChecksumIEEE(payload) != checksum {
+		return nil, fmt.Errorf("%v: checksum mismatch", ErrSnapshotBroken)
+	}
+	if err := msgpack.Decode(payload, &decoded); err != nil {
+		return nil, fmt.Errorf("%v: %v", ErrSnapshotBroken, err)
+	}
+	if uint32(len(decoded)) != count {
+		return nil, fmt.Errorf("%v: key count %d != %d", ErrSnapshotBroken, len(decoded), count)
+	}
+	return decoded, nil
+}
+
+// EXPORT : 全てのキーを NDJSON で書き出す(キー順)
+func (this *SyncMapServerConn) ExportNDJSON(w io.Writer) error {
+	if !this.IsMasterServer() {
+		res := split(this.send(syncMapCommandExport))
+		if _, err := w.Write(res[0]); err != nil {
+			return err
+		}
+		return decodeError(res[1])
+	}
+	keys := this.AllKeys()
+	sort.Strings(keys)
+	encoder := json.NewEncoder(w)
+	for _, key := range keys {
+		loaded, ok := this.loadDirect(key)
+		if !ok {
+			continue
+		}
+		record := SnapshotRecord{Key: key}
+		var value interface{}
+		if bs, isBytes := loaded.([]byte); isBytes {
+			record.Type = SnapshotRecordTypeValue
+			value = this.server.decodeForExport(bs)
+		} else {
+			record.Type = SnapshotRecordTypeList
+			list := loaded.([][]byte)
+			values := make([]interface{}, len(list))
+			for i, bs := range list {
+				values[i] = this.server.decodeForExport(bs)
+			}
+			value = values
+		}
+		marshaled, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("%s: %v", key, err)
+		}
+		record.Value = marshaled
+		if err := encoder.Encode(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (this *SyncMapServerConn) parseExport(input [][]byte) []byte {
+	var buf bytes.Buffer
+	err := this.ExportNDJSON(&buf)
+	return join([][]byte{buf.Bytes(), encodeError(err)})
+}
+
+// IMPORT : NDJSON を読み込む。存在するキーは上書きする
+func (this *SyncMapServerConn) ImportNDJSON(r io.Reader) (int, error) {
+	if !this.IsMasterServer() {
+		input, err := ioutil.ReadAll(r)
+		if err != nil {
+			return 0, err
+		}
+		res := split(this.send(syncMapCommandImport, input))
+		return decodeInt(res[0]), decodeError(res[1])
+	}
+	return ImportNDJSON(r, this, this.server.NewValueFunction)
+}
+func (this *SyncMapServerConn) parseImport(input [][]byte) []byte {
+	n, err := this.ImportNDJSON(bytes.NewReader(input[1]))
+	return join([][]byte{encodeToBytes(n), encodeError(err)})
+}
+
+// syncmapctl export で書き出した NDJSON を Redis に読み込む (Redis に移す時用)
+//
+//	isucari import-redis -port 8884 -redis 127.0.0.1 -db 0 users.ndjson
+//
+// 値の型は -port のストアの NewValueFunction で決まる。ファイルを省略すると標準入力から読む
+func runImportRedisCommand(args []string) int {
+	flags := flag.NewFlagSet("import-redis", flag.ContinueOnError)
+	port := flags.Int("port", 0, "NDJSON を書き出したストアのポート (値の型を決める)")
+	address := flags.String("redis", "127.0.0.1", "Redis のホスト")
+	dbNumber := flags.Int("db", 0, "Redis の DB 番号")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	configureSyncMapStores()
+	var store *SyncMapServerConn
+	for _, s := range syncMapStores() {
+		if s.server.masterPort == *port {
+			store = s
+		}
+	}
+	if store == nil {
+		fmt.Fprintf(os.Stderr, "unknown store port: %d\n", *port)
+		return 2
+	}
+	var input io.Reader = os.Stdin
+	if flags.NArg() > 0 {
+		f, err := os.Open(flags.Arg(0))
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		defer f.Close()
+		input = f
+	}
+	n, err := ImportNDJSON(input, NewRedisWrapper(*address, *dbNumber), store.server.NewValueFunction)
+	fmt.Printf("imported %d keys\n", n)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
+
+// NDJSON を任意の KeyValueStoreConn (RedisWrapper 含む) に読み込む。
+// newValue が nil か、その型で読めない値(IncrBy したカウンタなど)は汎用的に読む
+func ImportNDJSON(r io.Reader, conn KeyValueStoreConn, newValue func() interface{}) (int, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, defaultReadBufferSize), math.MaxInt32)
+	imported := 0
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		record := SnapshotRecord{}
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return imported, fmt.Errorf("line %d: %v", line, err)
+		}
+		switch record.Type {
+		case SnapshotRecordTypeValue:
+			value, err := decodeForImport(record.Value, newValue)
+			if err != nil {
+				return imported, fmt.Errorf("line %d (%s): %v", line, record.Key, err)
+			}
+			conn.Set(record.Key, value)
+		case SnapshotRecordTypeList:
+			var rawValues []json.RawMessage
+			if err := json.Unmarshal(record.Value, &rawValues); err != nil {
+				return imported, fmt.Errorf("line %d (%s): %v", line, record.Key, err)
+			}
+			values := make([]interface{}, len(rawValues))
+			for i, raw := range rawValues {
+				value, err := decodeForImport(raw, newValue)
+				if err != nil {
+					return imported, fmt.Errorf("line %d (%s)[%d]: %v", line, record.Key, i, err)
+				}
+				values[i] = value
+			}
+			conn.Del(record.Key)
+			if len(values) > 0 {
+				conn.RPush(record.Key, values...)
+			}
+		default:
+			return imported, fmt.Errorf("line %d (%s): unknown type %q", line, record.Key, record.Type)
+		}
+		imported++
+	}
+	return imported, scanner.Err()
+}
+
+func (this *SyncMapServer) decodeForExport(encoded []byte) interface{} {
+	if this.NewValueFunction != nil {
+		value := this.NewValueFunction()
+		if decodeFromBytes(encoded, value) == nil {
+			if kvtypes.JSONDropsFields(reflect.TypeOf(value)) {
+				return kvtypes.ExportFields(value)
+			}
+			return value
+		}
+	}
+	var value interface{}
+	if err := decodeFromBytes(encoded, &value); err != nil {
+		// 汎用的にデコードできないものはそのまま文字列にする
+		return string(encoded)
+	}
+	return kvtypes.NormalizeForJSON(value)
+}
+
+func decodeForImport(raw json.RawMessage, newValue func() interface{}) (interface{}, error) {
+	if newValue != nil {
+		value := newValue()
+		var err error
+		if kvtypes.JSONDropsFields(reflect.TypeOf(value)) {
+			err = kvtypes.ImportFields(raw, value)
+		} else {
+			decoder := json.NewDecoder(bytes.NewReader(raw))
+			decoder.DisallowUnknownFields()
+			err = decoder.Decode(value)
+		}
+		if err == nil {
+			// ptr のままだと Set 側で ptr として扱われるので中身を渡す
+			return reflect.ValueOf(value).Elem().Interface(), nil
+		}
+		// 構造体のストアに構造体として読めないオブジェクトが来たら、汎用的に読まずにエラーにする
+		if reflect.TypeOf(value).Elem().Kind() == reflect.Struct && bytes.HasPrefix(bytes.TrimSpace(raw), []byte("{")) {
+			return nil, err
+		}
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return kvtypes.DenormalizeFromJSON(value), nil
+}
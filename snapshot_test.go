@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// 全てのフィールドをゼロ値以外で埋める
+func fillSample(v reflect.Value, seed int) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		fillSample(v.Elem(), seed)
+	case reflect.Struct:
+		if v.Type() == reflect.TypeOf(time.Time{}) {
+			v.Set(reflect.ValueOf(time.Unix(1565575823+int64(seed), 0)))
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath == "" {
+				fillSample(v.Field(i), seed+i+1)
+			}
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(int64(seed))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(uint64(seed))
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(float64(seed) + 0.5)
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.String:
+		v.SetString("値" + strings.Repeat("x", seed))
+	case reflect.Slice:
+		slice := reflect.MakeSlice(v.Type(), 2, 2)
+		for i := 0; i < slice.Len(); i++ {
+			fillSample(slice.Index(i), seed+i+1)
+		}
+		v.Set(slice)
+	}
+}
+
+// configureSyncMapStores で型を登録した全てのストアについて、
+// Export した NDJSON を別のストアに Import して同じ値に戻ることを確かめる
+func TestSnapshotNDJSONRoundTrip(t *testing.T) {
+	configureSyncMapStores()
+	for _, store := range syncMapStores() {
+		newValue := store.server.NewValueFunction
+		if newValue == nil {
+			continue
+		}
+		typeName := reflect.TypeOf(newValue()).Elem().String()
+		src := newTestSyncMapServerConn(t)
+		src.server.NewValueFunction = newValue
+		src.server.SetCodec(store.server.GetCodec())
+		samples := make([]interface{}, 3)
+		for i := range samples {
+			sample := newValue()
+			fillSample(reflect.ValueOf(sample), i+1)
+			samples[i] = reflect.ValueOf(sample).Elem().Interface()
+		}
+		src.Set("value", samples[0])
+		src.RPush("list", samples[1], samples[2])
+
+		var exported bytes.Buffer
+		if err := src.ExportNDJSON(&exported); err != nil {
+			t.Fatalf("%s: export: %v", typeName, err)
+		}
+		dst := newTestSyncMapServerConn(t)
+		dst.server.NewValueFunction = newValue
+		dst.server.SetCodec(store.server.GetCodec())
+		n, err := dst.ImportNDJSON(bytes.NewReader(exported.Bytes()))
+		if err != nil || n != 2 {
+			t.Fatalf("%s: import = %d, %v\n%s", typeName, n, err, exported.String())
+		}
+
+		want := newValue()
+		got := newValue()
+		src.Get("value", want)
+		if !dst.Get("value", got) || !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: value = %+v, want %+v", typeName, got, want)
+		}
+		for i := 0; i < 2; i++ {
+			want := newValue()
+			got := newValue()
+			src.LIndex("list", i, want)
+			if !dst.LIndex("list", i, got) || !reflect.DeepEqual(got, want) {
+				t.Errorf("%s: list[%d] = %+v, want %+v", typeName, i, got, want)
+			}
+		}
+	}
+}
+
+// json:"-" のフィールドも書き出す
+func TestSnapshotExportKeepsHiddenFields(t *testing.T) {
+	conn := newTestSyncMapServerConn(t)
+	conn.server.NewValueFunction = func() interface{} { return &User{} }
+	conn.Set("1", User{ID: 1, AccountName: "isucon", HashedPassword: []byte("hash"), RatingCount: 3})
+	var exported bytes.Buffer
+	if err := conn.ExportNDJSON(&exported); err != nil {
+		t.Fatal(err)
+	}
+	record := SnapshotRecord{}
+	if err := json.Unmarshal(exported.Bytes(), &record); err != nil {
+		t.Fatal(err)
+	}
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(record.Value, &fields); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"HashedPassword", "RatingCount", "LastBump", "CreatedAt"} {
+		if _, ok := fields[name]; !ok {
+			t.Errorf("exported user has no %s: %s", name, record.Value)
+		}
+	}
+}
+
+func TestSnapshotImportRejectsUnknownField(t *testing.T) {
+	conn := newTestSyncMapServerConn(t)
+	conn.server.NewValueFunction = func() interface{} { return &User{} }
+	n, err := conn.ImportNDJSON(strings.NewReader(`{"key":"1","type":"value","value":{"ID":1,"Password":"x"}}` + "\n"))
+	if err == nil || n != 0 || conn.Exists("1") {
+		t.Errorf("import = %d, %v; want an error and no key", n, err)
+	}
+}
@@ -66,6 +66,52 @@ CREATE TABLE `shippings` (
   `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP
 ) ENGINE=InnoDB DEFAULT CHARACTER SET utf8mb4;
 
+DROP TABLE IF EXISTS `payments`;
+CREATE TABLE `payments` (
+  `transaction_evidence_id` bigint NOT NULL PRIMARY KEY,
+  `payment_id` varchar(191) NOT NULL,
+  `amount` int unsigned NOT NULL,
+  `status` enum('authorized', 'captured', 'refunded') NOT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARACTER SET utf8mb4;
+
+DROP TABLE IF EXISTS `reviews`;
+CREATE TABLE `reviews` (
+  `transaction_evidence_id` bigint NOT NULL,
+  `reviewer_role` enum('buyer', 'seller') NOT NULL,
+  `item_id` bigint NOT NULL,
+  `item_name` varchar(191) NOT NULL,
+  `reviewer_id` bigint NOT NULL,
+  `reviewee_id` bigint NOT NULL,
+  `rating` tinyint unsigned NOT NULL,
+  `comment` text NOT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`transaction_evidence_id`, `reviewer_role`),
+  INDEX idx_reviewee_id (`reviewee_id`, `created_at`)
+) ENGINE=InnoDB DEFAULT CHARACTER SET utf8mb4;
+
+DROP TABLE IF EXISTS `item_comments`;
+CREATE TABLE `item_comments` (
+  `id` bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `item_id` bigint NOT NULL,
+  `user_id` bigint NOT NULL,
+  `is_seller` tinyint(1) NOT NULL DEFAULT 0,
+  `body` text NOT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  INDEX idx_item_id (`item_id`, `created_at`)
+) ENGINE=InnoDB DEFAULT CHARACTER SET utf8mb4;
+
+DROP TABLE IF EXISTS `transaction_messages`;
+CREATE TABLE `transaction_messages` (
+  `id` bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `transaction_evidence_id` bigint NOT NULL,
+  `sender_id` bigint NOT NULL,
+  `body` text NOT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  INDEX idx_transaction_evidence_id (`transaction_evidence_id`, `id`)
+) ENGINE=InnoDB DEFAULT CHARACTER SET utf8mb4;
+
 DROP TABLE IF EXISTS `categories`;
 CREATE TABLE `categories` (
   `id` int unsigned NOT NULL AUTO_INCREMENT PRIMARY KEY,
@@ -3,3 +3,10 @@ use `isucari`
 
 update `items` set `timedateid` = concat(date_format(created_at, '%Y%m%d%H%i%S'), lpad(id, 8, '0'));
  alter table items add unique timedateid (timedateid);
+
+-- 取引のキャンセル (itemstatus.go)
+alter table transaction_evidences modify `status` enum('wait_shipping', 'wait_done', 'done', 'cancel') NOT NULL;
+alter table shippings modify `status` enum('initial', 'wait_pickup', 'shipping', 'done', 'cancel') NOT NULL;
+
+-- 受け取った評価の数と合計 (reviews.go)
+alter table users add `rating_count` int unsigned NOT NULL DEFAULT 0, add `rating_sum` int unsigned NOT NULL DEFAULT 0;
@@ -0,0 +1,163 @@
+package main
+
+// 取引の状態の Server-Sent Events
+//
+// /users/transactions/stream につなぐと、自分が出品 / 購入した商品・取引・配送の状態が変わる度に 1 行の JSON が届く。
+// 変わった側の台は eventServer の "user.<userId>" に publish し、各台は "user.*" を 1 つだけ購読して
+// その台につながっているクライアントに配る(別の台で起きたことも届く)。
+// 購読が詰まった時や再接続中のものは捨てられるので、クライアントはつなぎ直した時に /users/transactions.json を取り直すこと。
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	StreamEventItem     = "item"     // 出品の停止 / 再開
+	StreamEventShipping = "shipping" // ワーカーが配送状況を更新した (workers.go)
+	// 取引の操作は notifications.go の TransactionEvent* をそのまま使う
+
+	streamHeartbeatInterval = 20 * time.Second
+	streamClientBufferSize  = 16
+)
+
+type streamEvent struct {
+	Event                     string `json:"event"`
+	ItemID                    int64  `json:"item_id"`
+	ItemStatus                string `json:"item_status,omitempty"`
+	TransactionEvidenceID     int64  `json:"transaction_evidence_id,omitempty"`
+	TransactionEvidenceStatus string `json:"transaction_evidence_status,omitempty"`
+	ShippingStatus            string `json:"shipping_status,omitempty"`
+	CreatedAt                 int64  `json:"created_at"`
+}
+
+// この台につながっているクライアント。userId -> 送り先
+var streamClients struct {
+	mutex   sync.Mutex
+	clients map[int64]map[chan []byte]bool
+	once    sync.Once
+}
+
+func publishStreamEvent(userIDs []int64, event streamEvent) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		log.Println("stream:", err)
+		return
+	}
+	for _, userID := range userIDs {
+		eventServer.Publish(channelUserPrefix+strconv.Itoa(int(userID)), payload)
+	}
+}
+
+// 取引の今の状態を購入者と出品者に送る
+func publishTransactionStatus(event string, transactionEvidence TransactionEvidence) {
+	item := Item{}
+	idToItemServer.Get(strconv.Itoa(int(transactionEvidence.ItemID)), &item)
+	shipping := Shipping{}
+	transactionEvidenceToShippingsServer.Get(strconv.Itoa(int(transactionEvidence.ID)), &shipping)
+	publishStreamEvent([]int64{transactionEvidence.SellerID, transactionEvidence.BuyerID}, streamEvent{
+		Event:                     event,
+		ItemID:                    transactionEvidence.ItemID,
+		ItemStatus:                item.Status,
+		TransactionEvidenceID:     transactionEvidence.ID,
+		TransactionEvidenceStatus: transactionEvidence.Status,
+		ShippingStatus:            shipping.Status,
+		CreatedAt:                 time.Now().Unix(),
+	})
+}
+
+// 最初につながった時に、この台で "user.*" の購読を始める
+func subscribeUserStream() {
+	streamClients.once.Do(func() {
+		streamClients.clients = map[int64]map[chan []byte]bool{}
+		subscription := eventServer.Subscribe(channelUserPrefix + "*")
+		go func() {
+			for {
+				select {
+				case message := <-subscription.Messages():
+					userID, err := strconv.ParseInt(strings.TrimPrefix(message.Channel, channelUserPrefix), 10, 64)
+					if err != nil {
+						continue
+					}
+					deliverStreamEvent(userID, message.Payload)
+				case <-subscription.Done():
+					return
+				}
+			}
+		}()
+	})
+}
+
+// 詰まっているクライアントには送らない
+func deliverStreamEvent(userID int64, payload []byte) {
+	streamClients.mutex.Lock()
+	defer streamClients.mutex.Unlock()
+	for client := range streamClients.clients[userID] {
+		select {
+		case client <- payload:
+		default:
+		}
+	}
+}
+
+func addStreamClient(userID int64) chan []byte {
+	client := make(chan []byte, streamClientBufferSize)
+	streamClients.mutex.Lock()
+	defer streamClients.mutex.Unlock()
+	if streamClients.clients[userID] == nil {
+		streamClients.clients[userID] = map[chan []byte]bool{}
+	}
+	streamClients.clients[userID][client] = true
+	return client
+}
+
+func removeStreamClient(userID int64, client chan []byte) {
+	streamClients.mutex.Lock()
+	defer streamClients.mutex.Unlock()
+	delete(streamClients.clients[userID], client)
+	if len(streamClients.clients[userID]) == 0 {
+		delete(streamClients.clients, userID)
+	}
+}
+
+func getTransactionStream(w http.ResponseWriter, r *http.Request) {
+	user, errCode, errMsg := getUser(r)
+	if errMsg != "" {
+		outputErrorMsg(w, errCode, errMsg)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		outputErrorMsg(w, http.StatusInternalServerError, "streaming unsupported")
+		return
+	}
+	subscribeUserStream()
+	client := addStreamClient(user.ID)
+	defer removeStreamClient(user.ID, client)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no") // nginx でバッファさせない
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, ": connected\n\n")
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(streamHeartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case payload := <-client:
+			fmt.Fprintf(w, "data: %s\n\n", payload)
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": ping\n\n")
+		case <-r.Context().Done():
+			return
+		}
+		flusher.Flush()
+	}
+}
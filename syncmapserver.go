@@ -1,9 +1,9 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"io"
-	"io/ioutil"
 	"log"
 	"net"
 	"os"
@@ -14,6 +14,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/isucon/isucon9-qualify/webapp/go/internal/kvtypes"
 	"github.com/shamaton/msgpack"
 )
 
@@ -53,10 +54,11 @@ func GetMasterServerAddress() string {
 // SyncMapServer
 type SyncMapServer struct {
 	// データ毎に保存場所/コネクションを臨機応変に変えられるので分散しやすい.
-	SyncMap   sync.Map // string -> (byte[] | byte[][])
-	mutexMap  sync.Map // string -> *sync.Mutex
-	lockedMap sync.Map // string -> bool
-	keyCount  int32
+	SyncMap  sync.Map // string -> (byte[] | byte[][])
+	keyCount int32
+	// キー毎のロック。ロックしている人か待っている人がいる間だけ置く (lockKeysDirect)
+	keyLocksMutex sync.Mutex
+	keyLocks      map[string]*keyLock
 	// 接続情報
 	substanceAddress string
 	masterPort       int
@@ -64,11 +66,25 @@ type SyncMapServer struct {
 	connectionPool             [](*net.TCPConn)
 	connectionPoolStatus       []int
 	connectionPoolEmptyChannel chan int
-	// 関数をカスタマイズする用.強引に複数台で同期したいときに便利。
-	MySendCustomFunction func(this *SyncMapServerConn, buf []byte) []byte
+	// 名前付きの手続き(RegisterProcedure)。master 上でキーをロックして実行する
+	procedures sync.Map // string -> SyncMapProcedure
+	// Publish / Subscribe (pubsub.go)
+	subscriptions    sync.Map     // *SyncMapSubscription -> bool
+	keyspacePatterns atomic.Value // []string
+	// BLPop / BRPopLPush で待っている人を起こす用 (queue.go)
+	listWaitersMutex sync.Mutex
+	listWaiters      map[string]chan struct{}
+	// セカンダリインデックス (index.go)
+	indexesMutex sync.RWMutex
+	indexes      map[string]*syncMapIndex
 	// 初期化の方法を記す。 .Initialize  が呼ばれた時にこれで初期化する
 	// InitMarkPath(./init-) があればそれを読んで初期化関数は無視するし、なければ初期化関数を実行する。
 	InitializeFunction func()
+	// 保存している値の型 (ptr を返す)。NDJSON での Export / Import で使う。nil なら汎用的にデコードする
+	NewValueFunction func() interface{}
+	// 値のエンコード方式(internal/kvtypes)。nil なら tag 無しの msgpack。読む時は tag を見るので途中で変えてもよい。
+	// Reencode で読み書きの最中に変わるので SetCodec / GetCodec を通す
+	codec atomic.Value // valueCodecHolder (codec.go)
 }
 
 const ( // connectionPoolStatus
@@ -99,12 +115,17 @@ type KeyValueStoreConn interface { // ptr は参照を着けてLoadすること
 	FlushAll()
 	// List 関連
 	RPush(key string, values ...interface{}) int // Push後の最後の要素の index を返す
+	LPush(key string, values ...interface{}) int // Push後の最後の要素の index を返す
 	LLen(key string) int
 	LIndex(key string, index int, value interface{}) bool // ptr (キーが無ければ false)
 	LPop(key string, value interface{}) bool              // ptr (キーが無ければ false)
 	RPop(key string, value interface{}) bool              // ptr (キーが無ければ false)
 	LSet(key string, index int, value interface{})
-	LRange(key string, startIndex, stopIncludingIndex int) LRangeResult // ptr (0,-1 で全て取得可能) (負数の場合はPythonと同じような処理(stopIncludingIndexがPythonより1多い)) [a,b,c][0:-1] はPythonでは最後を含まないがこちらは含む
+	LRem(key string, count int, value interface{}) int // 削除した数を返す
+	// timeout: 0 なら無限に待つ, 負なら待たない
+	BLPop(key string, timeout time.Duration, value interface{}) bool                      // ptr (timeout したら false)
+	BRPopLPush(source, destination string, timeout time.Duration, value interface{}) bool // ptr (timeout したら false)
+	LRange(key string, startIndex, stopIncludingIndex int) LRangeResult                   // ptr (0,-1 で全て取得可能) (負数の場合はPythonと同じような処理(stopIncludingIndexがPythonより1多い)) [a,b,c][0:-1] はPythonでは最後を含まないがこちらは含む
 	// IsLocked(key string) は Redis には存在しない
 	Transaction(key string, f func(tx KeyValueStoreConn)) (isok bool)
 	TransactionWithKeys(keys []string, f func(tx KeyValueStoreConn)) (isok bool)
@@ -132,13 +153,17 @@ const ( // COMMANDS
 	syncMapCommandAllKeys = "ALLKEYS" // get all keys
 	// list (内部的に([]byte ではなく [][]byte として保存している))
 	// 順序が関係ないものに使うと吉
-	syncMapCommandRPush  = "RPUSH"  // append value to list(最初が空でも可能)
-	syncMapCommandLLen   = "LLEN"   // len of list
-	syncMapCommandLIndex = "LINDEX" // get value from list
-	syncMapCommandRPop   = "RPOP"   // pop last
-	syncMapCommandLPop   = "LPOP"   // pop head
-	syncMapCommandLSet   = "LSET"   // update value at index
-	syncMapCommandLRange = "LRANGE" // get list of range
+	syncMapCommandRPush      = "RPUSH"      // append value to list(最初が空でも可能)
+	syncMapCommandLLen       = "LLEN"       // len of list
+	syncMapCommandLIndex     = "LINDEX"     // get value from list
+	syncMapCommandRPop       = "RPOP"       // pop last
+	syncMapCommandLPop       = "LPOP"       // pop head
+	syncMapCommandLSet       = "LSET"       // update value at index
+	syncMapCommandLRange     = "LRANGE"     // get list of range
+	syncMapCommandLPush      = "LPUSH"      // prepend values to list
+	syncMapCommandLRem       = "LREM"       // remove values from list
+	syncMapCommandBLPop      = "BLPOP"      // pop head (blocking)
+	syncMapCommandBRPopLPush = "BRPOPLPUSH" // pop last and push head of another list (blocking)
 	// 特定のキーをLockする。
 	// それが解除されていれば、 特定のキーをロックする。
 	syncMapCommandLockKey     = "LL" // lock a key
@@ -146,13 +171,23 @@ const ( // COMMANDS
 	syncMapCommandIsLockedKey = "LI" // check is locked key
 	// そのほか
 	syncMapCommandFlushAll   = "FLUSHALL"
-	syncMapCommandCustom     = "CUSTOM" // custom
+	syncMapCommandCall       = "CALL"   // call registered procedure
+	syncMapCommandIQuery     = "IQUERY" // query secondary index
+	syncMapCommandPublish    = "PUBLISH"
+	syncMapCommandSubscribe  = "SUBSCRIBE" // 専用コネクションで送る
 	syncMapCommandInitialize = "INITIALIZE"
+	syncMapCommandSave       = "SAVE"     // write snapshot (path が空ならデフォルトのバックアップ先)
+	syncMapCommandLoad       = "LOAD"     // read snapshot (path が空ならデフォルトのバックアップ先)
+	syncMapCommandExport     = "EXPORT"   // dump all keys as NDJSON
+	syncMapCommandImport     = "IMPORT"   // load NDJSON (既存のキーは上書き)
+	syncMapCommandReencode   = "REENCODE" // change codec and rewrite all values
 	// check lock
 	syncMapCommandIncrByWithLock = "I_WL"
 	syncMapCommandRPushWithLock  = "RPUSH_WL"
 	syncMapCommandLPopWithLock   = "LPOP_WL"
 	syncMapCommandRPopWithLock   = "RPOP_WL"
+	syncMapCommandLPushWithLock  = "LPUSH_WL"
+	syncMapCommandLRemWithLock   = "LREM_WL"
 )
 
 // bytes utils // Connection Pool のために Contents長さを指定する変換が入る
@@ -178,7 +213,9 @@ func min(a, b int) int {
 	}
 	return b
 }
-func readAll(conn net.Conn) []byte {
+
+// 相手が接続を閉じた場合は err を返す(syncmapctl などの短命なクライアントのため)
+func readAll(conn net.Conn) ([]byte, error) {
 	contentLen := 0
 	var bufAll []byte
 	readMax := defaultReadBufferSize
@@ -191,6 +228,9 @@ func readAll(conn net.Conn) []byte {
 		readBufNum, err := conn.Read(buf)
 		if readBufNum != 4 {
 			if readBufNum == 0 {
+				if err != nil {
+					return nil, err
+				}
 				return readAll(conn)
 			} else {
 				log.Panic("too short buf : ", readBufNum)
@@ -198,7 +238,7 @@ func readAll(conn net.Conn) []byte {
 		}
 		contentLen += parse32bit(buf)
 		if contentLen == 0 {
-			return []byte("")
+			return []byte(""), nil
 		}
 		if err != nil {
 			log.Panic(err)
@@ -223,13 +263,13 @@ func readAll(conn net.Conn) []byte {
 			if currentReadLen+readBufNum != contentLen {
 				log.Panic("invalid len TCP")
 			}
-			return bufAll
+			return bufAll, nil
 		}
 	}
 	if currentReadLen != contentLen {
 		log.Panic("invalid len TPC !!")
 	}
-	return bufAll
+	return bufAll, nil
 }
 func writeAll(conn net.Conn, content []byte) {
 	contentLen := len(content)
@@ -255,12 +295,12 @@ func writeAll(conn net.Conn, content []byte) {
 // <-> []string    :: joinStrsToBytes <-> splitBytesToStrs
 // <-> string      :: byte[]()       <-> string()
 //
-// 変更できるようにpointer型で受け取ること
-func decodeFromBytes(input []byte, x interface{}) {
+// 変更できるようにpointer型で受け取ること。codec の tag があればそれでデコードする
+func decodeFromBytes(input []byte, x interface{}) error {
 	if x == nil {
-		return
+		return nil
 	}
-	msgpack.Decode(input, x)
+	return kvtypes.DecodeWithCodec(input, x)
 }
 
 // 240 - 17
@@ -338,12 +378,12 @@ func sbytes(s string) []byte {
 }
 func decodeBool(input []byte) bool {
 	result := true
-	decodeFromBytes(input, &result)
+	msgpack.Decode(input, &result)
 	return result
 }
 func decodeInt(input []byte) int {
 	result := 0
-	decodeFromBytes(input, &result)
+	msgpack.Decode(input, &result)
 	return result
 }
 
@@ -395,6 +435,18 @@ func (this *SyncMapServerConn) interpretWrapFunction(buf []byte) []byte {
 		return this.parseRPopWithLock(input)
 	case syncMapCommandLSet:
 		this.parseLSet(input)
+	case syncMapCommandLPush:
+		return this.parseLPush(input)
+	case syncMapCommandLPushWithLock:
+		return this.parseLPushWithLock(input)
+	case syncMapCommandLRem:
+		return this.parseLRem(input)
+	case syncMapCommandLRemWithLock:
+		return this.parseLRemWithLock(input)
+	case syncMapCommandBLPop:
+		return this.parseBLPop(input)
+	case syncMapCommandBRPopLPush:
+		return this.parseBRPopLPush(input)
 	case syncMapCommandLRange:
 		return this.parseLRange(input)
 	case syncMapCommandIsLockedKey:
@@ -404,12 +456,26 @@ func (this *SyncMapServerConn) interpretWrapFunction(buf []byte) []byte {
 	case syncMapCommandUnlockKey:
 		this.parseUnlockKeys(input)
 	// Custom Command
-	case syncMapCommandCustom:
-		return this.parseCustomFunction(input)
+	case syncMapCommandCall:
+		return this.parseCall(input)
+	case syncMapCommandIQuery:
+		return this.parseIQuery(input)
+	case syncMapCommandPublish:
+		return this.parsePublish(input)
 	case syncMapCommandInitialize:
 		this.Initialize()
 	case syncMapCommandFlushAll:
 		this.FlushAll()
+	case syncMapCommandSave:
+		return this.parseSave(input)
+	case syncMapCommandLoad:
+		return this.parseLoad(input)
+	case syncMapCommandExport:
+		return this.parseExport(input)
+	case syncMapCommandImport:
+		return this.parseImport(input)
+	case syncMapCommandReencode:
+		return this.parseReencode(input)
 	default:
 		panic(nil)
 	}
@@ -425,14 +491,16 @@ func (this *SyncMapServerConn) Get(key string, res interface{}) bool {
 	if len(loadedBytes) == 0 {
 		return false
 	}
-	decodeFromBytes(loadedBytes, res)
-	return true
+	return this.server.decodeValue(key, loadedBytes, res)
 }
 func (this *SyncMapServerConn) parseGet(input [][]byte) []byte {
 	key := string(input[1])
 	value, ok := this.loadDirect(key)
 	if ok {
-		return value.([]byte)
+		// リストのキーを Get された場合は存在しないものとして扱う
+		if bs, isBytes := value.([]byte); isBytes {
+			return bs
+		}
 	}
 	return []byte("")
 }
@@ -442,7 +510,7 @@ func (this *SyncMapServerConn) Set(key string, value interface{}) {
 	if this.IsMasterServer() {
 		this.storeDirectWithEncoding(key, value)
 	} else {
-		this.send(syncMapCommandSet, []byte(key), encodeToBytes(value))
+		this.send(syncMapCommandSet, []byte(key), this.server.encodeValue(value))
 	}
 }
 func (this *SyncMapServerConn) parseSet(input [][]byte) {
@@ -494,7 +562,10 @@ func (this *MGetResult) Get(key string, value interface{}) bool {
 	if !ok {
 		return false
 	}
-	decodeFromBytes(encoded, value)
+	if err := decodeFromBytes(encoded, value); err != nil {
+		log.Println("SyncMapServer decode error:", key, err)
+		return false
+	}
 	return true
 }
 
@@ -509,7 +580,7 @@ func (this *SyncMapServerConn) MSet(store map[string]interface{}) {
 		var keys []string
 		for key, value := range store {
 			keys = append(keys, key)
-			savedValues = append(savedValues, encodeToBytes(value))
+			savedValues = append(savedValues, this.server.encodeValue(value))
 		}
 		this.send(syncMapCommandMSet, joinStrsToBytes(keys), join(savedValues))
 	}
@@ -626,17 +697,19 @@ func (this *SyncMapServerConn) rpushImpl(key string, joinedValues []byte, needLo
 	elist, ok := conn.loadDirect(key)
 	if !ok { // そもそも存在しなかった時は追加
 		this.storeDirect(key, values)
+		this.server.wakeListWaiters(key)
 		return len(values) - 1
 	}
 	list := append(elist.([][]byte), values...)
 	conn.storeDirect(key, list)
+	this.server.wakeListWaiters(key)
 	return len(list) - 1
 }
 func (this *SyncMapServerConn) RPush(key string, values ...interface{}) int {
 	needLock := !this.myConnectionIsLocking(key)
 	joiningValues := make([][]byte, 0)
 	for _, value := range values {
-		joiningValues = append(joiningValues, encodeToBytes(value))
+		joiningValues = append(joiningValues, this.server.encodeValue(value))
 	}
 	if this.IsMasterServer() {
 		return this.rpushImpl(key, join(joiningValues), needLock)
@@ -662,7 +735,11 @@ func (this *SyncMapServerConn) LLen(key string) int {
 		if !ok {
 			return 0
 		}
-		return len(elist.([][]byte))
+		list, isList := elist.([][]byte)
+		if !isList {
+			return 0
+		}
+		return len(list)
 	} else {
 		return decodeInt(this.send(syncMapCommandLLen, []byte(key)))
 	}
@@ -679,21 +756,18 @@ func (this *SyncMapServerConn) LIndex(key string, index int, value interface{})
 		if !ok || index < 0 || index >= len(list) {
 			return false
 		}
-		decodeFromBytes(list[index], value)
-		return true
+		return this.server.decodeValue(key, list[index], value)
 	} else {
 		encoded := this.send(syncMapCommandLIndex, []byte(key), encodeToBytes(index))
 		if len(encoded) == 0 {
 			return false
 		}
-		decodeFromBytes(encoded, value)
-		return true
+		return this.server.decodeValue(key, encoded, value)
 	}
 }
 func (this *SyncMapServerConn) parseLIndex(input [][]byte) []byte {
 	key := string(input[1])
-	index := 0
-	decodeFromBytes(input[2], &index)
+	index := decodeInt(input[2])
 	elist, ok := this.loadDirect(key)
 	list := elist.([][]byte)
 	if !ok || index < 0 || index >= len(list) {
@@ -737,8 +811,7 @@ func (this *SyncMapServerConn) popWrap(key string, value interface{}, isPopHead
 		if len(encoded) == 0 {
 			return false
 		}
-		decodeFromBytes(encoded, value)
-		return true
+		return this.server.decodeValue(key, encoded, value)
 	} else {
 		command := ""
 		if needLock {
@@ -754,9 +827,11 @@ func (this *SyncMapServerConn) popWrap(key string, value interface{}, isPopHead
 				command = syncMapCommandRPop
 			}
 		}
-		result := false
-		decodeFromBytes(this.send(command, []byte(key)), &value)
-		return result
+		encoded := this.send(command, []byte(key))
+		if len(encoded) == 0 {
+			return false
+		}
+		return this.server.decodeValue(key, encoded, value)
 	}
 }
 func (this *SyncMapServerConn) LPop(key string, value interface{}) bool {
@@ -790,24 +865,26 @@ func (this *SyncMapServerConn) lsetImpl(key string, index int, encodedValue []by
 }
 func (this *SyncMapServerConn) LSet(key string, index int, value interface{}) {
 	if this.IsMasterServer() {
-		this.lsetImpl(key, index, encodeToBytes(value))
+		this.lsetImpl(key, index, this.server.encodeValue(value))
 	} else {
-		this.send(syncMapCommandLSet, []byte(key), encodeToBytes(index), encodeToBytes(value))
+		this.send(syncMapCommandLSet, []byte(key), encodeToBytes(index), this.server.encodeValue(value))
 	}
 }
 func (this *SyncMapServerConn) parseLSet(input [][]byte) {
-	index := 0
-	decodeFromBytes(input[2], &index)
+	index := decodeInt(input[2])
 	this.lsetImpl(string(input[1]), index, input[3])
 }
 
 // LRANGE: 範囲指定してリストを取得
 func (this *SyncMapServerConn) lrangeImpl(key string, startIndex, stopIncludingIndex int) [][]byte {
 	elist, ok := this.loadDirect(key)
-	list := elist.([][]byte)
 	if !ok {
 		return [][]byte{}
 	}
+	list, isList := elist.([][]byte)
+	if !isList {
+		return [][]byte{}
+	}
 	parse := func(i int) int {
 		if i >= 0 {
 			return i
@@ -858,11 +935,11 @@ func NewLRangeResult(input [][]byte) LRangeResult {
 }
 
 // 値は ptr で取得すること
-func (this *LRangeResult) Get(index int, value interface{}) {
+func (this *LRangeResult) Get(index int, value interface{}) error {
 	if index < 0 || index >= len(this.resultArray) {
 		log.Panic("Invalid Index For LGET")
 	}
-	decodeFromBytes(this.resultArray[index], value)
+	return decodeFromBytes(this.resultArray[index], value)
 }
 func (this *LRangeResult) Len() int {
 	return len(this.resultArray)
@@ -872,13 +949,58 @@ func (this *LRangeResult) Len() int {
 // }
 
 // トランザクション
+//
+// ロックは値とは別に持ち、使っている (ロックしている・待っている) 人を数えて 0 になったら消す。
+// 値と一緒に消すと Transaction の中で Del したキーの unlock や待っている人が別のロックを掴んでしまい、
+// 消さずに残すとセッションやログイン試行・通知のような作っては消すキーの分だけ増え続ける
+type keyLock struct {
+	mutex  sync.Mutex
+	refs   int   // keyLocksMutex で守る
+	locked int32 // atomic
+}
+
+func (this *SyncMapServer) acquireKeyLock(key string) *keyLock {
+	this.keyLocksMutex.Lock()
+	if this.keyLocks == nil {
+		this.keyLocks = map[string]*keyLock{}
+	}
+	l, ok := this.keyLocks[key]
+	if !ok {
+		l = &keyLock{}
+		this.keyLocks[key] = l
+	}
+	l.refs++
+	this.keyLocksMutex.Unlock()
+	return l
+}
+func (this *SyncMapServer) releaseKeyLock(key string) *keyLock {
+	this.keyLocksMutex.Lock()
+	defer this.keyLocksMutex.Unlock()
+	l, ok := this.keyLocks[key]
+	if !ok {
+		return nil
+	}
+	l.refs--
+	if l.refs == 0 {
+		delete(this.keyLocks, key)
+	}
+	return l
+}
+func (this *SyncMapServer) keyLockCount() int {
+	this.keyLocksMutex.Lock()
+	defer this.keyLocksMutex.Unlock()
+	return len(this.keyLocks)
+}
+
 func (this *SyncMapServerConn) IsLockedKey(key string) bool {
 	if this.IsMasterServer() {
-		locked, ok := this.server.lockedMap.Load(key)
+		this.server.keyLocksMutex.Lock()
+		defer this.server.keyLocksMutex.Unlock()
+		l, ok := this.server.keyLocks[key]
 		if !ok {
 			return false // 存在しない == ロックされていない
 		}
-		return locked.(bool)
+		return atomic.LoadInt32(&l.locked) == 1
 	} else {
 		return decodeBool(this.send(syncMapCommandIsLockedKey, []byte(key)))
 	}
@@ -891,16 +1013,9 @@ func (this *SyncMapServerConn) lockKeysDirect(keys []string) {
 	// キーはソート済みを想定
 	this.lockedKeys = keys
 	for _, key := range keys {
-		m, ok := this.server.mutexMap.Load(key)
-		if !ok {
-			this.registLockDirectWhenItWontExists(key)
-			m, ok = this.server.mutexMap.Load(key)
-			if !ok {
-				log.Panic("存在しないキーへのロックに失敗しました")
-			}
-		}
-		m.(*sync.Mutex).Lock()
-		this.server.lockedMap.Store(key, true)
+		l := this.server.acquireKeyLock(key)
+		l.mutex.Lock()
+		atomic.StoreInt32(&l.locked, 1)
 	}
 }
 
@@ -908,12 +1023,13 @@ func (this *SyncMapServerConn) unlockKeysDirect(keys []string) {
 	// キーはソート済みを想定
 	for i := len(keys) - 1; i >= 0; i-- {
 		key := keys[i]
-		m, ok := this.server.mutexMap.Load(key)
-		if !ok {
+		// 他に待っている人がいれば refs が残るので、Unlock する前に消えることはない
+		l := this.server.releaseKeyLock(key)
+		if l == nil {
 			log.Panic("存在しないキー" + key + "へのアンロックが掛かりました")
 		}
-		this.server.lockedMap.Store(key, false)
-		m.(*sync.Mutex).Unlock()
+		atomic.StoreInt32(&l.locked, 0)
+		l.mutex.Unlock()
 	}
 	this.lockedKeys = []string{}
 }
@@ -950,37 +1066,70 @@ func (this *SyncMapServerConn) parseUnlockKeys(input [][]byte) {
 	this.unlockKeysDirect(keys)
 }
 
-// 自作関数を使用する時用
-func DefaultSendCustomFunction(this *SyncMapServerConn, buf []byte) []byte {
-	return buf // echo server
+// 名前付きの手続き。master 上で keys を全てロックした状態で実行されるので、
+// loadDirect / storeDirect (や conn.Get / conn.Set) を使って「on_sale なら trading にする」のような
+// 複数の操作を1往復で原子的に行える。conn は keys をロック中として扱うので RPush 等も二重にロックしない。
+// 返り値の error は文字列として呼び出し側に返る
+type SyncMapProcedure func(conn *SyncMapServerConn, keys []string, args [][]byte) ([]byte, error)
+
+var ErrProcedureNotFound = errors.New("syncmap: procedure not found")
+
+// 起動時に全てのサーバーで(どれが master になっても良いように)同じものを登録しておくこと
+func (this *SyncMapServer) RegisterProcedure(name string, procedure SyncMapProcedure) {
+	this.procedures.Store(name, procedure)
 }
-func (this *SyncMapServerConn) CustomFunction(f func() []byte) []byte {
+func registerDefaultProcedures(server *SyncMapServer) {
+	server.RegisterProcedure("echo", func(conn *SyncMapServerConn, keys []string, args [][]byte) ([]byte, error) {
+		return join(args), nil
+	})
+}
+
+// CALL : 登録済みの手続きを名前で呼ぶ。トランザクション中に既にロックしているキーは二重にロックしない
+func (this *SyncMapServerConn) Call(name string, keys []string, args ...[]byte) ([]byte, error) {
+	sortedKeys := make([]string, len(keys))
+	copy(sortedKeys, keys)
+	sort.Strings(sortedKeys)
+	lockingKeys := []string{}
+	for _, key := range sortedKeys {
+		if !this.myConnectionIsLocking(key) {
+			lockingKeys = append(lockingKeys, key)
+		}
+	}
 	if this.IsMasterServer() {
-		return this.server.MySendCustomFunction(this, f())
-	} else {
-		return this.send(syncMapCommandCustom, f())
+		return this.callImpl(name, sortedKeys, lockingKeys, args)
 	}
+	res := split(this.send(syncMapCommandCall, []byte(name), joinStrsToBytes(sortedKeys), joinStrsToBytes(lockingKeys), join(args)))
+	return res[0], decodeError(res[1])
 }
-func (this *SyncMapServerConn) parseCustomFunction(input [][]byte) []byte {
-	return this.CustomFunction(func() []byte { return input[1] })
+func (this *SyncMapServerConn) callImpl(name string, keys, lockingKeys []string, args [][]byte) ([]byte, error) {
+	procedure, ok := this.server.procedures.Load(name)
+	if !ok {
+		return nil, fmt.Errorf("%v: %s", ErrProcedureNotFound, name)
+	}
+	conn := this.New()
+	conn.lockKeysDirect(lockingKeys)
+	defer conn.unlockKeysDirect(lockingKeys)
+	conn.lockedKeys = keys
+	return procedure.(SyncMapProcedure)(conn, keys, args)
+}
+func (this *SyncMapServerConn) parseCall(input [][]byte) []byte {
+	result, err := this.callImpl(string(input[1]), splitBytesToStrs(input[2]), splitBytesToStrs(input[3]), split(input[4]))
+	return join([][]byte{result, encodeError(err)})
 }
 
 // 全ての要素を削除する
 func (this *SyncMapServerConn) FlushAll() {
 	if this.IsMasterServer() {
-		var syncMap sync.Map
-		this.server.SyncMap = syncMap
-		var mutexMap sync.Map
-		this.server.mutexMap = mutexMap
-		var lockedMap sync.Map
-		this.server.lockedMap = lockedMap
+		// ロックは使っている人がいなくなれば消えるので、ロック中のものを消さないように残す
+		this.server.SyncMap = sync.Map{}
 		this.server.keyCount = 0
+		this.server.clearIndexes()
 	} else {
 		this.send(syncMapCommandFlushAll)
 	}
 }
 
-//  SyncMap で使用する関数
+// SyncMap で使用する関数
 func (this *SyncMapServer) GetConn() *SyncMapServerConn {
 	return &SyncMapServerConn{
 		server:              this,
@@ -991,12 +1140,10 @@ func NewSyncMapServerConn(substanceAddress string, isMaster bool) *SyncMapServer
 	if isMaster {
 		port, _ := strconv.Atoi(strings.Split(substanceAddress, ":")[1])
 		result := newMasterSyncMapServer(port)
-		result.MySendCustomFunction = DefaultSendCustomFunction
 		result.InitializeFunction = func() {}
 		return result.GetConn()
 	} else {
 		result := newSlaveSyncMapServer(substanceAddress)
-		result.MySendCustomFunction = DefaultSendCustomFunction
 		result.InitializeFunction = func() {}
 		return result.GetConn()
 	}
@@ -1008,7 +1155,7 @@ func (this *SyncMapServerConn) New() *SyncMapServerConn {
 	}
 }
 
-func (this SyncMapServer) IsMasterServer() bool {
+func (this *SyncMapServer) IsMasterServer() bool {
 	return len(this.substanceAddress) == 0
 }
 func (this *SyncMapServerConn) IsMasterServer() bool {
@@ -1039,22 +1186,31 @@ func newMasterSyncMapServer(port int) *SyncMapServer {
 				continue
 			}
 			go func() {
+				// PoolするのでconnectionはCloseさせない。
+				// 相手が閉じた時だけこちらも閉じる
+				defer conn.Close()
 				for {
-					read := readAll(conn)
+					read, err := readAll(conn)
+					if err != nil {
+						return
+					}
+					if isSubscribePacket(read) {
+						this.serveSubscriber(conn, read)
+						return
+					}
 					interpreted := serverConn.interpretWrapFunction(read)
 					writeAll(conn, interpreted)
 				}
-				// PoolするのでconnectionはCloseさせない。
-				// conn.Close()
 			}()
 		}
 	}()
 	// 起動終了までちょっと時間がかかるかもしれないので待機しておく
 	time.Sleep(10 * time.Millisecond)
-	// 何も設定しなければecho
-	this.MySendCustomFunction = DefaultSendCustomFunction
-	// バックアップファイルが見つかればそれを読み込む
-	this.readFile(this.getDefaultPath())
+	registerDefaultProcedures(&this)
+	// バックアップファイルが見つかればそれを読み込む(壊れていれば読み込まずにログに残す)
+	if err := this.readFile(this.getDefaultPath()); err != nil && !os.IsNotExist(err) {
+		log.Println("SyncMapServer backup is broken:", this.getDefaultPath(), err)
+	}
 	// バックアッププロセスを開始する
 	this.startBackUpProcess()
 	return &this
@@ -1067,7 +1223,7 @@ func newSlaveSyncMapServer(substanceAddress string) *SyncMapServer {
 		panic(err)
 	}
 	this.masterPort = port
-	this.MySendCustomFunction = DefaultSendCustomFunction
+	registerDefaultProcedures(&this)
 	this.connectionPool = make([]*net.TCPConn, maxSyncMapServerConnectionNum)
 	this.connectionPoolStatus = make([]int, maxSyncMapServerConnectionNum)
 	this.connectionPoolEmptyChannel = make(chan int, maxSyncMapServerConnectionNum)
@@ -1081,66 +1237,73 @@ func newSlaveSyncMapServer(substanceAddress string) *SyncMapServer {
 func (this *SyncMapServer) getDefaultPath() string {
 	return SyncMapBackUpPath + strconv.Itoa(this.masterPort) + ".sm"
 }
-func (this *SyncMapServer) writeFile(path string) {
-	if !this.IsMasterServer() {
-		return
-	}
-	// Lock が必要？
-	var result [][][]byte
-	this.SyncMap.Range(func(key, value interface{}) bool {
-		var here [][]byte
-		here = append(here, []byte(key.(string)))
-		if bs, ok := value.([]byte); ok {
-			here = append(here, []byte("1"))
-			here = append(here, bs)
-		} else if bss, ok := value.([][]byte); ok {
-			here = append(here, []byte("2"))
-			here = append(here, bss...)
-		} else {
-			panic(nil)
+func (this *SyncMapServer) startBackUpProcess() {
+	go func() {
+		time.Sleep(time.Duration(DefaultBackUpTimeSecond) * time.Second)
+		if err := this.writeFile(this.getDefaultPath()); err != nil {
+			log.Println("SyncMapServer backup error:", this.masterPort, err)
 		}
-		result = append(result, here)
-		return true
-	})
-	file, err := os.Create(path)
+	}()
+}
+
+// SAVE / LOAD : スナップショットを手動で書き出す / 読み込む。path が空ならデフォルトのバックアップ先。
+// ネットワーク越しに受け取るのはファイル名だけで、SyncMapBackUpPath + name に読み書きする(任意のパスは触らせない)
+func (this *SyncMapServerConn) Save(path string) error {
+	if this.IsMasterServer() {
+		if path == "" {
+			path = this.server.getDefaultPath()
+		}
+		return this.server.writeFile(path)
+	}
+	return decodeError(this.send(syncMapCommandSave, []byte(path)))
+}
+func (this *SyncMapServerConn) parseSave(input [][]byte) []byte {
+	path, err := snapshotPathFromName(string(input[1]))
 	if err != nil {
-		panic(err)
+		return encodeError(err)
 	}
-	defer file.Close()
-	file.Write(encodeToBytes(result))
+	return encodeError(this.Save(path))
 }
-func (this *SyncMapServer) readFile(path string) error {
-	if !this.IsMasterServer() {
-		return nil
+func (this *SyncMapServerConn) Load(path string) error {
+	if this.IsMasterServer() {
+		if path == "" {
+			path = this.server.getDefaultPath()
+		}
+		return this.server.readFile(path)
 	}
-	// Lock ?
-	encoded, err := ioutil.ReadFile(path)
+	return decodeError(this.send(syncMapCommandLoad, []byte(path)))
+}
+func (this *SyncMapServerConn) parseLoad(input [][]byte) []byte {
+	path, err := snapshotPathFromName(string(input[1]))
 	if err != nil {
-		return err
-	}
-	// 読み込めなければデータはそのまま
-	conn := this.GetConn()
-	conn.FlushAll()
-	var decoded [][][]byte
-	decodeFromBytes(encoded, &decoded)
-	for _, here := range decoded {
-		key := string(here[0])
-		t := string(here[1])
-		if strings.Compare(t, "1") == 0 {
-			conn.storeDirect(key, here[2])
-		} else if strings.Compare(t, "2") == 0 {
-			conn.storeDirect(key, here[2:])
-		} else {
-			panic(nil)
-		}
+		return encodeError(err)
 	}
-	return nil
+	return encodeError(this.Load(path))
 }
-func (this *SyncMapServer) startBackUpProcess() {
-	go func() {
-		time.Sleep(time.Duration(DefaultBackUpTimeSecond) * time.Second)
-		this.writeFile(this.getDefaultPath())
-	}()
+
+// 空ならデフォルトのまま。区切り文字を含むものや . / .. は受け付けない
+func snapshotPathFromName(name string) (string, error) {
+	if name == "" {
+		return "", nil
+	}
+	if name == "." || name == ".." || strings.ContainsAny(name, "/\\") {
+		return "", fmt.Errorf("snapshot name must not be a path: %q", name)
+	}
+	return SyncMapBackUpPath + name, nil
+}
+
+// エラーは文字列にして返す。空なら成功
+func encodeError(err error) []byte {
+	if err == nil {
+		return []byte("")
+	}
+	return []byte(err.Error())
+}
+func decodeError(input []byte) error {
+	if len(input) == 0 {
+		return nil
+	}
+	return errors.New(string(input))
 }
 
 // 初期化データがあればそれをロード。なければ初期化の方法を書く
@@ -1154,12 +1317,17 @@ func (this *SyncMapServerConn) Initialize() {
 		if err == nil { // 読み込めたので何もしない
 			return
 		}
+		if !os.IsNotExist(err) {
+			log.Println("SyncMapServer init data is broken:", path, err)
+		}
 		log.Println("INIT 4:", this.DBSize())
 		this.FlushAll()
 		log.Println("INIT 5:", this.DBSize())
 		this.server.InitializeFunction()
 		log.Println("INIT 6:", this.DBSize())
-		this.server.writeFile(path)
+		if err := this.server.writeFile(path); err != nil {
+			log.Println("SyncMapServer init data write error:", path, err)
+		}
 		log.Println("INIT 7:", this.DBSize())
 	} else {
 		this.send(syncMapCommandInitialize)
@@ -1170,16 +1338,34 @@ func (this *SyncMapServerConn) Initialize() {
 // 自身の SyncMapからLoad / 変更できるようにpointer型で受け取ること
 func (this *SyncMapServerConn) loadDirectWithDecoding(key string, res interface{}) bool {
 	value, ok := this.loadDirect(key)
-	if ok {
-		decodeFromBytes(value.([]byte), res)
+	if !ok {
+		return false
 	}
-	return ok
+	return this.server.decodeValue(key, value.([]byte), res)
 }
 func (this *SyncMapServerConn) storeDirectWithEncoding(key string, value interface{}) {
-	encoded := encodeToBytes(value)
+	encoded := this.server.encodeValue(value)
 	this.storeDirect(key, encoded)
 }
 
+// 値を このストアの Codec でエンコード / デコードする。
+// デコードに失敗したら(壊れている・型が違う) ログに残して存在しないものとして扱う
+func (this *SyncMapServer) encodeValue(value interface{}) []byte {
+	encoded, err := kvtypes.EncodeWithCodec(this.GetCodec(), value)
+	if err != nil {
+		log.Println("SyncMapServer encode error:", this.masterPort, err)
+		return encodeToBytes(value)
+	}
+	return encoded
+}
+func (this *SyncMapServer) decodeValue(key string, encoded []byte, res interface{}) bool {
+	if err := decodeFromBytes(encoded, res); err != nil {
+		log.Println("SyncMapServer decode error:", this.masterPort, key, err)
+		return false
+	}
+	return true
+}
+
 // 集約させておくことで後で便利にする
 func (this *SyncMapServerConn) loadDirect(key string) (interface{}, bool) {
 	x, ok := this.server.SyncMap.Load(key)
@@ -1190,29 +1376,28 @@ func (this *SyncMapServerConn) loadDirect(key string) (interface{}, bool) {
 func (this *SyncMapServerConn) storeDirect(key string, value interface{}) {
 	_, exists := this.server.SyncMap.Load(key)
 	if !exists {
-		this.registLockDirectWhenItWontExists(key)
 		atomic.AddInt32(&this.server.keyCount, 1)
 	}
-	this.server.SyncMap.Store(key, value)
+	if this.server.hasIndexes() {
+		this.server.storeWithIndexes(key, value)
+	} else {
+		this.server.SyncMap.Store(key, value)
+	}
+	this.server.notifyKeyspaceEvent(key, KeyspaceEventSet)
 }
 func (this *SyncMapServerConn) deleteDirect(key string) {
 	_, exists := this.server.SyncMap.Load(key)
 	if !exists {
 		return
 	}
-	this.server.SyncMap.Delete(key)
-	this.server.mutexMap.Delete(key)
-	this.server.lockedMap.Delete(key)
-	atomic.AddInt32(&this.server.keyCount, -1)
-}
-
-// キーが存在しない可能性が高い時にキーを追加する
-func (this *SyncMapServerConn) registLockDirectWhenItWontExists(key string) {
-	var m sync.Mutex
-	_, loaded := this.server.mutexMap.LoadOrStore(key, &m)
-	if !loaded {
-		this.server.lockedMap.Store(key, false)
+	if this.server.hasIndexes() {
+		this.server.deleteWithIndexes(key)
+	} else {
+		this.server.SyncMap.Delete(key)
 	}
+	// ロックは消さない。使っている人がいなくなった時に unlockKeysDirect で消える
+	atomic.AddInt32(&this.server.keyCount, -1)
+	this.server.notifyKeyspaceEvent(key, KeyspaceEventDel)
 }
 
 // IsLocked とは違って自身がそれをロックしているかどうかを調べる
@@ -1274,7 +1459,10 @@ func (this *SyncMapServerConn) sendBySlave(command string, packet []byte, rawPac
 		conn = newConn
 	}
 	writeAll(conn, packet)
-	result := readAll(conn)
+	result, err := readAll(conn)
+	if err != nil {
+		log.Panic(err)
+	}
 	this.server.connectionPool[poolIndex] = conn
 	this.server.connectionPoolStatus[poolIndex] = ConnectionPoolStatusEmpty
 	if command == syncMapCommandLockKey {
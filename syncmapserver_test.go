@@ -0,0 +1,112 @@
+package main
+
+import (
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// テスト用の master を空いているポートで立てる。
+// バックアップは DefaultBackUpTimeSecond 後にしか書かないので、テスト中にファイルは作られない
+func newTestSyncMapServerConn(t testing.TB) *SyncMapServerConn {
+	t.Helper()
+	listener, err := net.Listen("tcp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+	return NewSyncMapServerConn("127.0.0.1:"+strconv.Itoa(port), true)
+}
+
+// master につなぐ slave (コマンドが TCP を通る方)
+func newTestSlaveConn(master *SyncMapServerConn) *SyncMapServerConn {
+	return NewSyncMapServerConn("127.0.0.1:"+strconv.Itoa(master.server.masterPort), false)
+}
+
+// master と slave の両方で同じテストを回す
+func forEachTestConn(t *testing.T, test func(t *testing.T, conn *SyncMapServerConn)) {
+	t.Run("master", func(t *testing.T) { test(t, newTestSyncMapServerConn(t)) })
+	t.Run("slave", func(t *testing.T) { test(t, newTestSlaveConn(newTestSyncMapServerConn(t))) })
+}
+
+func TestSnapshotPathFromName(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    string
+		wantErr bool
+	}{
+		{"", "", false}, // デフォルトのパス (呼び出し側で決める)
+		{"daily", SyncMapBackUpPath + "daily", false},
+		{"daily.sm", SyncMapBackUpPath + "daily.sm", false},
+		{".", "", true},
+		{"..", "", true},
+		{"../etc/passwd", "", true},
+		{"/tmp/x", "", true},
+		{`a\b`, "", true},
+	}
+	for _, test := range tests {
+		got, err := snapshotPathFromName(test.name)
+		if (err != nil) != test.wantErr {
+			t.Errorf("snapshotPathFromName(%q) error = %v, wantErr %v", test.name, err, test.wantErr)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("snapshotPathFromName(%q) = %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+// Transaction 中に自分がロックしているキーを消しても unlock できる
+func TestDelInTransaction(t *testing.T) {
+	forEachTestConn(t, func(t *testing.T, conn *SyncMapServerConn) {
+		conn.Set("key", "value")
+		ok := conn.Transaction("key", func(tx KeyValueStoreConn) {
+			tx.Del("key")
+		})
+		if !ok || conn.Exists("key") {
+			t.Errorf("Transaction = %v, exists = %v", ok, conn.Exists("key"))
+		}
+		if conn.DBSize() != 0 {
+			t.Errorf("DBSize = %d, want 0", conn.DBSize())
+		}
+		// 消した後も同じキーでロックできる
+		conn.Transaction("key", func(tx KeyValueStoreConn) {
+			tx.Set("key", "again")
+		})
+		got := ""
+		if !conn.Get("key", &got) || got != "again" {
+			t.Errorf("Get = %q", got)
+		}
+	})
+}
+
+// 同じキーを取り合っても順番に実行され、誰も使っていないロックは残らない
+func TestKeyLocksArePruned(t *testing.T) {
+	master := newTestSyncMapServerConn(t)
+	slave := newTestSlaveConn(master)
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			key := "session:" + strconv.Itoa(i%5)
+			slave.Transaction(key, func(tx KeyValueStoreConn) {
+				n := 0
+				tx.Get(key, &n)
+				tx.Set(key, n+1)
+				if i%2 == 0 {
+					tx.Del(key)
+				}
+			})
+		}(i)
+	}
+	wg.Wait()
+	if n := master.server.keyLockCount(); n != 0 {
+		t.Errorf("%d key locks are left", n)
+	}
+	if master.IsLockedKey("session:0") {
+		t.Error("session:0 is still locked")
+	}
+}
@@ -0,0 +1,342 @@
+package main
+
+// TOTP (RFC 6238) の二段階認証
+//
+// /users/2fa/setup で秘密鍵を作って(まだ有効にはしない)、認証アプリで読んだコードを /users/2fa/enable に送ると有効になり、
+// リカバリーコードを一度だけ返す(保存するのは hash だけ)。
+// 有効なユーザーは /login でパスワードが合っても session に pending_2fa_user_id を入れるだけで、
+// /login/2fa でコードかリカバリーコードを確かめてからログインさせる。
+// 同じコードの使い回しを防ぐため、最後に使った時間ステップより前のコードは受け付けない。
+import (
+	"crypto/hmac"
+	crand "crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/sessions"
+	"github.com/isucon/isucon9-qualify/webapp/go/internal/kvtypes"
+)
+
+const (
+	totpIssuer            = "isucari"
+	totpPeriod            = 30 // 秒
+	totpDigits            = 6
+	totpSkew              = 1  // 前後何ステップまで許すか
+	totpSecretSize        = 20 // bytes (SHA1 の長さ)
+	recoveryCodeCount     = 10
+	recoveryCodeSize      = 5 // bytes (hex で 10 文字)
+	twoFactorPendingLimit = 5 * time.Minute
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+type twoFactor = kvtypes.TwoFactor
+
+func totpCode(secret []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, value%1000000)
+}
+
+// 合っていればそのステップを返す。lastUsedStep 以前のステップは受け付けない
+func verifyTOTP(secret []byte, code string, lastUsedStep int64, now time.Time) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != totpDigits {
+		return 0, false
+	}
+	current := now.Unix() / totpPeriod
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if step <= lastUsedStep {
+			continue
+		}
+		if hmac.Equal([]byte(totpCode(secret, step)), []byte(code)) {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+func totpProvisioningURI(accountName string, secret []byte) string {
+	label := url.PathEscape(totpIssuer + ":" + accountName)
+	query := url.Values{}
+	query.Set("secret", totpEncoding.EncodeToString(secret))
+	query.Set("issuer", totpIssuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", strconv.Itoa(totpDigits))
+	query.Set("period", strconv.Itoa(totpPeriod))
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
+
+func hashRecoveryCode(code string) []byte {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(code))))
+	return sum[:]
+}
+
+func newRecoveryCodes() (codes []string, hashes [][]byte) {
+	for i := 0; i < recoveryCodeCount; i++ {
+		code := secureRandomStr(recoveryCodeSize)
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	return codes, hashes
+}
+
+func twoFactorEnabled(userID int64) bool {
+	tf := twoFactor{}
+	return twoFactorServer.Get(strconv.Itoa(int(userID)), &tf) && tf.Enabled
+}
+
+// コードかリカバリーコードを確かめて、使ったものを記録する
+func verifyTwoFactor(userID int64, code, recoveryCode string) bool {
+	idStr := strconv.Itoa(int(userID))
+	ok := false
+	twoFactorServer.Transaction(idStr, func(tx KeyValueStoreConn) {
+		tf := twoFactor{}
+		if !tx.Get(idStr, &tf) || !tf.Enabled {
+			return
+		}
+		if code != "" {
+			step, verified := verifyTOTP(tf.Secret, code, tf.LastUsedStep, time.Now())
+			if !verified {
+				return
+			}
+			tf.LastUsedStep = step
+		} else {
+			hashed := hashRecoveryCode(recoveryCode)
+			found := -1
+			for i, h := range tf.RecoveryCodeHashes {
+				if hmac.Equal(h, hashed) {
+					found = i
+					break
+				}
+			}
+			if found < 0 {
+				return
+			}
+			tf.RecoveryCodeHashes = append(tf.RecoveryCodeHashes[:found], tf.RecoveryCodeHashes[found+1:]...)
+		}
+		tx.Set(idStr, tf)
+		ok = true
+	})
+	return ok
+}
+
+// パスワードが合った後に呼ぶ。二段階認証が要らなければ false
+func startPendingTwoFactor(session *sessions.Session, userID int64) bool {
+	if !twoFactorEnabled(userID) {
+		return false
+	}
+	renewSession(session)
+	delete(session.Values, "user_id")
+	delete(session.Values, "csrf_token")
+	session.Values["pending_2fa_user_id"] = userID
+	session.Values["pending_2fa_at"] = time.Now().Unix()
+	return true
+}
+
+type resLoginTwoFactor struct {
+	TwoFactorRequired bool `json:"two_factor_required"`
+}
+
+type reqLoginTwoFactor struct {
+	Code         string `json:"code"`
+	RecoveryCode string `json:"recovery_code"`
+}
+
+type reqTwoFactor struct {
+	CSRFToken string `json:"csrf_token"`
+	Code      string `json:"code"`
+}
+
+type resTwoFactorSetup struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+type resTwoFactorEnable struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// ログインの 2 段階目
+func postLoginTwoFactor(w http.ResponseWriter, r *http.Request) {
+	rl := reqLoginTwoFactor{}
+	err := json.NewDecoder(r.Body).Decode(&rl)
+	if err != nil {
+		outputErrorMsg(w, http.StatusBadRequest, "json decode error")
+		return
+	}
+	if rl.Code == "" && rl.RecoveryCode == "" {
+		outputErrorMsg(w, http.StatusBadRequest, "code or recovery_code is required")
+		return
+	}
+	session := getSession(r)
+	userID, ok := session.Values["pending_2fa_user_id"].(int64)
+	pendingAt, _ := session.Values["pending_2fa_at"].(int64)
+	if !ok || time.Since(time.Unix(pendingAt, 0)) > twoFactorPendingLimit {
+		outputErrorMsg(w, http.StatusUnauthorized, "もう一度ログインしてください")
+		return
+	}
+	u := User{}
+	if !idToUserServer.Get(strconv.Itoa(int(userID)), &u) {
+		outputErrorMsg(w, http.StatusNotFound, "user not found")
+		return
+	}
+	reservation, wait := reserveLoginAttempt(u.AccountName, requestRemoteAddr(r))
+	if wait > 0 {
+		outputLoginRetryAfter(w, wait)
+		return
+	}
+	if !verifyTwoFactor(userID, rl.Code, rl.RecoveryCode) {
+		reservation.fail()
+		outputErrorMsg(w, http.StatusUnauthorized, "確認コードが間違えています")
+		return
+	}
+	reservation.release()
+	recordLoginSuccess(u.AccountName)
+	renewSession(session)
+	delete(session.Values, "pending_2fa_user_id")
+	delete(session.Values, "pending_2fa_at")
+	session.Values["user_id"] = u.ID
+	session.Values["csrf_token"] = secureRandomStr(4)
+	if err = session.Save(r, w); err != nil {
+		log.Print(err)
+		outputErrorMsg(w, http.StatusInternalServerError, "session error")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	json.NewEncoder(w).Encode(u)
+}
+
+// 新しい秘密鍵を作る。enable するまでは今の設定のまま
+func postTwoFactorSetup(w http.ResponseWriter, r *http.Request) {
+	rt := reqTwoFactor{}
+	err := json.NewDecoder(r.Body).Decode(&rt)
+	if err != nil {
+		outputErrorMsg(w, http.StatusBadRequest, "json decode error")
+		return
+	}
+	if rt.CSRFToken != getCSRFToken(r) {
+		outputErrorMsg(w, http.StatusUnprocessableEntity, "csrf token error")
+		return
+	}
+	user, errCode, errMsg := getUser(r)
+	if errMsg != "" {
+		outputErrorMsg(w, errCode, errMsg)
+		return
+	}
+	secret := make([]byte, totpSecretSize)
+	if _, err := crand.Read(secret); err != nil {
+		log.Print(err)
+		outputErrorMsg(w, http.StatusInternalServerError, "error")
+		return
+	}
+	idStr := strconv.Itoa(int(user.ID))
+	twoFactorServer.Transaction(idStr, func(tx KeyValueStoreConn) {
+		tf := twoFactor{}
+		if !tx.Get(idStr, &tf) {
+			tf.UserID = user.ID
+		}
+		tf.PendingSecret = secret
+		tx.Set(idStr, tf)
+	})
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	json.NewEncoder(w).Encode(resTwoFactorSetup{
+		Secret:          totpEncoding.EncodeToString(secret),
+		ProvisioningURI: totpProvisioningURI(user.AccountName, secret),
+	})
+}
+
+// setup した鍵のコードが合っていれば有効にして、リカバリーコードを返す
+func postTwoFactorEnable(w http.ResponseWriter, r *http.Request) {
+	rt := reqTwoFactor{}
+	err := json.NewDecoder(r.Body).Decode(&rt)
+	if err != nil {
+		outputErrorMsg(w, http.StatusBadRequest, "json decode error")
+		return
+	}
+	if rt.CSRFToken != getCSRFToken(r) {
+		outputErrorMsg(w, http.StatusUnprocessableEntity, "csrf token error")
+		return
+	}
+	user, errCode, errMsg := getUser(r)
+	if errMsg != "" {
+		outputErrorMsg(w, errCode, errMsg)
+		return
+	}
+	idStr := strconv.Itoa(int(user.ID))
+	codes, hashes := newRecoveryCodes()
+	errCode, errMsg = 0, ""
+	twoFactorServer.Transaction(idStr, func(tx KeyValueStoreConn) {
+		tf := twoFactor{}
+		if !tx.Get(idStr, &tf) || len(tf.PendingSecret) == 0 {
+			errCode, errMsg = http.StatusBadRequest, "先に設定を始めてください"
+			return
+		}
+		step, ok := verifyTOTP(tf.PendingSecret, rt.Code, 0, time.Now())
+		if !ok {
+			errCode, errMsg = http.StatusBadRequest, "確認コードが間違えています"
+			return
+		}
+		tf.Enabled = true
+		tf.Secret = tf.PendingSecret
+		tf.PendingSecret = nil
+		tf.RecoveryCodeHashes = hashes
+		tf.LastUsedStep = step
+		tf.EnabledAt = time.Now()
+		tx.Set(idStr, tf)
+	})
+	if errMsg != "" {
+		outputErrorMsg(w, errCode, errMsg)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	json.NewEncoder(w).Encode(resTwoFactorEnable{RecoveryCodes: codes})
+}
+
+// 今のコード(かリカバリーコード)で確かめてから無効にする
+func postTwoFactorDisable(w http.ResponseWriter, r *http.Request) {
+	rt := struct {
+		reqTwoFactor
+		RecoveryCode string `json:"recovery_code"`
+	}{}
+	err := json.NewDecoder(r.Body).Decode(&rt)
+	if err != nil {
+		outputErrorMsg(w, http.StatusBadRequest, "json decode error")
+		return
+	}
+	if rt.CSRFToken != getCSRFToken(r) {
+		outputErrorMsg(w, http.StatusUnprocessableEntity, "csrf token error")
+		return
+	}
+	user, errCode, errMsg := getUser(r)
+	if errMsg != "" {
+		outputErrorMsg(w, errCode, errMsg)
+		return
+	}
+	if !twoFactorEnabled(user.ID) {
+		outputErrorMsg(w, http.StatusBadRequest, "二段階認証は有効になっていません")
+		return
+	}
+	if !verifyTwoFactor(user.ID, rt.Code, rt.RecoveryCode) {
+		outputErrorMsg(w, http.StatusBadRequest, "確認コードが間違えています")
+		return
+	}
+	twoFactorServer.Del(strconv.Itoa(int(user.ID)))
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	w.Write([]byte("{}"))
+}
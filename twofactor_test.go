@@ -0,0 +1,141 @@
+package main
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+// RFC 6238 Appendix B の SHA1 の値 (8 桁) の下 6 桁
+var rfc6238Secret = []byte("12345678901234567890")
+
+func TestTOTPCodeRFC6238(t *testing.T) {
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},          // 94287082
+		{1111111109, "081804"},  // 07081804
+		{1111111111, "050471"},  // 14050471
+		{1234567890, "005924"},  // 89005924
+		{2000000000, "279037"},  // 69279037
+		{20000000000, "353130"}, // 65353130
+	}
+	for _, test := range tests {
+		if got := totpCode(rfc6238Secret, test.unix/totpPeriod); got != test.want {
+			t.Errorf("totpCode(T=%d) = %s, want %s", test.unix, got, test.want)
+		}
+		step, ok := verifyTOTP(rfc6238Secret, test.want, 0, time.Unix(test.unix, 0))
+		if !ok || step != test.unix/totpPeriod {
+			t.Errorf("verifyTOTP(T=%d) = %d, %v", test.unix, step, ok)
+		}
+	}
+}
+
+func TestVerifyTOTPSkewAndReplay(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	current := now.Unix() / totpPeriod
+	tests := []struct {
+		name         string
+		code         string
+		lastUsedStep int64
+		wantStep     int64
+		wantOK       bool
+	}{
+		{"current step", totpCode(rfc6238Secret, current), 0, current, true},
+		{"one step behind", totpCode(rfc6238Secret, current-1), 0, current - 1, true},
+		{"one step ahead", totpCode(rfc6238Secret, current+1), 0, current + 1, true},
+		{"two steps behind", totpCode(rfc6238Secret, current-2), 0, 0, false},
+		{"two steps ahead", totpCode(rfc6238Secret, current+2), 0, 0, false},
+		{"surrounding spaces", " " + totpCode(rfc6238Secret, current) + "\n", 0, current, true},
+		{"wrong code", "000000", 0, 0, false},
+		{"too short", totpCode(rfc6238Secret, current)[:5], 0, 0, false},
+		{"too long", totpCode(rfc6238Secret, current) + "0", 0, 0, false},
+		{"empty", "", 0, 0, false},
+		// 使ったステップとそれより前は受け付けない
+		{"replay of the used step", totpCode(rfc6238Secret, current), current, 0, false},
+		{"step before the used one", totpCode(rfc6238Secret, current-1), current, 0, false},
+		{"step after the used one", totpCode(rfc6238Secret, current+1), current, current + 1, true},
+		{"older step after the used one", totpCode(rfc6238Secret, current), current - 1, current, true},
+	}
+	for _, test := range tests {
+		step, ok := verifyTOTP(rfc6238Secret, test.code, test.lastUsedStep, now)
+		if ok != test.wantOK || step != test.wantStep {
+			t.Errorf("%s: verifyTOTP = %d, %v; want %d, %v", test.name, step, ok, test.wantStep, test.wantOK)
+		}
+	}
+}
+
+func useTestTwoFactorServer(t *testing.T) (restore func()) {
+	saved := twoFactorServer
+	twoFactorServer = newTestSyncMapServerConn(t)
+	twoFactorServer.server.NewValueFunction = func() interface{} { return &twoFactor{} }
+	return func() { twoFactorServer = saved }
+}
+
+// 同じコードは 2 回使えない。リカバリーコードはそれぞれ 1 回だけ
+func TestVerifyTwoFactor(t *testing.T) {
+	defer useTestTwoFactorServer(t)()
+	const userID = 1234
+	codes, hashes := newRecoveryCodes()
+	twoFactorServer.Set(strconv.Itoa(userID), twoFactor{
+		UserID:             userID,
+		Enabled:            true,
+		Secret:             rfc6238Secret,
+		RecoveryCodeHashes: hashes,
+	})
+
+	code := totpCode(rfc6238Secret, time.Now().Unix()/totpPeriod)
+	if !verifyTwoFactor(userID, code, "") {
+		t.Fatal("valid code was rejected")
+	}
+	if verifyTwoFactor(userID, code, "") {
+		t.Error("the same code was accepted twice")
+	}
+
+	if !verifyTwoFactor(userID, "", " "+codes[3]+" ") {
+		t.Error("valid recovery code was rejected")
+	}
+	if verifyTwoFactor(userID, "", codes[3]) {
+		t.Error("the same recovery code was accepted twice")
+	}
+	if verifyTwoFactor(userID, "", "0000000000") {
+		t.Error("unknown recovery code was accepted")
+	}
+	tf := twoFactor{}
+	twoFactorServer.Get(strconv.Itoa(userID), &tf)
+	if len(tf.RecoveryCodeHashes) != recoveryCodeCount-1 {
+		t.Errorf("%d recovery codes left, want %d", len(tf.RecoveryCodeHashes), recoveryCodeCount-1)
+	}
+	// 他のコードはまだ使える
+	if !verifyTwoFactor(userID, "", codes[0]) {
+		t.Error("another recovery code was rejected")
+	}
+
+	// 有効にしていなければ通さない
+	tf.Enabled = false
+	twoFactorServer.Set(strconv.Itoa(userID), tf)
+	if verifyTwoFactor(userID, "", codes[1]) {
+		t.Error("disabled two-factor accepted a recovery code")
+	}
+	if verifyTwoFactor(999, "", codes[1]) {
+		t.Error("unknown user accepted a recovery code")
+	}
+}
+
+func TestNewRecoveryCodes(t *testing.T) {
+	codes, hashes := newRecoveryCodes()
+	if len(codes) != recoveryCodeCount || len(hashes) != recoveryCodeCount {
+		t.Fatalf("%d codes, %d hashes", len(codes), len(hashes))
+	}
+	seen := map[string]bool{}
+	for i, code := range codes {
+		if seen[code] {
+			t.Errorf("duplicated recovery code %s", code)
+		}
+		seen[code] = true
+		if string(hashes[i]) != string(hashRecoveryCode(code)) {
+			t.Errorf("hash of %s differs", code)
+		}
+	}
+}
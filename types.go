@@ -1,42 +1,26 @@
 package main
 
-import "time"
+import "github.com/isucon/isucon9-qualify/webapp/go/internal/kvtypes"
 
 type Config struct {
 	Name string `json:"name" db:"name"`
 	Val  string `json:"val" db:"val"`
 }
 
-type User struct {
-	ID             int64     `json:"id" db:"id"`
-	AccountName    string    `json:"account_name" db:"account_name"`
-	HashedPassword []byte    `json:"-" db:"hashed_password"`
-	Address        string    `json:"address,omitempty" db:"address"`
-	NumSellItems   int       `json:"num_sell_items" db:"num_sell_items"`
-	LastBump       time.Time `json:"-" db:"last_bump"`
-	CreatedAt      time.Time `json:"-" db:"created_at"`
-	PlainPassword  string
-}
+// KVS に保存する型は cmd/syncmapctl と共有するので internal/kvtypes で定義する
+type (
+	User                = kvtypes.User
+	Item                = kvtypes.Item
+	TransactionEvidence = kvtypes.TransactionEvidence
+	Shipping            = kvtypes.Shipping
+)
 
 type UserSimple struct {
-	ID           int64  `json:"id" db:"id"`
-	AccountName  string `json:"account_name" db:"account_name"`
-	NumSellItems int    `json:"num_sell_items" db:"num_sell_items"`
-}
-
-type Item struct {
-	ID          int64     `json:"id" db:"id"`
-	SellerID    int64     `json:"seller_id" db:"seller_id"`
-	BuyerID     int64     `json:"buyer_id" db:"buyer_id"`
-	Status      string    `json:"status" db:"status"`
-	Name        string    `json:"name" db:"name"`
-	Price       int       `json:"price" db:"price"`
-	Description string    `json:"description" db:"description"`
-	ImageName   string    `json:"image_name" db:"image_name"`
-	CategoryID  int       `json:"category_id" db:"category_id"`
-	CreatedAt   time.Time `json:"-" db:"created_at"`
-	UpdatedAt   time.Time `json:"-" db:"updated_at"`
-	TimeDateID  string    `json:"-" db:"timedateid"`
+	ID            int64   `json:"id" db:"id"`
+	AccountName   string  `json:"account_name" db:"account_name"`
+	NumSellItems  int     `json:"num_sell_items" db:"num_sell_items"`
+	RatingCount   int     `json:"rating_count" db:"-"`
+	RatingAverage float64 `json:"rating_average" db:"-"` // 評価が無ければ 0
 }
 
 type ItemWithUserSimple struct {
@@ -55,6 +39,7 @@ type ItemSimple struct {
 	CategoryID int         `json:"category_id"`
 	Category   *Category   `json:"category"`
 	CreatedAt  int64       `json:"created_at"`
+	LikeCount  int         `json:"like_count"`
 }
 
 type ItemDetail struct {
@@ -74,37 +59,10 @@ type ItemDetail struct {
 	TransactionEvidenceStatus string      `json:"transaction_evidence_status,omitempty"`
 	ShippingStatus            string      `json:"shipping_status,omitempty"`
 	CreatedAt                 int64       `json:"created_at"`
-}
-
-type TransactionEvidence struct {
-	ID                 int64     `json:"id" db:"id"`
-	SellerID           int64     `json:"seller_id" db:"seller_id"`
-	BuyerID            int64     `json:"buyer_id" db:"buyer_id"`
-	Status             string    `json:"status" db:"status"`
-	ItemID             int64     `json:"item_id" db:"item_id"`
-	ItemName           string    `json:"item_name" db:"item_name"`
-	ItemPrice          int       `json:"item_price" db:"item_price"`
-	ItemDescription    string    `json:"item_description" db:"item_description"`
-	ItemCategoryID     int       `json:"item_category_id" db:"item_category_id"`
-	ItemRootCategoryID int       `json:"item_root_category_id" db:"item_root_category_id"`
-	CreatedAt          time.Time `json:"-" db:"created_at"`
-	UpdatedAt          time.Time `json:"-" db:"updated_at"`
-}
-
-type Shipping struct {
-	TransactionEvidenceID int64     `json:"transaction_evidence_id" db:"transaction_evidence_id"`
-	Status                string    `json:"status" db:"status"`
-	ItemName              string    `json:"item_name" db:"item_name"`
-	ItemID                int64     `json:"item_id" db:"item_id"`
-	ReserveID             string    `json:"reserve_id" db:"reserve_id"`
-	ReserveTime           int64     `json:"reserve_time" db:"reserve_time"`
-	ToAddress             string    `json:"to_address" db:"to_address"`
-	ToName                string    `json:"to_name" db:"to_name"`
-	FromAddress           string    `json:"from_address" db:"from_address"`
-	FromName              string    `json:"from_name" db:"from_name"`
-	ImgBinary             []byte    `json:"-" db:"img_binary"`
-	CreatedAt             time.Time `json:"-" db:"created_at"`
-	UpdatedAt             time.Time `json:"-" db:"updated_at"`
+	LikeCount                 int         `json:"like_count"`
+	Liked                     bool        `json:"liked,omitempty"` // 見ているユーザーがいいねしているか
+	CommentCount              int         `json:"comment_count"`
+	UnreadMessageCount        int         `json:"unread_message_count,omitempty"` // 取引メッセージの未読数 (getTransactions のみ)
 }
 
 type Category struct {
@@ -206,8 +164,10 @@ type reqBump struct {
 }
 
 type resSetting struct {
-	CSRFToken         string     `json:"csrf_token"`
-	PaymentServiceURL string     `json:"payment_service_url"`
-	User              *User      `json:"user,omitempty"`
-	Categories        []Category `json:"categories"`
+	CSRFToken               string     `json:"csrf_token"`
+	PaymentServiceURL       string     `json:"payment_service_url"`
+	User                    *User      `json:"user,omitempty"`
+	TwoFactorEnabled        bool       `json:"two_factor_enabled"`
+	UnreadNotificationCount int        `json:"unread_notification_count"` // notifications.go
+	Categories              []Category `json:"categories"`
 }
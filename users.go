@@ -0,0 +1,136 @@
+package main
+
+// ユーザーの MySQL への保存と、以前の postRegister で KV にだけ登録されたユーザーの移行
+//
+//	./isucari migrate-users
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+const mysqlErrDuplicateEntry = 1062
+
+var ErrAccountNameTaken = errors.New("account name is already taken")
+
+// 新しいユーザーを users に INSERT して、採番された ID を入れて返す
+func insertUser(user User) (User, error) {
+	result, err := dbx.Exec("INSERT INTO `users` (`account_name`, `hashed_password`, `address`, `num_sell_items`, `last_bump`, `created_at`) VALUES (?, ?, ?, ?, ?, ?)",
+		user.AccountName,
+		user.HashedPassword,
+		user.Address,
+		user.NumSellItems,
+		user.LastBump,
+		user.CreatedAt,
+	)
+	if isDuplicateEntry(err) {
+		return user, ErrAccountNameTaken
+	}
+	if err != nil {
+		return user, err
+	}
+	user.ID, err = result.LastInsertId()
+	return user, err
+}
+
+// KV にある ID のまま users に INSERT する
+func insertUserWithID(user User) error {
+	if len(user.HashedPassword) == 0 {
+		return errors.New("no hashed password")
+	}
+	_, err := dbx.Exec("INSERT INTO `users` (`id`, `account_name`, `hashed_password`, `address`, `num_sell_items`, `last_bump`, `created_at`, `rating_count`, `rating_sum`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		user.ID,
+		user.AccountName,
+		user.HashedPassword,
+		user.Address,
+		user.NumSellItems,
+		user.LastBump,
+		user.CreatedAt,
+		user.RatingCount,
+		user.RatingSum,
+	)
+	if isDuplicateEntry(err) {
+		return fmt.Errorf("%v: %s", ErrAccountNameTaken, user.AccountName)
+	}
+	return err
+}
+
+func isDuplicateEntry(err error) bool {
+	mysqlErr, ok := err.(*mysql.MySQLError)
+	return ok && mysqlErr.Number == mysqlErrDuplicateEntry
+}
+
+// KV にだけいるユーザーの ID
+func kvOnlyUserIDs() ([]string, error) {
+	dbIDs := []int64{}
+	if err := dbx.Select(&dbIDs, "SELECT `id` FROM `users`"); err != nil {
+		return nil, err
+	}
+	inDB := map[string]bool{}
+	for _, id := range dbIDs {
+		inDB[strconv.FormatInt(id, 10)] = true
+	}
+	result := []string{}
+	for _, key := range idToUserServer.AllKeys() {
+		if !inDB[key] {
+			result = append(result, key)
+		}
+	}
+	sortNumericKeys(result)
+	return result, nil
+}
+
+// KV にだけいるユーザーを同じ ID で users に入れる。
+// ID を指定して INSERT するので AUTO_INCREMENT もその後ろから採番されるようになる
+func migrateKVOnlyUsers() (migrated int, errs []error) {
+	ids, err := kvOnlyUserIDs()
+	if err != nil {
+		return 0, []error{err}
+	}
+	for _, idStr := range ids {
+		if err := migrateKVOnlyUser(idStr); err != nil {
+			errs = append(errs, fmt.Errorf("user %s: %v", idStr, err))
+			continue
+		}
+		migrated++
+	}
+	return migrated, errs
+}
+func migrateKVOnlyUser(idStr string) error {
+	user := User{}
+	if !idToUserServer.Get(idStr, &user) {
+		return errors.New("not found in kv")
+	}
+	return insertUserWithID(user)
+}
+
+// 起動時に移行しておく(移行前の ID と新しく採番される ID がぶつからないように)。1 台で十分
+func migrateKVOnlyUsersOnStartup() {
+	if !isMasterServerIP {
+		return
+	}
+	migrated, errs := migrateKVOnlyUsers()
+	if migrated > 0 {
+		log.Println("migrated kv-only users:", migrated)
+	}
+	for _, err := range errs {
+		log.Println("migrate kv-only users:", err)
+	}
+}
+
+func runMigrateUsersCommand(args []string) int {
+	configureSyncMapStores()
+	migrated, errs := migrateKVOnlyUsers()
+	fmt.Printf("migrated %d users\n", migrated)
+	for _, err := range errs {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	if len(errs) > 0 {
+		return 1
+	}
+	return 0
+}
@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 )
@@ -64,6 +66,50 @@ func getShipmentServiceURL() string {
 	return val
 }
 
+// 商品の並び順 (created_at DESC, id DESC) のキー。ページングのカーソルにも使う
+func formatTimeDateID(createdAt int64, itemID int64) string {
+	return time.Unix(createdAt, 0).Format("20060102150405") + fmt.Sprintf("%08d", itemID)
+}
+
+// ?<idParam>=...&created_at=... のページングのカーソル。どちらかが無ければ "" (先頭から)
+func parsePagingCursor(query url.Values, idParam string) (cursor string, errMsg string) {
+	var id, createdAt int64
+	var err error
+	if idStr := query.Get(idParam); idStr != "" {
+		id, err = strconv.ParseInt(idStr, 10, 64)
+		if err != nil || id <= 0 {
+			return "", idParam + " param error"
+		}
+	}
+	if createdAtStr := query.Get("created_at"); createdAtStr != "" {
+		createdAt, err = strconv.ParseInt(createdAtStr, 10, 64)
+		if err != nil || createdAt <= 0 {
+			return "", "created_at param error"
+		}
+	}
+	if id > 0 && createdAt > 0 {
+		cursor = formatTimeDateID(createdAt, id)
+	}
+	return cursor, ""
+}
+
+// counterServer から prefix + itemId の数をまとめて取る (likes.go / comments.go)
+func itemCounters(prefix string, items []Item) map[int64]int {
+	keys := make([]string, len(items))
+	for i, item := range items {
+		keys[i] = prefix + strconv.Itoa(int(item.ID))
+	}
+	mGot := counterServer.MGet(keys)
+	counts := make(map[int64]int, len(items))
+	for _, item := range items {
+		count := 0
+		if mGot.Get(prefix+strconv.Itoa(int(item.ID)), &count) {
+			counts[item.ID] = count
+		}
+	}
+	return counts
+}
+
 func getUserSimpleByID(q sqlx.Queryer, userID int64) (userSimple UserSimple, err error) {
 	user := User{}
 	userIDStr := strconv.Itoa(int(userID))
@@ -71,10 +117,17 @@ func getUserSimpleByID(q sqlx.Queryer, userID int64) (userSimple UserSimple, err
 		return userSimple, errors.New("no user")
 	}
 	idToUserServer.Get(userIDStr, &user)
-	userSimple.ID = user.ID
-	userSimple.AccountName = user.AccountName
-	userSimple.NumSellItems = user.NumSellItems
-	return userSimple, err
+	return newUserSimple(user), err
+}
+
+func newUserSimple(user User) UserSimple {
+	return UserSimple{
+		ID:            user.ID,
+		AccountName:   user.AccountName,
+		NumSellItems:  user.NumSellItems,
+		RatingCount:   user.RatingCount,
+		RatingAverage: ratingAverage(user),
+	}
 }
 
 func getCategoryByID(q sqlx.Queryer, categoryID int) (category Category, err error) {
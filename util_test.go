@@ -0,0 +1,49 @@
+package main
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestParsePagingCursor(t *testing.T) {
+	tests := []struct {
+		query      string
+		wantCursor string
+		wantErrMsg string
+	}{
+		{"", "", ""},
+		{"item_id=50000", "", ""},
+		{"created_at=1565575823", "", ""},
+		{"item_id=50000&created_at=1565575823", formatTimeDateID(1565575823, 50000), ""},
+		{"item_id=0&created_at=1565575823", "", "item_id param error"},
+		{"item_id=abc", "", "item_id param error"},
+		{"item_id=50000&created_at=-1", "", "created_at param error"},
+		{"comment_id=1&created_at=1565575823", "", ""}, // 別の名前の ID は見ない
+	}
+	for _, test := range tests {
+		query, _ := url.ParseQuery(test.query)
+		cursor, errMsg := parsePagingCursor(query, "item_id")
+		if cursor != test.wantCursor || errMsg != test.wantErrMsg {
+			t.Errorf("%q: parsePagingCursor = %q, %q; want %q, %q", test.query, cursor, errMsg, test.wantCursor, test.wantErrMsg)
+		}
+	}
+}
+
+// counterServer は数の種類をプレフィックスで分ける
+func TestItemCounters(t *testing.T) {
+	saved := counterServer
+	counterServer = newTestSyncMapServerConn(t)
+	counterServer.server.NewValueFunction = func() interface{} { return new(int) }
+	defer func() { counterServer = saved }()
+	counterServer.IncrBy(counterKeyLikes+"1", 3)
+	counterServer.IncrBy(counterKeyComments+"1", 5)
+	counterServer.IncrBy(counterKeyComments+"2", 1)
+	items := []Item{{ID: 1}, {ID: 2}, {ID: 3}}
+	if got, want := itemCounters(counterKeyLikes, items), map[int64]int{1: 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("likes = %v, want %v", got, want)
+	}
+	if got, want := itemCounters(counterKeyComments, items), map[int64]int{1: 5, 2: 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("comments = %v, want %v", got, want)
+	}
+}
@@ -31,16 +31,21 @@ const (
 	TransactionEvidenceStatusWaitShipping = "wait_shipping"
 	TransactionEvidenceStatusWaitDone     = "wait_done"
 	TransactionEvidenceStatusDone         = "done"
+	TransactionEvidenceStatusCancel       = "cancel"
 
 	ShippingsStatusInitial    = "initial"
 	ShippingsStatusWaitPickup = "wait_pickup"
 	ShippingsStatusShipping   = "shipping"
 	ShippingsStatusDone       = "done"
-
-	BumpChargeSeconds   = 3 * time.Second
-	ItemsPerPage        = 48
-	TransactionsPerPage = 10
-	BcryptCost          = 4
+	ShippingsStatusCancel     = "cancel"
+
+	BumpChargeSeconds    = 3 * time.Second
+	ItemsPerPage         = 48
+	TransactionsPerPage  = 10
+	CommentsPerPage      = 20
+	MessagesPerPage      = 50
+	NotificationsPerPage = 20
+	BcryptCost           = 4
 )
 
 var (
@@ -50,8 +55,8 @@ var (
 	client    http.Client
 )
 
-// とりあえず plain password だけを管理するサーバー(ID/AccountName/PlainPassword以外の情報は嘘)
-var isMasterServerIP = MyServerIsOnMasterServerIP()
+// サブコマンド (main.go) の時は動いているサーバーに slave としてつなぐ
+var isMasterServerIP = MyServerIsOnMasterServerIP() && subcommandName() == ""
 
 // string -> string
 // var accountNameToIDServer = NewRedisWrapper(RedisHostPrivateIPAddress, 0)
@@ -72,6 +77,77 @@ var transactionEvidenceToShippingsServer = NewSyncMapServerConn(GetMasterServerA
 // itemId -> transactionEvidence
 var itemIdToTransactionEvidenceServer = NewSyncMapServerConn(GetMasterServerAddress()+":8881", isMasterServerIP)
 
+// 全台への通知 (Publish / Subscribe) とジョブのキュー用
+var eventServer = NewSyncMapServerConn(GetMasterServerAddress()+":8880", isMasterServerIP)
+
+// sessionID -> serverSession{} (sessions.go)
+var sessionServer = NewSyncMapServerConn(GetMasterServerAddress()+":8879", isMasterServerIP)
+
+// "account:name" / "ip:addr" -> loginAttempt{} (loginattempts.go)
+var loginAttemptServer = NewSyncMapServerConn(GetMasterServerAddress()+":8878", isMasterServerIP)
+
+// userId(string) -> twoFactor{} (twofactor.go)
+var twoFactorServer = NewSyncMapServerConn(GetMasterServerAddress()+":8877", isMasterServerIP)
+
+// sha256(token) の hex -> apiToken{} (apitokens.go)
+var apiTokenServer = NewSyncMapServerConn(GetMasterServerAddress()+":8876", isMasterServerIP)
+
+// transactionEvidenceId(string) -> Payment{} (payments.go)
+var paymentServer = NewSyncMapServerConn(GetMasterServerAddress()+":8875", isMasterServerIP)
+
+// campaignId -> campaign{} (campaigns.go)
+var campaignServer = NewSyncMapServerConn(GetMasterServerAddress()+":8874", isMasterServerIP)
+
+// userId(string) -> userPoints{} (campaigns.go)
+var pointServer = NewSyncMapServerConn(GetMasterServerAddress()+":8873", isMasterServerIP)
+
+// "transactionEvidenceId:role" -> Review{} (reviews.go)
+var reviewServer = NewSyncMapServerConn(GetMasterServerAddress()+":8872", isMasterServerIP)
+
+// "userId:itemId" -> itemLike{} (likes.go)
+var likeServer = NewSyncMapServerConn(GetMasterServerAddress()+":8871", isMasterServerIP)
+
+// counterKey* + id(string) -> int のカウンタ。IncrBy で持つ数はプレフィックスを変えてここにまとめる
+var counterServer = NewSyncMapServerConn(GetMasterServerAddress()+":8870", isMasterServerIP)
+
+// commentId(string) -> itemComment{} (comments.go)
+var commentServer = NewSyncMapServerConn(GetMasterServerAddress()+":8869", isMasterServerIP)
+
+// messageId(string) -> transactionMessage{} (messages.go)
+var messageServer = NewSyncMapServerConn(GetMasterServerAddress()+":8867", isMasterServerIP)
+
+// notificationId(string) -> notification{} (notifications.go)
+var notificationServer = NewSyncMapServerConn(GetMasterServerAddress()+":8865", isMasterServerIP)
+
+// 全てのストア (import-redis で保存している型を引く)
+func syncMapStores() []*SyncMapServerConn {
+	return []*SyncMapServerConn{
+		accountNameToIDServer, idToUserServer, idToItemServer, transactionEvidenceToShippingsServer,
+		itemIdToTransactionEvidenceServer, eventServer, sessionServer, loginAttemptServer,
+		twoFactorServer, apiTokenServer, paymentServer, campaignServer, pointServer, reviewServer,
+		likeServer, counterServer, commentServer, messageServer, notificationServer,
+	}
+}
+
+const ( // eventServer のチャンネル
+	channelCacheReset = "cache.reset"     // 各台のローカルキャッシュを捨てる
+	channelLatency    = "metrics.latency" // 各台のレイテンシの集計 (latency.go)
+	channelUserPrefix = "user."           // + userId。そのユーザーの取引の状態の変化 (stream.go)
+)
+const ( // counterServer のキーのプレフィックス
+	counterKeyLikes                 = "likes:"                    // + itemId。いいねの数 (likes.go)
+	counterKeyComments              = "comments:"                 // + itemId。コメントの数 (comments.go)
+	counterKeyUnreadNotifications   = "unread_notifications:"     // + userId。未読の通知の数 (notifications.go)
+	counterKeyNotificationsReadUpTo = "notifications_read_up_to:" // + userId。全て既読にした時の最後の通知の ID
+	counterKeyUnreadMessages        = "unread_messages:"          // + "transactionEvidenceId:userId"。未読のメッセージの数 (messages.go)
+	counterKeyLastReadMessage       = "last_read_message:"        // + "transactionEvidenceId:userId"。最後に読んだ相手のメッセージの ID
+)
+const ( // eventServer のキュー (ReliableQueue)
+	queueShipmentStatus    = "queue:shipment.status"    // 配送状況の更新
+	queueWriteBehind       = "queue:writebehind"        // MySQL への書き込み (writebehind.go)
+	queueWriteBehindFailed = "queue:writebehind:failed" // 再試行しても失敗した書き込み
+)
+
 // string -> []Hoge
 // var arrayServer = NewSyncMapServerConn(GetMasterServerAddress()+":8882", isMasterServerIP)
 // const keyOfTransactionEvidences = "transaction_evidences"
@@ -0,0 +1,134 @@
+package main
+
+// eventServer のキューを使うバックグラウンドのワーカー
+import (
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	shipmentStatusWorkerNum       = 4
+	shipmentStatusRefreshInterval = 1 * time.Second
+	workerPopTimeout              = 5 * time.Second
+)
+
+// 配送状況を shipment service に問い合わせて Shipping.Status を更新するジョブ。done になるまで繰り返す
+type shipmentStatusJob struct {
+	TransactionEvidenceID int64
+	ReserveID             string
+	NotBefore             int64 // unix nano. これより前には問い合わせない
+}
+
+// 既にキューに入れたもの (TransactionEvidenceID -> bool)。台毎に持つので他の台と重複して入ることはある
+var shipmentStatusRefreshQueued = sync.Map{}
+
+// 処理中リストは台毎に分ける(起動時に自分の処理中のものだけを戻すため)
+var shipmentStatusQueue = func() *ReliableQueue {
+	hostname, _ := os.Hostname()
+	return NewReliableQueue(eventServer, queueShipmentStatus, queueShipmentStatus+":processing:"+hostname)
+}()
+
+func enqueueShipmentStatusRefresh(transactionEvidenceID int64, reserveID string) {
+	shipmentStatusRefreshQueued.Store(transactionEvidenceID, true)
+	shipmentStatusQueue.Push(shipmentStatusJob{
+		TransactionEvidenceID: transactionEvidenceID,
+		ReserveID:             reserveID,
+	})
+}
+
+// まだキューに入れていなければ入れる
+func ensureShipmentStatusRefresh(transactionEvidenceID int64, reserveID string) {
+	if _, queued := shipmentStatusRefreshQueued.LoadOrStore(transactionEvidenceID, true); queued {
+		return
+	}
+	enqueueShipmentStatusRefresh(transactionEvidenceID, reserveID)
+}
+
+func startShipmentStatusWorkers() {
+	queue := shipmentStatusQueue
+	if requeued := queue.Requeue(); requeued > 0 {
+		log.Println("shipment status worker: requeued", requeued)
+	}
+	for i := 0; i < shipmentStatusWorkerNum; i++ {
+		go runShipmentStatusWorker(queue)
+	}
+}
+
+func runShipmentStatusWorker(queue *ReliableQueue) {
+	for {
+		job := shipmentStatusJob{}
+		if !queue.Pop(workerPopTimeout, &job) {
+			continue
+		}
+		handleShipmentStatusJob(queue, job, refreshShipmentStatus)
+	}
+}
+
+// まだ問い合わせる時間になっていなければ、その時間にキューに戻すようにして次のジョブに進む
+// (ワーカーが待つと、問い合わせられる数がワーカーの数で頭打ちになる)。
+// 戻すまでは処理中リストに残すので、その間に落ちても起動時の Requeue で戻る
+func handleShipmentStatusJob(queue *ReliableQueue, job shipmentStatusJob, refresh func(job shipmentStatusJob) bool) {
+	if wait := time.Until(time.Unix(0, job.NotBefore)); wait > 0 {
+		time.AfterFunc(wait, func() {
+			queue.Push(job)
+			queue.Ack(job)
+		})
+		return
+	}
+	if !refresh(job) {
+		next := job
+		next.NotBefore = time.Now().Add(shipmentStatusRefreshInterval).UnixNano()
+		queue.Push(next)
+	}
+	queue.Ack(job)
+}
+
+// 配送状況は進む方向にしか変わらない
+var shippingStatusOrder = map[string]int{
+	ShippingsStatusInitial:    0,
+	ShippingsStatusWaitPickup: 1,
+	ShippingsStatusShipping:   2,
+	ShippingsStatusDone:       3,
+}
+
+// 配送が完了した(もう問い合わせなくてよい)なら true
+func refreshShipmentStatus(job shipmentStatusJob) bool {
+	ssr, err := APIShipmentStatus(getShipmentServiceURL(), &APIShipmentStatusReq{
+		ReserveID: job.ReserveID,
+	})
+	if err != nil {
+		log.Println("shipment status worker:", job.TransactionEvidenceID, err)
+		return false
+	}
+	done := false
+	var changedItemID int64
+	trIdStr := strconv.Itoa(int(job.TransactionEvidenceID))
+	transactionEvidenceToShippingsServer.Transaction(trIdStr, func(tx KeyValueStoreConn) {
+		shipping := Shipping{}
+		if !tx.Get(trIdStr, &shipping) || shipping.ReserveID != job.ReserveID {
+			done = true // 初期化などで消えた
+			return
+		}
+		if shipping.Status == ShippingsStatusCancel {
+			done = true // キャンセルされた (itemstatus.go)
+			return
+		}
+		if shippingStatusOrder[ssr.Status] > shippingStatusOrder[shipping.Status] {
+			shipping.Status = ssr.Status
+			shipping.UpdatedAt = time.Now().Truncate(time.Second)
+			tx.Set(trIdStr, shipping)
+			changedItemID = shipping.ItemID
+		}
+		done = shipping.Status == ShippingsStatusDone
+	})
+	if changedItemID > 0 {
+		transactionEvidence := TransactionEvidence{}
+		if itemIdToTransactionEvidenceServer.Get(strconv.Itoa(int(changedItemID)), &transactionEvidence) {
+			publishTransactionStatus(StreamEventShipping, transactionEvidence)
+		}
+	}
+	return done
+}
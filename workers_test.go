@@ -0,0 +1,55 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+// まだ問い合わせる時間でないジョブでワーカーは待たず、後ろのジョブを先に処理する
+func TestHandleShipmentStatusJobNotDue(t *testing.T) {
+	conn := newTestSyncMapServerConn(t)
+	queue := NewReliableQueue(conn, "queue", "queue:processing")
+	later := shipmentStatusJob{TransactionEvidenceID: 1, ReserveID: "1", NotBefore: time.Now().Add(300 * time.Millisecond).UnixNano()}
+	due := shipmentStatusJob{TransactionEvidenceID: 2, ReserveID: "2"}
+	queue.Push(later)
+	queue.Push(due)
+
+	refreshed := []int64{}
+	refresh := func(job shipmentStatusJob) bool {
+		refreshed = append(refreshed, job.TransactionEvidenceID)
+		return true
+	}
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		job := shipmentStatusJob{}
+		if !queue.Pop(-1, &job) {
+			t.Fatalf("pop %d: queue is empty", i)
+		}
+		handleShipmentStatusJob(queue, job, refresh)
+	}
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Errorf("worker waited %v for a job that was not due", elapsed)
+	}
+	if len(refreshed) != 1 || refreshed[0] != 2 {
+		t.Fatalf("refreshed = %v, want [2]", refreshed)
+	}
+	// 戻すまでは処理中に残る
+	if queue.Len() != 0 || conn.LLen("queue:processing") != 1 {
+		t.Errorf("queue = %d, processing = %d", queue.Len(), conn.LLen("queue:processing"))
+	}
+
+	job := shipmentStatusJob{}
+	if !queue.Pop(time.Second, &job) || job.TransactionEvidenceID != 1 {
+		t.Fatalf("job was not put back: %+v", job)
+	}
+	if time.Since(start) < 250*time.Millisecond {
+		t.Error("job was put back before NotBefore")
+	}
+	handleShipmentStatusJob(queue, job, refresh)
+	if len(refreshed) != 2 || refreshed[1] != 1 {
+		t.Errorf("refreshed = %v, want [2 1]", refreshed)
+	}
+	if queue.Len() != 0 || conn.LLen("queue:processing") != 0 {
+		t.Errorf("after done: queue = %d, processing = %d", queue.Len(), conn.LLen("queue:processing"))
+	}
+}
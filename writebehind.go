@@ -0,0 +1,321 @@
+package main
+
+// MySQL への書き込みを後回しにする (write-behind)
+//
+// ハンドラは KV を更新したあと dbx.Exec の代わりに writeBehind で SQL を eventServer のキューに積む。
+// ワーカーは同じ行への更新の順序を保つために eventServer の master の台で 1 つだけ動かし、
+// まとめて取り出して 1 つのトランザクションで適用する。失敗したら 1 件ずつバックオフしながら再試行し、
+// それでも失敗したものは失敗リストに移す(/debug/writebehind で見られる)。
+// LastInsertId が必要な INSERT はこれまで通り同期で実行すること。
+// /initialize で MySQL を作り直す時は世代 (writeBehindGenerationKey) を進め、ワーカーは古い世代の書き込みを捨てる。
+// 取り出し済みのものや再試行を待っているものが、作り直した初期データを書き換えないようにするため。
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	writeBehindBatchSize   = 100
+	writeBehindMaxAttempts = 5
+	writeBehindBaseBackoff = 100 * time.Millisecond
+	writeBehindMaxBackoff  = 5 * time.Second
+	writeBehindFailedLimit = 1000 // 失敗リストに残しておく数
+
+	writeBehindGenerationKey = "writebehind.generation" // eventServer に置く
+)
+
+type writeBehindOp struct {
+	Query      string
+	Args       []interface{}
+	EnqueuedAt int64 // unix nano
+	Attempts   int
+	LastError  string
+	Generation int
+}
+
+// キューには msgpack にした writeBehindOp を []byte のまま積む。
+// デコードすると Args の型が変わる(int64 -> uint8 など)ので、Ack は取り出したバイト列そのままで行う
+var writeBehindQueue = NewReliableQueue(eventServer, queueWriteBehind, queueWriteBehind+":processing")
+
+// ワーカーの台でだけ数える
+var writeBehindStats struct {
+	applied     int64
+	retried     int64
+	failed      int64
+	discarded   int64
+	batches     int64
+	mutex       sync.Mutex
+	lastError   string
+	lastErrorAt time.Time
+}
+
+func writeBehind(query string, args ...interface{}) {
+	writeBehindQueue.Push(encodeToBytes(writeBehindOp{
+		Query:      query,
+		Args:       args,
+		EnqueuedAt: time.Now().UnixNano(),
+		Generation: writeBehindGeneration(),
+	}))
+}
+
+func writeBehindGeneration() int {
+	generation := 0
+	eventServer.Get(writeBehindGenerationKey, &generation)
+	return generation
+}
+
+func startWriteBehindWorker() {
+	if !isMasterServerIP {
+		return
+	}
+	go func() {
+		recoverWriteBehind()
+		runWriteBehindWorker()
+	}()
+}
+
+// 落ちる前に取り出していたものは、キューに残っているものより先に適用する(Requeue だと後ろに並んでしまう)。
+// 適用済みのものをもう一度適用することになるが、同じ値で UPDATE するだけなので問題ない
+func recoverWriteBehind() {
+	pending := eventServer.LRange(writeBehindQueue.processingKey, 0, -1)
+	if pending.Len() == 0 {
+		return
+	}
+	log.Println("write-behind worker: recovering", pending.Len())
+	batch := make([][]byte, 0, pending.Len())
+	for i := pending.Len() - 1; i >= 0; i-- { // 古いものが末尾にある
+		encoded := []byte{}
+		if err := pending.Get(i, &encoded); err == nil {
+			batch = append(batch, encoded)
+		}
+	}
+	applyWriteBehindEncoded(batch)
+}
+
+// /initialize で MySQL を作り直す前に、それ以前の書き込みを捨てる。
+// ワーカーが既に取り出しているものは世代を進めることで捨てさせる
+func discardWriteBehind() {
+	eventServer.IncrBy(writeBehindGenerationKey, 1)
+	eventServer.Del(queueWriteBehind)
+	eventServer.Del(writeBehindQueue.processingKey)
+	eventServer.Del(queueWriteBehindFailed)
+}
+
+func runWriteBehindWorker() {
+	for {
+		if batch := popWriteBehindBatch(workerPopTimeout); len(batch) > 0 {
+			applyWriteBehindEncoded(batch)
+		}
+	}
+}
+
+// 1 件目は timeout まで待ち、残りは待たずに writeBehindBatchSize 件まで取り出す
+func popWriteBehindBatch(timeout time.Duration) [][]byte {
+	encoded := []byte{}
+	if !writeBehindQueue.Pop(timeout, &encoded) {
+		return nil
+	}
+	batch := [][]byte{encoded}
+	for len(batch) < writeBehindBatchSize {
+		next := []byte{}
+		if !writeBehindQueue.Pop(-1, &next) {
+			break
+		}
+		batch = append(batch, next)
+	}
+	return batch
+}
+
+// 適用してから処理中リストから消す。古い世代のものは捨てる
+func applyWriteBehindEncoded(batch [][]byte) {
+	generation := writeBehindGeneration()
+	ops := make([]writeBehindOp, 0, len(batch))
+	for _, encoded := range batch {
+		op := writeBehindOp{}
+		if err := decodeFromBytes(encoded, &op); err != nil {
+			log.Println("write-behind worker: broken op", err)
+			continue
+		}
+		if op.Generation != generation {
+			atomic.AddInt64(&writeBehindStats.discarded, 1)
+			continue
+		}
+		ops = append(ops, op)
+	}
+	if len(ops) > 0 {
+		applyWriteBehindBatch(ops)
+	}
+	for _, encoded := range batch {
+		writeBehindQueue.Ack(encoded)
+	}
+}
+
+func applyWriteBehindBatch(ops []writeBehindOp) {
+	atomic.AddInt64(&writeBehindStats.batches, 1)
+	err := execWriteBehindBatch(ops)
+	if err == nil {
+		atomic.AddInt64(&writeBehindStats.applied, int64(len(ops)))
+		return
+	}
+	recordWriteBehindError(err)
+	// ロールバックされているので 1 件ずつ順に適用し直す
+	for _, op := range ops {
+		applyWriteBehindOp(op)
+	}
+}
+func execWriteBehindBatch(ops []writeBehindOp) error {
+	tx, err := dbx.Begin()
+	if err != nil {
+		return err
+	}
+	for _, op := range ops {
+		if _, err := tx.Exec(op.Query, writeBehindArgs(op.Args)...); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// 後ろの更新を先に適用しないように、成功するか諦めるまでここで待つ
+func applyWriteBehindOp(op writeBehindOp) {
+	for {
+		_, err := dbx.Exec(op.Query, writeBehindArgs(op.Args)...)
+		if err == nil {
+			atomic.AddInt64(&writeBehindStats.applied, 1)
+			return
+		}
+		recordWriteBehindError(err)
+		op.Attempts++
+		op.LastError = err.Error()
+		if op.Attempts >= writeBehindMaxAttempts {
+			log.Println("write-behind worker: gave up", op.Query, err)
+			atomic.AddInt64(&writeBehindStats.failed, 1)
+			eventServer.RPush(queueWriteBehindFailed, op)
+			for eventServer.LLen(queueWriteBehindFailed) > writeBehindFailedLimit {
+				eventServer.LPop(queueWriteBehindFailed, nil)
+			}
+			return
+		}
+		atomic.AddInt64(&writeBehindStats.retried, 1)
+		time.Sleep(writeBehindBackoff(op.Attempts))
+		// 待っている間に /initialize されたらもう適用しない
+		if op.Generation != writeBehindGeneration() {
+			atomic.AddInt64(&writeBehindStats.discarded, 1)
+			return
+		}
+	}
+}
+func writeBehindBackoff(attempts int) time.Duration {
+	backoff := writeBehindBaseBackoff << uint(attempts-1)
+	if backoff > writeBehindMaxBackoff || backoff <= 0 {
+		return writeBehindMaxBackoff
+	}
+	return backoff
+}
+
+// msgpack から戻した time.Time は UTC なので、DSN の loc=Local に揃える
+func writeBehindArgs(args []interface{}) []interface{} {
+	result := make([]interface{}, len(args))
+	for i, arg := range args {
+		if t, ok := arg.(time.Time); ok {
+			arg = t.Local()
+		}
+		result[i] = arg
+	}
+	return result
+}
+
+func recordWriteBehindError(err error) {
+	writeBehindStats.mutex.Lock()
+	defer writeBehindStats.mutex.Unlock()
+	writeBehindStats.lastError = err.Error()
+	writeBehindStats.lastErrorAt = time.Now()
+}
+
+type writeBehindFailure struct {
+	Query      string        `json:"query"`
+	Args       []interface{} `json:"args"`
+	EnqueuedAt time.Time     `json:"enqueued_at"`
+	Attempts   int           `json:"attempts"`
+	LastError  string        `json:"last_error"`
+}
+type writeBehindStatus struct {
+	Backlog     int                  `json:"backlog"`    // まだ取り出されていない数
+	Processing  int                  `json:"processing"` // 適用中の数
+	Applied     int64                `json:"applied"`
+	Retried     int64                `json:"retried"`
+	Failed      int64                `json:"failed"`
+	Discarded   int64                `json:"discarded"` // /initialize より前の世代なので捨てた数
+	Batches     int64                `json:"batches"`
+	LastError   string               `json:"last_error,omitempty"`
+	LastErrorAt *time.Time           `json:"last_error_at,omitempty"`
+	Failures    []writeBehindFailure `json:"failures"` // 諦めたもの(新しい順に最大 20 件)
+}
+
+// 件数は全台で共通、適用の数などはワーカーの台(eventServer の master)の値
+//
+//	curl localhost:9876/debug/writebehind
+func getDebugWriteBehind(w http.ResponseWriter, r *http.Request) {
+	status := writeBehindStatus{
+		Backlog:    writeBehindQueue.Len(),
+		Processing: eventServer.LLen(writeBehindQueue.processingKey),
+		Applied:    atomic.LoadInt64(&writeBehindStats.applied),
+		Retried:    atomic.LoadInt64(&writeBehindStats.retried),
+		Failed:     atomic.LoadInt64(&writeBehindStats.failed),
+		Discarded:  atomic.LoadInt64(&writeBehindStats.discarded),
+		Batches:    atomic.LoadInt64(&writeBehindStats.batches),
+		Failures:   []writeBehindFailure{},
+	}
+	writeBehindStats.mutex.Lock()
+	if status.LastError = writeBehindStats.lastError; status.LastError != "" {
+		lastErrorAt := writeBehindStats.lastErrorAt
+		status.LastErrorAt = &lastErrorAt
+	}
+	writeBehindStats.mutex.Unlock()
+	failures := eventServer.LRange(queueWriteBehindFailed, -20, -1)
+	for i := failures.Len() - 1; i >= 0; i-- {
+		op := writeBehindOp{}
+		if err := failures.Get(i, &op); err != nil {
+			continue
+		}
+		status.Failures = append(status.Failures, writeBehindFailure{
+			Query:      op.Query,
+			Args:       op.Args,
+			EnqueuedAt: time.Unix(0, op.EnqueuedAt),
+			Attempts:   op.Attempts,
+			LastError:  op.LastError,
+		})
+	}
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	json.NewEncoder(w).Encode(status)
+}
+
+// 諦めたものをキューに戻す(古い順に)
+//
+//	curl -XPOST localhost:9876/debug/writebehind/retry
+func postDebugWriteBehindRetry(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		outputErrorMsg(w, http.StatusMethodNotAllowed, "POST only")
+		return
+	}
+	retried := 0
+	for {
+		op := writeBehindOp{}
+		if !eventServer.LPop(queueWriteBehindFailed, &op) {
+			break
+		}
+		op.Attempts = 0
+		op.Generation = writeBehindGeneration()
+		writeBehindQueue.Push(encodeToBytes(op))
+		retried++
+	}
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	json.NewEncoder(w).Encode(struct {
+		Retried int `json:"retried"`
+	}{Retried: retried})
+}
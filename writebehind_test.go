@@ -0,0 +1,293 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// 実行した SQL を覚えておくだけのドライバ。failures に載せたクエリは指定した回数だけ失敗する (-1 なら毎回)
+type recordingDriver struct {
+	mutex    sync.Mutex
+	applied  []string // コミットされた順
+	failures map[string]int
+	commits  int
+}
+
+var errRecordingDriver = errors.New("recording driver: exec failed")
+
+func (this *recordingDriver) Open(name string) (driver.Conn, error) {
+	return &recordingConn{driver: this}, nil
+}
+
+func (this *recordingDriver) exec(query string) error {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	if remaining, ok := this.failures[query]; ok && remaining != 0 {
+		this.failures[query] = remaining - 1
+		return errRecordingDriver
+	}
+	return nil
+}
+
+func (this *recordingDriver) Applied() []string {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	return append([]string{}, this.applied...)
+}
+
+type recordingConn struct {
+	driver  *recordingDriver
+	pending []string // トランザクション中
+	inTx    bool
+}
+
+func (this *recordingConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("recording driver: prepare is not supported")
+}
+func (this *recordingConn) Close() error { return nil }
+func (this *recordingConn) Begin() (driver.Tx, error) {
+	this.inTx = true
+	this.pending = nil
+	return this, nil
+}
+func (this *recordingConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if err := this.driver.exec(query); err != nil {
+		return nil, err
+	}
+	if this.inTx {
+		this.pending = append(this.pending, query)
+	} else {
+		this.driver.mutex.Lock()
+		this.driver.applied = append(this.driver.applied, query)
+		this.driver.mutex.Unlock()
+	}
+	return driver.RowsAffected(1), nil
+}
+func (this *recordingConn) Commit() error {
+	this.driver.mutex.Lock()
+	defer this.driver.mutex.Unlock()
+	this.driver.applied = append(this.driver.applied, this.pending...)
+	this.driver.commits++
+	this.inTx = false
+	return nil
+}
+func (this *recordingConn) Rollback() error {
+	this.pending = nil
+	this.inTx = false
+	return nil
+}
+
+var recordingDriverCount int64
+
+// dbx と write-behind のキューをテスト用に差し替える。戻すのは restore
+func setupWriteBehindTest(t *testing.T, failures map[string]int) (recorder *recordingDriver, restore func()) {
+	recorder = &recordingDriver{failures: failures}
+	name := fmt.Sprintf("recording%d", atomic.AddInt64(&recordingDriverCount, 1))
+	sql.Register(name, recorder)
+	db, err := sql.Open(name, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.SetMaxOpenConns(1)
+	savedDB, savedEventServer, savedQueue := dbx, eventServer, writeBehindQueue
+	dbx = sqlx.NewDb(db, "mysql")
+	eventServer = newTestSyncMapServerConn(t)
+	writeBehindQueue = NewReliableQueue(eventServer, queueWriteBehind, queueWriteBehind+":processing")
+	return recorder, func() {
+		dbx, eventServer, writeBehindQueue = savedDB, savedEventServer, savedQueue
+		db.Close()
+	}
+}
+
+func TestWriteBehindBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, 100 * time.Millisecond},
+		{2, 200 * time.Millisecond},
+		{3, 400 * time.Millisecond},
+		{6, 3200 * time.Millisecond},
+		{7, writeBehindMaxBackoff},
+		{64, writeBehindMaxBackoff}, // シフトで溢れても上限
+		{100, writeBehindMaxBackoff},
+	}
+	for _, test := range tests {
+		if got := writeBehindBackoff(test.attempts); got != test.want {
+			t.Errorf("writeBehindBackoff(%d) = %v, want %v", test.attempts, got, test.want)
+		}
+	}
+}
+
+func TestWriteBehindBatching(t *testing.T) {
+	recorder, restore := setupWriteBehindTest(t, nil)
+	defer restore()
+	queries := make([]string, writeBehindBatchSize+20)
+	for i := range queries {
+		queries[i] = "UPDATE `items` SET `n` = ? WHERE `id` = " + string(rune('A'+i%26)) + string(rune('0'+i/26))
+		writeBehind(queries[i], i)
+	}
+	batchSizes := []int{}
+	for {
+		batch := popWriteBehindBatch(-1)
+		if len(batch) == 0 {
+			break
+		}
+		batchSizes = append(batchSizes, len(batch))
+		applyWriteBehindEncoded(batch)
+	}
+	if want := []int{writeBehindBatchSize, 20}; !reflect.DeepEqual(batchSizes, want) {
+		t.Errorf("batch sizes = %v, want %v", batchSizes, want)
+	}
+	if recorder.commits != 2 {
+		t.Errorf("commits = %d, want 2", recorder.commits)
+	}
+	if got := recorder.Applied(); !reflect.DeepEqual(got, queries) {
+		t.Errorf("applied %d queries out of order or missing", len(got))
+	}
+	if n := eventServer.LLen(writeBehindQueue.processingKey); n != 0 {
+		t.Errorf("processing = %d after apply", n)
+	}
+}
+
+// まとめて失敗したら 1 件ずつ順に再試行し、諦めたものは失敗リストに移して後ろは続ける
+func TestWriteBehindRetryAndFailedList(t *testing.T) {
+	const (
+		ok1   = "UPDATE ok1"
+		flaky = "UPDATE flaky"
+		bad   = "UPDATE bad"
+		ok2   = "UPDATE ok2"
+	)
+	recorder, restore := setupWriteBehindTest(t, map[string]int{flaky: 2, bad: -1})
+	defer restore()
+	// 失敗リストは上限まで埋まっていれば古いものから消える
+	for i := 0; i < writeBehindFailedLimit; i++ {
+		eventServer.RPush(queueWriteBehindFailed, writeBehindOp{Query: "old"})
+	}
+	retried := atomic.LoadInt64(&writeBehindStats.retried)
+	failed := atomic.LoadInt64(&writeBehindStats.failed)
+	for _, query := range []string{ok1, flaky, bad, ok2} {
+		writeBehind(query)
+	}
+	applyWriteBehindEncoded(popWriteBehindBatch(-1))
+
+	if want := []string{ok1, flaky, ok2}; !reflect.DeepEqual(recorder.Applied(), want) {
+		t.Errorf("applied = %v, want %v", recorder.Applied(), want)
+	}
+	if recorder.commits != 0 {
+		t.Errorf("commits = %d, want 0 (the batch must be rolled back)", recorder.commits)
+	}
+	// flaky の 1 回目の失敗はまとめて実行した時なので 1 回、bad は諦めるまでの writeBehindMaxAttempts-1 回
+	if got := atomic.LoadInt64(&writeBehindStats.retried) - retried; got != int64(1+writeBehindMaxAttempts-1) {
+		t.Errorf("retried = %d", got)
+	}
+	if got := atomic.LoadInt64(&writeBehindStats.failed) - failed; got != 1 {
+		t.Errorf("failed = %d, want 1", got)
+	}
+	if n := eventServer.LLen(queueWriteBehindFailed); n != writeBehindFailedLimit {
+		t.Errorf("failed list = %d, want %d", n, writeBehindFailedLimit)
+	}
+	last := writeBehindOp{}
+	eventServer.LIndex(queueWriteBehindFailed, writeBehindFailedLimit-1, &last)
+	if last.Query != bad || last.Attempts != writeBehindMaxAttempts || last.LastError != errRecordingDriver.Error() {
+		t.Errorf("last failure = %+v", last)
+	}
+	if n := eventServer.LLen(writeBehindQueue.processingKey); n != 0 {
+		t.Errorf("processing = %d after apply", n)
+	}
+
+	// retry で諦めたものをキューに戻す(回数は数え直す)
+	eventServer.Del(queueWriteBehindFailed)
+	eventServer.RPush(queueWriteBehindFailed, last)
+	recorder.failures[bad] = 0
+	w := httptest.NewRecorder()
+	postDebugWriteBehindRetry(w, httptest.NewRequest(http.MethodPost, "/debug/writebehind/retry", nil))
+	if w.Code != http.StatusOK || eventServer.LLen(queueWriteBehindFailed) != 0 || writeBehindQueue.Len() != 1 {
+		t.Fatalf("retry: %d %s, failed = %d, queue = %d", w.Code, w.Body, eventServer.LLen(queueWriteBehindFailed), writeBehindQueue.Len())
+	}
+	batch := popWriteBehindBatch(-1)
+	requeued := writeBehindOp{}
+	decodeFromBytes(batch[0], &requeued)
+	if requeued.Query != bad || requeued.Attempts != 0 {
+		t.Errorf("requeued = %+v", requeued)
+	}
+	applyWriteBehindEncoded(batch)
+	if got := recorder.Applied(); got[len(got)-1] != bad {
+		t.Errorf("applied = %v, want %s at the end", got, bad)
+	}
+}
+
+// 落ちる前に取り出していたものを、古い順に先に適用する
+func TestRecoverWriteBehind(t *testing.T) {
+	recorder, restore := setupWriteBehindTest(t, nil)
+	defer restore()
+	for _, query := range []string{"UPDATE 1", "UPDATE 2", "UPDATE 3"} {
+		writeBehind(query)
+	}
+	// 2 件取り出したところで落ちた
+	writeBehindQueue.Pop(-1, new([]byte))
+	writeBehindQueue.Pop(-1, new([]byte))
+	recoverWriteBehind()
+	if want := []string{"UPDATE 1", "UPDATE 2"}; !reflect.DeepEqual(recorder.Applied(), want) {
+		t.Errorf("recovered = %v, want %v", recorder.Applied(), want)
+	}
+	if n := eventServer.LLen(writeBehindQueue.processingKey); n != 0 {
+		t.Errorf("processing = %d after recover", n)
+	}
+	if writeBehindQueue.Len() != 1 {
+		t.Errorf("queue = %d, want 1", writeBehindQueue.Len())
+	}
+}
+
+// msgpack を通った time.Time は DSN に合わせて Local にする
+func TestWriteBehindArgs(t *testing.T) {
+	now := time.Unix(1565575823, 0)
+	op := writeBehindOp{}
+	decodeFromBytes(encodeToBytes(writeBehindOp{Args: []interface{}{int64(1), "a", now}}), &op)
+	args := writeBehindArgs(op.Args)
+	got, ok := args[2].(time.Time)
+	if !ok || !got.Equal(now) || got.Location() != time.Local {
+		t.Errorf("time arg = %#v", args[2])
+	}
+	if args[1] != "a" {
+		t.Errorf("string arg = %#v", args[1])
+	}
+}
+
+// /initialize より前に積んだものは、取り出し済みでも適用しない
+func TestDiscardWriteBehind(t *testing.T) {
+	recorder, restore := setupWriteBehindTest(t, nil)
+	defer restore()
+	discarded := atomic.LoadInt64(&writeBehindStats.discarded)
+	writeBehind("UPDATE old1")
+	writeBehind("UPDATE old2")
+	writeBehind("UPDATE old3")
+	popped := popWriteBehindBatch(-1) // ワーカーが取り出したところ
+	writeBehind("UPDATE old4")
+
+	discardWriteBehind()
+	if writeBehindQueue.Len() != 0 || eventServer.LLen(writeBehindQueue.processingKey) != 0 {
+		t.Errorf("queue = %d, processing = %d after discard", writeBehindQueue.Len(), eventServer.LLen(writeBehindQueue.processingKey))
+	}
+	writeBehind("UPDATE new")
+	applyWriteBehindEncoded(popped)
+	applyWriteBehindEncoded(popWriteBehindBatch(-1))
+	if want := []string{"UPDATE new"}; !reflect.DeepEqual(recorder.Applied(), want) {
+		t.Errorf("applied = %v, want %v", recorder.Applied(), want)
+	}
+	if got := atomic.LoadInt64(&writeBehindStats.discarded) - discarded; got != 3 {
+		t.Errorf("discarded = %d, want 3", got)
+	}
+}